@@ -7,7 +7,12 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation"
 
 	"go.stevenxie.me/api/v2/auth/airtable"
+	"go.stevenxie.me/api/v2/location/routing"
+	"go.stevenxie.me/api/v2/pkg/gitlab"
 	"go.stevenxie.me/api/v2/pkg/jaeger"
+	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/productivity/activitywatch"
+	"go.stevenxie.me/api/v2/scheduling"
 )
 
 // Config maps to a configuration YAML that can configure programs in this
@@ -26,26 +31,168 @@ type Config struct {
 	} `yaml:"about"`
 
 	Git struct {
+		// Forges from which to read commits; commits from all enabled forges are
+		// interleaved by timestamp.
+		GitHub struct {
+			Enabled bool `yaml:"enabled"`
+		} `yaml:"github"`
+		GitLab struct {
+			Enabled bool   `yaml:"enabled"`
+			BaseURL string `yaml:"baseURL"`
+		} `yaml:"gitlab"`
+		Gitea struct {
+			Enabled bool   `yaml:"enabled"`
+			BaseURL string `yaml:"baseURL"`
+		} `yaml:"gitea"`
+
 		Precacher struct {
 			Enabled  bool          `yaml:"enabled"`
 			Interval time.Duration `yaml:"interval"`
 			Limit    *int          `yaml:"limit"`
 		} `yaml:"precacher"`
+
+		Stats struct {
+			// LogPath is the path of the file in which commits are recorded.
+			LogPath        string        `yaml:"logPath"`
+			RecordInterval time.Duration `yaml:"recordInterval"`
+
+			// Timezone is the name of the time zone in which to bucket commits
+			// into days; if empty, the local time zone is used.
+			Timezone string `yaml:"timezone"`
+		} `yaml:"stats"`
 	} `yaml:"git"`
 
 	Scheduling struct {
+		// Source is where calendar events are read from; one of "gcal" or
+		// "ics".
+		Source string `yaml:"source"`
+
 		GCal struct {
 			CalendarIDs []string `yaml:"calendarIDs"`
 		} `yaml:"gcal"`
+
+		ICS struct {
+			Sources []struct {
+				URL string `yaml:"url"`
+
+				// Type is one of "ics" (an iCalendar feed or local .ics file)
+				// or "caldav" (a CalDAV collection); if empty, "ics" is used.
+				Type string `yaml:"type"`
+
+				// Username and PasswordEnv configure basic authentication;
+				// PasswordEnv is the name of the environment variable that
+				// contains the password.
+				Username    string `yaml:"username"`
+				PasswordEnv string `yaml:"passwordEnv"`
+			} `yaml:"sources"`
+			CacheTTL time.Duration `yaml:"cacheTTL"`
+		} `yaml:"ics"`
+
+		Booking struct {
+			Enabled bool `yaml:"enabled"`
+
+			// Backend is where bookings are held; one of "gcal" or "memory".
+			Backend string `yaml:"backend"`
+
+			// CalendarID is the Google calendar into which bookings are
+			// inserted, if Backend is "gcal".
+			CalendarID  string        `yaml:"calendarID"`
+			Buffer      time.Duration `yaml:"buffer"`
+			MaxDuration time.Duration `yaml:"maxDuration"`
+
+			// WorkingHours are the hours during which bookings may be placed.
+			WorkingHours struct {
+				// Start and End are times of day, like "09:00".
+				Start string `yaml:"start"`
+				End   string `yaml:"end"`
+
+				// Weekdays are the names of the days on which bookings may be
+				// placed (i.e. "Monday"); if empty, any day is allowed.
+				Weekdays []string `yaml:"weekdays"`
+			} `yaml:"workingHours"`
+		} `yaml:"booking"`
+
+		Travel struct {
+			// Routing is how trips between events are planned; one of "here"
+			// or "heuristic" (estimated from the distance travelled).
+			Routing string `yaml:"routing"`
+
+			// Mode is the mode of transport used for trips; one of "car",
+			// "pedestrian", or "publicTransport".
+			Mode string `yaml:"mode"`
+		} `yaml:"travel"`
 	} `yaml:"scheduling"`
 
 	Music struct {
+		// Backend is the music provider; one of "spotify" or "mpd".
+		Backend string `yaml:"backend"`
+
+		MPD struct {
+			Address string        `yaml:"address"`
+			Timeout time.Duration `yaml:"timeout"`
+		} `yaml:"mpd"`
+
+		Lyrics struct {
+			// Dir is a directory containing LRC files.
+			Dir  string `yaml:"dir"`
+			HTTP struct {
+				// URL is a template for the URL from which to fetch lyrics; see
+				// lrc.NewHTTPSource for details.
+				URL string `yaml:"url"`
+			} `yaml:"http"`
+		} `yaml:"lyrics"`
+
+		Requests struct {
+			RateLimit  int           `yaml:"rateLimit"`
+			RateWindow time.Duration `yaml:"rateWindow"`
+			MaxPending int           `yaml:"maxPending"`
+		} `yaml:"requests"`
+
 		Streamer struct {
-			Enabled      bool          `yaml:"enabled"`
-			PollInterval time.Duration `yaml:"pollInterval"`
+			Enabled          bool          `yaml:"enabled"`
+			PollInterval     time.Duration `yaml:"pollInterval"`
+			FastPollInterval time.Duration `yaml:"fastPollInterval"`
+			IdlePollInterval time.Duration `yaml:"idlePollInterval"`
+			BufferSize       int           `yaml:"bufferSize"`
 		} `yaml:"streamer"`
 	} `yaml:"music"`
 
+	Productivity struct {
+		// Source is the source of productivity records; one of "rescuetime" or
+		// "activitywatch".
+		Source string `yaml:"source"`
+
+		ActivityWatch struct {
+			BaseURL  string `yaml:"baseURL"`
+			Hostname string `yaml:"hostname"`
+
+			// Rules classify window events; the first matching rule is used.
+			// Events that match no rules are classified as DefaultCategory.
+			Rules           []activitywatch.Rule `yaml:"rules"`
+			DefaultCategory string               `yaml:"defaultCategory"`
+		} `yaml:"activityWatch"`
+
+		Scoring struct {
+			// Weights maps category names (i.e. "Very Productive") to weights
+			// between 0 and 1, replacing their productivity pulse weights.
+			Weights map[string]float64 `yaml:"weights"`
+
+			// ExcludeNeutral ignores neutral time when computing scores.
+			ExcludeNeutral bool `yaml:"excludeNeutral"`
+
+			// HourWeights maps hours of the day (0 to 23) to multipliers for the
+			// time recorded during those hours.
+			HourWeights map[int]float64 `yaml:"hourWeights"`
+		} `yaml:"scoring"`
+
+		Goals struct {
+			// Path is the file in which goals and focus sessions are saved; if
+			// empty, they are only kept in memory.
+			Path          string        `yaml:"path"`
+			CheckInterval time.Duration `yaml:"checkInterval"`
+		} `yaml:"goals"`
+	} `yaml:"productivity"`
+
 	Location struct {
 		Precacher struct {
 			Enabled  bool          `yaml:"enabled"`
@@ -61,6 +208,12 @@ type Config struct {
 		} `yaml:"currentRegion"`
 	}
 
+	Status struct {
+		// PollInterval is how often my status is recomputed, while it is being
+		// streamed.
+		PollInterval time.Duration `yaml:"pollInterval"`
+	} `yaml:"status"`
+
 	Auth struct {
 		Airtable struct {
 			Codes struct {
@@ -74,6 +227,42 @@ type Config struct {
 	} `yaml:"auth"`
 }
 
+// Supported values for Config.Music.Backend.
+const (
+	MusicBackendSpotify = "spotify"
+	MusicBackendMPD     = "mpd"
+)
+
+// Supported values for Config.Scheduling.Source.
+const (
+	SchedulingSourceGCal = "gcal"
+	SchedulingSourceICS  = "ics"
+)
+
+// Supported values for Config.Scheduling.ICS.Sources[].Type.
+const (
+	ICSSourceTypeICS    = "ics"
+	ICSSourceTypeCalDAV = "caldav"
+)
+
+// Supported values for Config.Scheduling.Booking.Backend.
+const (
+	BookingBackendGCal   = "gcal"
+	BookingBackendMemory = "memory"
+)
+
+// Supported values for Config.Scheduling.Travel.Routing.
+const (
+	TravelRoutingHere      = "here"
+	TravelRoutingHeuristic = "heuristic"
+)
+
+// Supported values for Config.Productivity.Source.
+const (
+	ProductivitySourceRescueTime    = "rescuetime"
+	ProductivitySourceActivityWatch = "activitywatch"
+)
+
 func defaultConfig() *Config {
 	cfg := new(Config)
 
@@ -84,6 +273,10 @@ func defaultConfig() *Config {
 		cfg.Interval = 2 * time.Minute
 	}
 
+	// Default Git settings.
+	cfg.Git.GitHub.Enabled = true
+	cfg.Git.GitLab.BaseURL = gitlab.DefaultBaseURL
+
 	// Default Git precacher settings.
 	{
 		cfg := &cfg.Git.Precacher
@@ -91,13 +284,72 @@ func defaultConfig() *Config {
 		cfg.Interval = 10 * time.Minute
 	}
 
+	// Default Git stats settings.
+	{
+		cfg := &cfg.Git.Stats
+		cfg.LogPath = "data/git/commits.jsonl"
+		cfg.RecordInterval = 15 * time.Minute
+	}
+
+	// Default music settings.
+	cfg.Music.Backend = MusicBackendSpotify
+	cfg.Music.MPD.Address = "localhost:6600"
+	cfg.Music.MPD.Timeout = 5 * time.Second
+
+	// Default music request settings.
+	{
+		cfg := &cfg.Music.Requests
+		cfg.RateLimit = 3
+		cfg.RateWindow = time.Hour
+		cfg.MaxPending = 25
+	}
+
 	// Default music streamer settings.
 	{
 		cfg := &cfg.Music.Streamer
 		cfg.Enabled = true
 		cfg.PollInterval = time.Second
+		cfg.FastPollInterval = 250 * time.Millisecond
+		cfg.IdlePollInterval = 5 * time.Second
+		cfg.BufferSize = 4
+	}
+
+	// Default productivity settings.
+	cfg.Productivity.Source = ProductivitySourceRescueTime
+	{
+		cfg := &cfg.Productivity.ActivityWatch
+		cfg.BaseURL = activitywatch.DefaultBaseURL
+		cfg.DefaultCategory = productivity.CatNeutral.Name()
+	}
+	{
+		cfg := &cfg.Productivity.Goals
+		cfg.Path = "data/productivity/goals.json"
+		cfg.CheckInterval = 5 * time.Minute
+	}
+
+	// Default scheduling settings.
+	cfg.Scheduling.Source = SchedulingSourceGCal
+	cfg.Scheduling.ICS.CacheTTL = 5 * time.Minute
+
+	// Default booking settings.
+	{
+		cfg := &cfg.Scheduling.Booking
+		cfg.Backend = BookingBackendGCal
+		cfg.Buffer = 10 * time.Minute
+		cfg.MaxDuration = time.Hour
+
+		wh := &cfg.WorkingHours
+		wh.Start, wh.End = "09:00", "17:00"
+		wh.Weekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}
 	}
 
+	// Default travel settings.
+	cfg.Scheduling.Travel.Routing = TravelRoutingHere
+	cfg.Scheduling.Travel.Mode = string(routing.ModeCar)
+
+	// Default status settings.
+	cfg.Status.PollInterval = time.Minute
+
 	// Default Airtable settings.
 	{
 		cfg := &cfg.Auth.Airtable
@@ -148,11 +400,245 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	{
+		music := &cfg.Music
+		if err := validation.Validate(
+			music.Backend,
+			validation.Required,
+			validation.In(MusicBackendSpotify, MusicBackendMPD),
+		); err != nil {
+			return errors.Wrap(err, "validate Music.Backend")
+		}
+		if streamer := &music.Streamer; streamer.Enabled {
+			if err := validation.ValidateStruct(
+				streamer,
+				validation.Field(
+					&streamer.PollInterval,
+					validation.Min(time.Duration(1)),
+				),
+				validation.Field(
+					&streamer.FastPollInterval,
+					validation.Min(time.Duration(1)),
+				),
+				validation.Field(
+					&streamer.IdlePollInterval,
+					validation.Min(time.Duration(1)),
+				),
+				validation.Field(&streamer.BufferSize, validation.Min(1)),
+			); err != nil {
+				return errors.Wrap(err, "validate Music.Streamer")
+			}
+		}
+		{
+			reqs := &music.Requests
+			if err := validation.ValidateStruct(
+				reqs,
+				validation.Field(&reqs.RateLimit, validation.Min(1)),
+				validation.Field(&reqs.RateWindow, validation.Min(time.Duration(1))),
+				validation.Field(&reqs.MaxPending, validation.Min(1)),
+			); err != nil {
+				return errors.Wrap(err, "validate Music.Requests")
+			}
+		}
+		if music.Backend == MusicBackendMPD {
+			mpd := &music.MPD
+			if err := validation.ValidateStruct(
+				mpd,
+				validation.Field(&mpd.Address, validation.Required),
+				validation.Field(&mpd.Timeout, validation.Min(time.Duration(1))),
+			); err != nil {
+				return errors.Wrap(err, "validate Music.MPD")
+			}
+		}
+	}
+
+	{
+		git := &cfg.Git
+		if !git.GitHub.Enabled && !git.GitLab.Enabled && !git.Gitea.Enabled {
+			return errors.New("validate Git: no forges enabled")
+		}
+		if git.GitLab.Enabled {
+			if err := validation.Validate(
+				git.GitLab.BaseURL,
+				validation.Required,
+			); err != nil {
+				return errors.Wrap(err, "validate Git.GitLab.BaseURL")
+			}
+		}
+		if git.Gitea.Enabled {
+			if err := validation.Validate(
+				git.Gitea.BaseURL,
+				validation.Required,
+			); err != nil {
+				return errors.Wrap(err, "validate Git.Gitea.BaseURL")
+			}
+		}
+		{
+			stats := &git.Stats
+			if err := validation.ValidateStruct(
+				stats,
+				validation.Field(&stats.LogPath, validation.Required),
+				validation.Field(
+					&stats.RecordInterval,
+					validation.Min(time.Duration(1)),
+				),
+			); err != nil {
+				return errors.Wrap(err, "validate Git.Stats")
+			}
+			if tz := stats.Timezone; tz != "" {
+				if _, err := time.LoadLocation(tz); err != nil {
+					return errors.Wrap(err, "validate Git.Stats.Timezone")
+				}
+			}
+		}
+	}
+
+	{
+		prod := &cfg.Productivity
+		if err := validation.Validate(
+			prod.Source,
+			validation.Required,
+			validation.In(
+				ProductivitySourceRescueTime,
+				ProductivitySourceActivityWatch,
+			),
+		); err != nil {
+			return errors.Wrap(err, "validate Productivity.Source")
+		}
+		if prod.Source == ProductivitySourceActivityWatch {
+			aw := &prod.ActivityWatch
+			if err := validation.ValidateStruct(
+				aw,
+				validation.Field(&aw.BaseURL, validation.Required),
+				validation.Field(&aw.Rules),
+			); err != nil {
+				return errors.Wrap(err, "validate Productivity.ActivityWatch")
+			}
+			if _, err := productivity.ParseCategory(
+				aw.DefaultCategory,
+			); err != nil {
+				return errors.Wrap(
+					err,
+					"validate Productivity.ActivityWatch.DefaultCategory",
+				)
+			}
+		}
+		for name := range prod.Scoring.Weights {
+			if _, err := productivity.ParseCategory(name); err != nil {
+				return errors.Wrap(err, "validate Productivity.Scoring.Weights")
+			}
+		}
+		if err := validation.Validate(
+			prod.Goals.CheckInterval,
+			validation.Min(time.Duration(1)),
+		); err != nil {
+			return errors.Wrap(err, "validate Productivity.Goals.CheckInterval")
+		}
+	}
+
 	if err := validation.Validate(
-		cfg.Scheduling.GCal.CalendarIDs,
+		cfg.Scheduling.Source,
 		validation.Required,
+		validation.In(SchedulingSourceGCal, SchedulingSourceICS),
+	); err != nil {
+		return errors.Wrap(err, "validate Scheduling.Source")
+	}
+	switch cfg.Scheduling.Source {
+	case SchedulingSourceGCal:
+		if err := validation.Validate(
+			cfg.Scheduling.GCal.CalendarIDs,
+			validation.Required,
+		); err != nil {
+			return errors.Wrap(err, "validate Scheduling.GCal.CalendarIDs")
+		}
+	case SchedulingSourceICS:
+		ics := &cfg.Scheduling.ICS
+		if err := validation.Validate(
+			ics.Sources,
+			validation.Required,
+		); err != nil {
+			return errors.Wrap(err, "validate Scheduling.ICS.Sources")
+		}
+		for i := range ics.Sources {
+			src := &ics.Sources[i]
+			if err := validation.ValidateStruct(
+				src,
+				validation.Field(&src.URL, validation.Required),
+				validation.Field(
+					&src.Type,
+					validation.In(ICSSourceTypeICS, ICSSourceTypeCalDAV),
+				),
+			); err != nil {
+				return errors.Wrapf(err, "validate Scheduling.ICS.Sources[%d]", i)
+			}
+		}
+		if err := validation.Validate(
+			ics.CacheTTL,
+			validation.Min(time.Duration(0)),
+		); err != nil {
+			return errors.Wrap(err, "validate Scheduling.ICS.CacheTTL")
+		}
+	}
+	if booking := &cfg.Scheduling.Booking; booking.Enabled {
+		if err := validation.ValidateStruct(
+			booking,
+			validation.Field(
+				&booking.Backend,
+				validation.Required,
+				validation.In(BookingBackendGCal, BookingBackendMemory),
+			),
+			validation.Field(&booking.Buffer, validation.Min(time.Duration(0))),
+			validation.Field(
+				&booking.MaxDuration,
+				validation.Min(time.Duration(1)),
+			),
+		); err != nil {
+			return errors.Wrap(err, "validate Scheduling.Booking")
+		}
+		if _, err := scheduling.ParseWorkingHours(
+			booking.WorkingHours.Start, booking.WorkingHours.End,
+			booking.WorkingHours.Weekdays,
+		); err != nil {
+			return errors.Wrap(err, "validate Scheduling.Booking.WorkingHours")
+		}
+		if booking.Backend == BookingBackendGCal {
+			if err := validation.Validate(
+				booking.CalendarID,
+				validation.Required,
+			); err != nil {
+				return errors.Wrap(err, "validate Scheduling.Booking.CalendarID")
+			}
+		}
+	}
+
+	{
+		travel := &cfg.Scheduling.Travel
+		if err := validation.ValidateStruct(
+			travel,
+			validation.Field(
+				&travel.Routing,
+				validation.Required,
+				validation.In(TravelRoutingHere, TravelRoutingHeuristic),
+			),
+			validation.Field(
+				&travel.Mode,
+				validation.Required,
+				validation.In(
+					string(routing.ModeCar),
+					string(routing.ModePedestrian),
+					string(routing.ModePublicTransport),
+				),
+			),
+		); err != nil {
+			return errors.Wrap(err, "validate Scheduling.Travel")
+		}
+	}
+
+	if err := validation.Validate(
+		cfg.Status.PollInterval,
+		validation.Min(time.Duration(1)),
 	); err != nil {
-		return errors.Wrap(err, "validate Scheduling.GCal.CalendarIDs")
+		return errors.Wrap(err, "validate Status.PollInterval")
 	}
 
 	{
@@ -14,13 +14,16 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
+	calendar "google.golang.org/api/calendar/v3"
 
 	"go.stevenxie.me/gopkg/cmdutil"
 	"go.stevenxie.me/gopkg/configutil"
 	"go.stevenxie.me/guillotine"
 
 	"go.stevenxie.me/api/v2/pkg/basic"
+	"go.stevenxie.me/api/v2/pkg/gitea"
 	"go.stevenxie.me/api/v2/pkg/github"
+	"go.stevenxie.me/api/v2/pkg/gitlab"
 	"go.stevenxie.me/api/v2/pkg/google"
 	"go.stevenxie.me/api/v2/pkg/here"
 	"go.stevenxie.me/api/v2/pkg/jaeger"
@@ -30,26 +33,37 @@ import (
 	"go.stevenxie.me/api/v2/location/geocode/heregeo"
 	"go.stevenxie.me/api/v2/location/gmaps"
 	"go.stevenxie.me/api/v2/location/locsvc"
+	"go.stevenxie.me/api/v2/location/routing"
+	"go.stevenxie.me/api/v2/location/routing/hereroute"
 
 	"go.stevenxie.me/api/v2/about"
 	"go.stevenxie.me/api/v2/about/aboutgh"
 	"go.stevenxie.me/api/v2/about/aboutsvc"
 
 	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/music/lrc"
+	"go.stevenxie.me/api/v2/music/mpd"
 	"go.stevenxie.me/api/v2/music/musicsvc"
 	"go.stevenxie.me/api/v2/music/spotify"
 
 	"go.stevenxie.me/api/v2/scheduling"
 	"go.stevenxie.me/api/v2/scheduling/gcal"
+	"go.stevenxie.me/api/v2/scheduling/icscal"
+	"go.stevenxie.me/api/v2/scheduling/schedmem"
 	"go.stevenxie.me/api/v2/scheduling/schedsvc"
 
 	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/productivity/activitywatch"
+	"go.stevenxie.me/api/v2/productivity/prodscore"
 	"go.stevenxie.me/api/v2/productivity/prodsvc"
 	"go.stevenxie.me/api/v2/productivity/rescuetime"
 
 	"go.stevenxie.me/api/v2/git"
 	"go.stevenxie.me/api/v2/git/gitgh"
+	"go.stevenxie.me/api/v2/git/gitgl"
+	"go.stevenxie.me/api/v2/git/gitgt"
 	"go.stevenxie.me/api/v2/git/gitsvc"
+	"go.stevenxie.me/api/v2/git/stats"
 
 	"go.stevenxie.me/api/v2/assist/transit"
 	"go.stevenxie.me/api/v2/assist/transit/grt"
@@ -59,6 +73,8 @@ import (
 	"go.stevenxie.me/api/v2/auth"
 	"go.stevenxie.me/api/v2/auth/airtable"
 
+	"go.stevenxie.me/api/v2/status/statussvc"
+
 	"go.stevenxie.me/api/v2/server/debugsrv"
 	"go.stevenxie.me/api/v2/server/gqlsrv"
 
@@ -211,21 +227,11 @@ func run(*cli.Context) (err error) {
 		return errors.Wrap(err, "create GitHub client")
 	}
 
-	spotifyClient, err := spotify.New()
-	if err != nil {
-		return errors.Wrap(err, "create Spotify client")
-	}
-
 	googleClients, err := google.NewClientSet()
 	if err != nil {
 		return errors.Wrap(err, "create Google client set")
 	}
 
-	rtimeClient, err := rescuetime.NewClient()
-	if err != nil {
-		return errors.Wrap(err, "create RescueTime client")
-	}
-
 	airtableClient, err := airtable.NewClient()
 	if err != nil {
 		return errors.Wrap(err, "create Airtable client")
@@ -234,10 +240,12 @@ func run(*cli.Context) (err error) {
 	// Init services.
 	log.Info("Initializing services...")
 
-	var locationService location.Service
+	var (
+		locationService location.Service
+		geoc            = heregeo.NewGeocoder(hereClient, basic.WithTracer(tracer))
+	)
 	{
 		var (
-			geoc    = heregeo.NewGeocoder(hereClient, basic.WithTracer(tracer))
 			hist    = gmaps.NewHistorian(timelineClient, basic.WithTracer(tracer))
 			histsvc = locsvc.NewHistoryService(hist, geoc, basicOpts...)
 		)
@@ -281,54 +289,330 @@ func run(*cli.Context) (err error) {
 		aboutService = aboutsvc.NewService(src, locationService, basicOpts...)
 	}
 
-	var musicService music.Service
+	var (
+		musicService  music.Service
+		musicRequests *musicsvc.RequestService
+	)
 	{
 		var (
-			src            = spotify.NewSource(spotifyClient)
-			srcsvc         = musicsvc.NewSourceService(src, basic.WithLogger(log))
-			currentService = spotify.NewCurrentService(spotifyClient, basicOpts...)
+			src            music.Source
+			currentService music.CurrentService
+			ctrl           music.Controller
 		)
+		switch cfg := cfg.Music; cfg.Backend {
+		case config.MusicBackendSpotify:
+			spotifyClient, err := spotify.New()
+			if err != nil {
+				return errors.Wrap(err, "create Spotify client")
+			}
+			src = spotify.NewSource(spotifyClient)
+			currentService = spotify.NewCurrentService(spotifyClient, basicOpts...)
+			ctrl = spotify.NewController(spotifyClient, basicOpts...)
+		case config.MusicBackendMPD:
+			mpdClient, err := mpd.NewClient(
+				cfg.MPD.Address,
+				mpd.ClientWithTimeout(cfg.MPD.Timeout),
+			)
+			if err != nil {
+				return errors.Wrap(err, "create MPD client")
+			}
+			src = mpd.NewSource(mpdClient)
+			currentService = mpd.NewCurrentService(mpdClient, basicOpts...)
+			ctrl = mpd.NewController(mpdClient, basicOpts...)
+		default:
+			return errors.Newf("unknown music backend '%s'", cfg.Backend)
+		}
+
+		var lyricsSources []music.LyricsSource
+		if dir := cfg.Music.Lyrics.Dir; dir != "" {
+			lyricsSources = append(lyricsSources, lrc.NewDirSource(dir))
+		}
+		if tmpl := cfg.Music.Lyrics.HTTP.URL; tmpl != "" {
+			httpSource, err := lrc.NewHTTPSource(tmpl)
+			if err != nil {
+				return errors.Wrap(err, "create HTTP lyrics source")
+			}
+			lyricsSources = append(lyricsSources, httpSource)
+		}
+
 		var (
-			ctrl    = spotify.NewController(spotifyClient, basicOpts...)
-			ctrlsvc = musicsvc.NewControlService(ctrl, basicOpts...)
+			srcsvc    = musicsvc.NewSourceService(src, basic.WithLogger(log))
+			ctrlsvc   = musicsvc.NewControlService(ctrl, basicOpts...)
+			lyricssvc = musicsvc.NewLyricsService(lyricsSources, basicOpts...)
 		)
+		{
+			cfg := cfg.Music.Requests
+			musicRequests = musicsvc.NewRequestService(
+				ctrlsvc,
+				musicsvc.RequestServiceWithLogger(log),
+				musicsvc.RequestServiceWithTracer(tracer),
+				musicsvc.RequestServiceWithRateLimit(cfg.RateLimit, cfg.RateWindow),
+				musicsvc.RequestServiceWithMaxPending(cfg.MaxPending),
+			)
+		}
 		musicService = musicsvc.NewService(
 			srcsvc,
 			currentService,
 			ctrlsvc,
+			lyricssvc,
+			musicRequests,
 		)
 	}
 
-	var musicStreamer music.Streamer
+	var currentStreamer music.CurrentStreamer
 	if cfg := cfg.Music.Streamer; cfg.Enabled {
-		currentStreamer := musicsvc.NewCurrentStreamer(
+		streamer := musicsvc.NewCurrentStreamer(
 			musicService,
 			musicsvc.StreamerWithLogger(log),
 			musicsvc.StreamerWithPollInterval(cfg.PollInterval),
+			musicsvc.StreamerWithFastPollInterval(cfg.FastPollInterval),
+			musicsvc.StreamerWithIdlePollInterval(cfg.IdlePollInterval),
+			musicsvc.StreamerWithBufferSize(cfg.BufferSize),
 		)
 		guillo.AddFunc(
-			currentStreamer.Stop,
+			streamer.Stop,
 			guillotine.WithPrefix("stopping music streamer"),
 		)
-		musicStreamer = currentStreamer
+		currentStreamer = streamer
 	} else {
-		musicStreamer = musicsvc.NewNoopCurrentStreamer(basic.WithLogger(log))
+		currentStreamer = musicsvc.NewNoopCurrentStreamer(basic.WithLogger(log))
 	}
+	musicStreamer := musicsvc.NewStreamer(
+		currentStreamer,
+		musicsvc.NewLyricsStreamer(
+			currentStreamer,
+			musicService,
+			basic.WithLogger(log),
+		),
+		musicRequests,
+	)
 
-	var schedulingService scheduling.Service
+	var (
+		schedulingService scheduling.Service
+		bookingService    scheduling.BookingService
+		travelService     scheduling.TravelService
+	)
 	{
-		calsvc, err := googleClients.CalendarService(context.Background())
-		if err != nil {
-			return errors.Wrap(err, "create Google calendar service")
+		// The Google calendar service is only created if it is needed, since it
+		// requires Google credentials.
+		var calsvc *calendar.Service
+		if (cfg.Scheduling.Source == config.SchedulingSourceGCal) ||
+			(cfg.Scheduling.Booking.Enabled &&
+				(cfg.Scheduling.Booking.Backend == config.BookingBackendGCal)) {
+			if calsvc, err = googleClients.CalendarService(
+				context.Background(),
+			); err != nil {
+				return errors.Wrap(err, "create Google calendar service")
+			}
 		}
-		src := gcal.NewCalendar(calsvc, cfg.Scheduling.GCal.CalendarIDs)
-		schedulingService = schedsvc.NewService(src, locationService, basicOpts...)
+
+		var (
+			src    scheduling.Calendar
+			events scheduling.EventSource
+		)
+		switch cfg.Scheduling.Source {
+		case config.SchedulingSourceGCal:
+			ids := cfg.Scheduling.GCal.CalendarIDs
+			src = gcal.NewCalendar(calsvc, ids)
+			events = gcal.NewEventSource(calsvc, ids)
+		case config.SchedulingSourceICS:
+			cfg := &cfg.Scheduling.ICS
+			sources := make([]icscal.Source, len(cfg.Sources))
+			for i, s := range cfg.Sources {
+				sources[i] = icscal.Source{
+					URL:      s.URL,
+					Type:     icscal.SourceType(s.Type),
+					Username: s.Username,
+				}
+				if sources[i].Type == "" {
+					sources[i].Type = icscal.SourceTypeICS
+				}
+				if s.PasswordEnv != "" {
+					sources[i].Password = os.Getenv(s.PasswordEnv)
+				}
+			}
+			cal, err := icscal.NewCalendar(
+				sources,
+				icscal.CalendarWithCacheTTL(cfg.CacheTTL),
+				icscal.CalendarWithLogger(log),
+			)
+			if err != nil {
+				return errors.Wrap(err, "create ICS calendar")
+			}
+			src, events = cal, cal
+		default:
+			return errors.Newf(
+				"unknown scheduling source '%s'",
+				cfg.Scheduling.Source,
+			)
+		}
+
+		var (
+			booker      scheduling.Booker
+			bookingOpts = []schedsvc.BookingServiceOption{
+				schedsvc.BookingServiceWithLogger(log),
+				schedsvc.BookingServiceWithTracer(tracer),
+			}
+		)
+		if cfg := &cfg.Scheduling.Booking; cfg.Enabled {
+			switch cfg.Backend {
+			case config.BookingBackendGCal:
+				booker = gcal.NewBooker(calsvc, cfg.CalendarID)
+			case config.BookingBackendMemory:
+				// Holds are only visible in the in-memory calendar, so it must
+				// also be consulted for busy times.
+				mem := schedmem.NewCalendar()
+				src, booker = schedsvc.NewMergedCalendar(src, mem), mem
+			default:
+				return errors.Newf("unknown booking backend '%s'", cfg.Backend)
+			}
+
+			wh, err := scheduling.ParseWorkingHours(
+				cfg.WorkingHours.Start, cfg.WorkingHours.End,
+				cfg.WorkingHours.Weekdays,
+			)
+			if err != nil {
+				return errors.Wrap(err, "parse booking working hours")
+			}
+			bookingOpts = append(
+				bookingOpts,
+				schedsvc.BookingServiceWithWorkingHours(*wh),
+				schedsvc.BookingServiceWithBuffer(cfg.Buffer),
+				schedsvc.BookingServiceWithMaxDuration(cfg.MaxDuration),
+			)
+		}
+		schedulingService = schedsvc.NewService(
+			src, events,
+			locationService,
+			basicOpts...,
+		)
+		bookingService = schedsvc.NewBookingService(
+			schedulingService,
+			booker,
+			bookingOpts...,
+		)
+
+		// Trips are estimated heuristically if they can't be planned using
+		// Here.
+		router := routing.NewHeuristicRouter()
+		if cfg.Scheduling.Travel.Routing == config.TravelRoutingHere {
+			router = routing.WithFallback(
+				hereroute.NewRouter(hereClient, basic.WithTracer(tracer)),
+				router,
+				basic.WithLogger(log),
+			)
+		}
+		travelService = schedsvc.NewTravelService(
+			schedulingService,
+			locationService,
+			geoc,
+			router,
+			schedsvc.TravelServiceWithLogger(log),
+			schedsvc.TravelServiceWithTracer(tracer),
+			schedsvc.TravelServiceWithMode(
+				routing.Mode(cfg.Scheduling.Travel.Mode),
+			),
+		)
 	}
 
-	var gitService git.Service
+	var (
+		gitService      git.Service
+		gitStatsService stats.Service
+		gitStreamer     = gitsvc.NewStreamer(gitsvc.StreamerWithLogger(log))
+		gitIngesters    = []git.CommitIngester{gitStreamer}
+	)
+	guillo.AddFunc(
+		gitStreamer.Stop,
+		guillotine.WithPrefix("stopping Git streamer"),
+	)
 	{
-		src := gitgh.NewSource(githubClient)
-		gitService = gitsvc.NewService(src, basicOpts...)
+		var (
+			srcs  []git.Source
+			acts  git.ActivitySource
+			metas git.RepoMetaSource
+			vers  git.VerificationSource
+			langs stats.LanguageSource
+		)
+		if cfg.Git.GitHub.Enabled {
+			loaderOpts := []github.LoaderOption{github.LoaderWithLogger(log)}
+			loader := github.NewRepoLoader(githubClient, loaderOpts...)
+			srcs = append(srcs, gitgh.NewSource(githubClient))
+			acts = gitgh.NewActivitySource(githubClient)
+			metas = gitgh.NewRepoMetaSource(loader)
+			vers = gitgh.NewVerificationSource(
+				github.NewCommitLoader(githubClient, loaderOpts...),
+			)
+			langs = gitgh.NewLanguageSource(loader)
+		}
+		if cfg := cfg.Git.GitLab; cfg.Enabled {
+			client, err := gitlab.New(cfg.BaseURL)
+			if err != nil {
+				return errors.Wrap(err, "create GitLab client")
+			}
+			srcs = append(srcs, gitgl.NewSource(client))
+		}
+		if cfg := cfg.Git.Gitea; cfg.Enabled {
+			client, err := gitea.New(cfg.BaseURL)
+			if err != nil {
+				return errors.Wrap(err, "create Gitea client")
+			}
+			srcs = append(srcs, gitgt.NewSource(client))
+		}
+		src := gitsvc.NewMergedSource(srcs, gitsvc.MergedSourceWithLogger(log))
+
+		// Serve pages of commit history from a precached copy, rather than
+		// fetching it from every forge on each request.
+		svcsrc := git.Source(src)
+		if cfg := cfg.Git.Precacher; cfg.Enabled {
+			precacher := gitsvc.NewSourcePrecacher(
+				src,
+				cfg.Interval,
+				gitsvc.SourcePrecacherWithLogger(log),
+			)
+			guillo.AddFunc(
+				precacher.Stop,
+				guillotine.WithPrefix("stopping Git source precacher"),
+			)
+			svcsrc = precacher
+			gitIngesters = append(gitIngesters, precacher)
+		}
+		gitService = gitsvc.NewService(svcsrc, acts, metas, vers, basicOpts...)
+
+		// Record commit history, for computing stats.
+		{
+			cfg := cfg.Git.Stats
+			commitLog, err := stats.NewFileLog(cfg.LogPath)
+			if err != nil {
+				return errors.Wrap(err, "open Git commit log")
+			}
+			guillo.AddCloser(
+				commitLog,
+				guillotine.WithPrefix("closing Git commit log"),
+			)
+			recorder := stats.NewRecorder(
+				src, commitLog,
+				cfg.RecordInterval,
+				stats.RecorderWithLogger(log),
+			)
+			guillo.AddFunc(
+				recorder.Stop,
+				guillotine.WithPrefix("stopping Git commit recorder"),
+			)
+			gitIngesters = append(gitIngesters, recorder)
+
+			loc := time.Local
+			if tz := cfg.Timezone; tz != "" {
+				if loc, err = time.LoadLocation(tz); err != nil {
+					return errors.Wrap(err, "load Git stats time zone")
+				}
+			}
+			gitStatsService = stats.NewService(
+				commitLog, langs,
+				stats.ServiceWithLogger(log),
+				stats.ServiceWithTracer(tracer),
+				stats.ServiceWithLocation(loc),
+			)
+		}
 
 		if cfg := cfg.Git.Precacher; cfg.Enabled {
 			precacher := gitsvc.NewServicePrecacher(
@@ -346,13 +630,95 @@ func run(*cli.Context) (err error) {
 				guillotine.WithPrefix("stopping Git service precacher"),
 			)
 			gitService = precacher
+			gitIngesters = append(gitIngesters, precacher)
 		}
 	}
 
-	var productivityService productivity.Service
+	var (
+		productivityService productivity.Service
+		goalService         *prodsvc.GoalService
+	)
 	{
-		src := rescuetime.NewRecordSource(rtimeClient)
-		productivityService = prodsvc.NewService(src, locationService, basicOpts...)
+		var (
+			src       productivity.RecordSource
+			acts      productivity.ActivitySource
+			intervals productivity.IntervalSource
+		)
+		switch cfg := cfg.Productivity; cfg.Source {
+		case config.ProductivitySourceRescueTime:
+			client, err := rescuetime.NewClient()
+			if err != nil {
+				return errors.Wrap(err, "create RescueTime client")
+			}
+			src = rescuetime.NewRecordSource(client)
+			acts = rescuetime.NewActivitySource(client)
+			intervals = rescuetime.NewIntervalSource(client)
+		case config.ProductivitySourceActivityWatch:
+			cfg := cfg.ActivityWatch
+			def, err := productivity.ParseCategory(cfg.DefaultCategory)
+			if err != nil {
+				return errors.Wrap(err, "parse ActivityWatch default category")
+			}
+			classifier, err := activitywatch.NewClassifier(cfg.Rules, def)
+			if err != nil {
+				return errors.Wrap(err, "create ActivityWatch classifier")
+			}
+			var (
+				client = activitywatch.NewClient(cfg.BaseURL)
+				opts   = []activitywatch.RecordSourceOption{
+					activitywatch.RecordSourceWithHostname(cfg.Hostname),
+				}
+			)
+			src = activitywatch.NewRecordSource(client, classifier, opts...)
+			acts = activitywatch.NewActivitySource(client, classifier, opts...)
+			intervals = activitywatch.NewIntervalSource(client, classifier, opts...)
+		default:
+			return errors.Newf("unknown productivity source '%s'", cfg.Source)
+		}
+		scoreOpts := []prodscore.ScorerOption{
+			prodscore.ScorerWithExcludeNeutral(
+				cfg.Productivity.Scoring.ExcludeNeutral,
+			),
+			prodscore.ScorerWithHourWeights(cfg.Productivity.Scoring.HourWeights),
+		}
+		if names := cfg.Productivity.Scoring.Weights; len(names) > 0 {
+			weights := make(map[productivity.Category]float64, len(names))
+			for name, w := range names {
+				cat, err := productivity.ParseCategory(name)
+				if err != nil {
+					return errors.Wrap(err, "parse productivity scoring weights")
+				}
+				weights[cat] = w
+			}
+			scoreOpts = append(scoreOpts, prodscore.ScorerWithWeights(weights))
+		}
+		scorer, err := prodscore.NewScorer(scoreOpts...)
+		if err != nil {
+			return errors.Wrap(err, "create productivity scorer")
+		}
+		productivityService = prodsvc.NewService(
+			src, acts, intervals,
+			locationService,
+			scorer,
+			basicOpts...,
+		)
+
+		cfg := cfg.Productivity.Goals
+		if goalService, err = prodsvc.NewGoalService(
+			productivityService,
+			intervals,
+			prodsvc.GoalServiceWithLogger(log),
+			prodsvc.GoalServiceWithTracer(tracer),
+			prodsvc.GoalServiceWithPath(cfg.Path),
+			prodsvc.GoalServiceWithCheckInterval(cfg.CheckInterval),
+			prodsvc.GoalServiceWithScorer(scorer),
+		); err != nil {
+			return errors.Wrap(err, "create productivity goal service")
+		}
+		guillo.AddFunc(
+			goalService.Stop,
+			guillotine.WithPrefix("stopping productivity goal service"),
+		)
 	}
 
 	var authService auth.Service
@@ -392,6 +758,26 @@ func run(*cli.Context) (err error) {
 		)
 	}
 
+	var statusService *statussvc.Service
+	{
+		statusService = statussvc.NewService(
+			statussvc.Sources{
+				Scheduling:   schedulingService,
+				Location:     locationService,
+				Music:        musicService,
+				Productivity: productivityService,
+				Goals:        goalService,
+			},
+			statussvc.ServiceWithLogger(log),
+			statussvc.ServiceWithTracer(tracer),
+			statussvc.ServiceWithPollInterval(cfg.Status.PollInterval),
+		)
+		guillo.AddFunc(
+			statusService.Stop,
+			guillotine.WithPrefix("stopping status service"),
+		)
+	}
+
 	// Coordinate processes with errgroup.
 	var group errgroup.Group
 
@@ -402,22 +788,43 @@ func run(*cli.Context) (err error) {
 
 	// Start GraphQL server.
 	log.Info("Initializing GraphQL server...")
+	gqlOpts := []gqlsrv.ServerOption{
+		gqlsrv.WithLogger(log),
+		gqlsrv.WithSentry(sentry.NewHub(sty, sentry.NewScope())),
+	}
+	if secret, ok := github.WebhookSecret(); ok && cfg.Git.GitHub.Enabled {
+		gqlOpts = append(gqlOpts, gqlsrv.WithGitHubWebhook(
+			secret,
+			githubClient.CurrentUserLogin,
+			gitsvc.NewIngester(gitIngesters...),
+		))
+	} else {
+		log.Info("No GitHub webhook secret; Git commits will only be polled.")
+	}
 	gqlServer := gqlsrv.NewServer(
 		gqlsrv.Services{
 			Git:          gitService,
+			GitStats:     gitStatsService,
 			About:        aboutService,
 			Music:        musicService,
 			Auth:         authService,
 			Location:     locationService,
 			Transit:      transitService,
 			Scheduling:   schedulingService,
+			Booking:      bookingService,
+			Travel:       travelService,
 			Productivity: productivityService,
+
+			ProductivityGoals: goalService,
+			Status:            statusService,
 		},
 		gqlsrv.Streamers{
-			Music: musicStreamer,
+			Git:          gitStreamer,
+			Music:        musicStreamer,
+			Productivity: goalService,
+			Status:       statusService,
 		},
-		gqlsrv.WithLogger(log),
-		gqlsrv.WithSentry(sentry.NewHub(sty, sentry.NewScope())),
+		gqlOpts...,
 	)
 	guillo.AddFinalizer(shutdownFinalizer(gqlServer, "GraphQL server", log))
 	group.Go(startServerFunc(gqlServer, host, flags.Port, guillo))
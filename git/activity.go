@@ -0,0 +1,173 @@
+package git
+
+import (
+	"context"
+	stderrs "errors"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// An ActivityType is a kind of Activity.
+type ActivityType string
+
+// The set of valid ActivityTypes.
+const (
+	ActivityPullRequest ActivityType = "PULL_REQUEST"
+	ActivityReview      ActivityType = "REVIEW"
+	ActivityIssue       ActivityType = "ISSUE"
+	ActivityRelease     ActivityType = "RELEASE"
+)
+
+// ActivityTypes are all the valid ActivityTypes.
+var ActivityTypes = []ActivityType{
+	ActivityPullRequest,
+	ActivityReview,
+	ActivityIssue,
+	ActivityRelease,
+}
+
+// ErrInvalidActivityType is returned by a Service when an ActivityType is not
+// valid.
+var ErrInvalidActivityType = exthttp.WrapWithHTTPCode(
+	stderrs.New("git: invalid activity type"),
+	http.StatusBadRequest,
+)
+
+// Validate returns an error if t is not a valid ActivityType.
+func (t ActivityType) Validate() error {
+	for _, valid := range ActivityTypes {
+		if t == valid {
+			return nil
+		}
+	}
+	return ErrInvalidActivityType
+}
+
+// An Activity is something that I did on a Git forge, other than pushing
+// commits.
+//
+// It is one of PullRequestActivity, ReviewActivity, IssueActivity, or
+// ReleaseActivity.
+type Activity interface {
+	ActivityType() ActivityType
+	ActivityTime() time.Time
+}
+
+type (
+	// A PullRequestAction is an action performed on a pull request.
+	PullRequestAction string
+
+	// An IssueAction is an action performed on an issue.
+	IssueAction string
+
+	// A ReviewState is the outcome of a pull request review.
+	ReviewState string
+)
+
+// The set of valid PullRequestActions.
+const (
+	PullRequestOpened   PullRequestAction = "OPENED"
+	PullRequestMerged   PullRequestAction = "MERGED"
+	PullRequestClosed   PullRequestAction = "CLOSED"
+	PullRequestReopened PullRequestAction = "REOPENED"
+)
+
+// The set of valid IssueActions.
+const (
+	IssueOpened   IssueAction = "OPENED"
+	IssueClosed   IssueAction = "CLOSED"
+	IssueReopened IssueAction = "REOPENED"
+)
+
+// The set of valid ReviewStates.
+const (
+	ReviewApproved         ReviewState = "APPROVED"
+	ReviewChangesRequested ReviewState = "CHANGES_REQUESTED"
+	ReviewCommented        ReviewState = "COMMENTED"
+	ReviewDismissed        ReviewState = "DISMISSED"
+)
+
+type (
+	// A PullRequest identifies a pull request.
+	PullRequest struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		URL    string `json:"url"`
+	}
+
+	// A PullRequestActivity is an action that I performed on a pull request.
+	PullRequestActivity struct {
+		Action      PullRequestAction `json:"action"`
+		PullRequest PullRequest       `json:"pullRequest"`
+		Repo        Repo              `json:"repo"`
+		Timestamp   time.Time         `json:"timestamp"`
+	}
+
+	// A ReviewActivity is a review that I submitted on a pull request.
+	ReviewActivity struct {
+		State       ReviewState `json:"state"`
+		URL         string      `json:"url"`
+		PullRequest PullRequest `json:"pullRequest"`
+		Repo        Repo        `json:"repo"`
+		Timestamp   time.Time   `json:"timestamp"`
+	}
+
+	// An IssueActivity is an action that I performed on an issue.
+	IssueActivity struct {
+		Action    IssueAction `json:"action"`
+		Number    int         `json:"number"`
+		Title     string      `json:"title"`
+		URL       string      `json:"url"`
+		Repo      Repo        `json:"repo"`
+		Timestamp time.Time   `json:"timestamp"`
+	}
+
+	// A ReleaseActivity is a release that I published.
+	ReleaseActivity struct {
+		Tag        string    `json:"tag"`
+		Name       string    `json:"name"`
+		Prerelease bool      `json:"prerelease"`
+		URL        string    `json:"url"`
+		Repo       Repo      `json:"repo"`
+		Timestamp  time.Time `json:"timestamp"`
+	}
+)
+
+var (
+	_ Activity = (*PullRequestActivity)(nil)
+	_ Activity = (*ReviewActivity)(nil)
+	_ Activity = (*IssueActivity)(nil)
+	_ Activity = (*ReleaseActivity)(nil)
+)
+
+// ActivityType implements Activity.ActivityType.
+func (*PullRequestActivity) ActivityType() ActivityType { return ActivityPullRequest }
+
+// ActivityTime implements Activity.ActivityTime.
+func (a *PullRequestActivity) ActivityTime() time.Time { return a.Timestamp }
+
+// ActivityType implements Activity.ActivityType.
+func (*ReviewActivity) ActivityType() ActivityType { return ActivityReview }
+
+// ActivityTime implements Activity.ActivityTime.
+func (a *ReviewActivity) ActivityTime() time.Time { return a.Timestamp }
+
+// ActivityType implements Activity.ActivityType.
+func (*IssueActivity) ActivityType() ActivityType { return ActivityIssue }
+
+// ActivityTime implements Activity.ActivityTime.
+func (a *IssueActivity) ActivityTime() time.Time { return a.Timestamp }
+
+// ActivityType implements Activity.ActivityType.
+func (*ReleaseActivity) ActivityType() ActivityType { return ActivityRelease }
+
+// ActivityTime implements Activity.ActivityTime.
+func (a *ReleaseActivity) ActivityTime() time.Time { return a.Timestamp }
+
+// An ActivitySource can retrieve my recent Activity.
+type ActivitySource interface {
+	// RecentActivity retrieves recent Activity, ordered from newest to oldest.
+	RecentActivity(ctx context.Context) ([]Activity, error)
+}
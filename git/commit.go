@@ -3,8 +3,6 @@ package git
 import (
 	"context"
 	"time"
-
-	"github.com/google/go-github/v25/github"
 )
 
 type (
@@ -19,8 +17,19 @@ type (
 		Timestamp time.Time     `json:"timestamp"`
 	}
 
-	// A CommitAuthor forwards a definition.
-	CommitAuthor github.CommitAuthor
+	// A CommitAuthor authors or commits a Commit.
+	CommitAuthor struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+
+		// Login is the author's username on the forge that hosts the Commit, if
+		// known.
+		Login *string `json:"login,omitempty"`
+
+		// Date is the time at which the Commit was authored (or committed), if
+		// known.
+		Date *time.Time `json:"date,omitempty"`
+	}
 )
 
 // A Repo represents a Git repository.
@@ -31,5 +40,11 @@ type Repo struct {
 
 // A Source can retrieve recent Git commits from various projects.
 type Source interface {
+	// RecentCommits retrieves the latest commit from each of the `limit` most
+	// recently pushed-to repositories.
 	RecentCommits(ctx context.Context, limit int) ([]Commit, error)
+
+	// CommitHistory retrieves every commit in each recent push, ordered from
+	// newest to oldest.
+	CommitHistory(ctx context.Context) ([]Commit, error)
 }
@@ -0,0 +1,155 @@
+package gitgh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	ghlib "github.com/google/go-github/v25/github"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/pkg/github"
+)
+
+// NewActivitySource creates a new git.ActivitySource that reads Activity from
+// the events of the authenticated GitHub user.
+func NewActivitySource(c *github.Client) git.ActivitySource {
+	return activitySource{client: c}
+}
+
+type activitySource struct {
+	client *github.Client
+}
+
+var _ git.ActivitySource = (*activitySource)(nil)
+
+func (src activitySource) RecentActivity(
+	ctx context.Context,
+) ([]git.Activity, error) {
+	var acts []git.Activity
+	err := forEachEvent(ctx, src.client, func(e *ghlib.Event) (bool, error) {
+		switch e.GetType() {
+		case "PullRequestEvent", "PullRequestReviewEvent", "IssuesEvent",
+			"ReleaseEvent":
+		default:
+			return true, nil
+		}
+		payload, err := e.ParsePayload()
+		if err != nil {
+			return false, errors.Wrap(err, "gitgh: failed to parse event payload")
+		}
+		if act := activityFromPayload(e, payload); act != nil {
+			acts = append(acts, act)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return acts, nil
+}
+
+// activityFromPayload converts the payload of e into a git.Activity.
+//
+// It returns nil if the payload does not describe a supported action.
+func activityFromPayload(
+	e *ghlib.Event,
+	payload interface{},
+) git.Activity {
+	var (
+		name = e.GetRepo().GetName()
+		repo = git.Repo{
+			Name: name,
+			URL:  fmt.Sprintf("%s/%s", _homeURL, name),
+		}
+		ts = e.GetCreatedAt()
+	)
+	switch p := payload.(type) {
+	case *ghlib.PullRequestEvent:
+		var action git.PullRequestAction
+		switch p.GetAction() {
+		case "opened":
+			action = git.PullRequestOpened
+		case "reopened":
+			action = git.PullRequestReopened
+		case "closed":
+			action = git.PullRequestClosed
+			if p.GetPullRequest().GetMerged() {
+				action = git.PullRequestMerged
+			}
+		default:
+			return nil
+		}
+		return &git.PullRequestActivity{
+			Action:      action,
+			PullRequest: pullRequestFromGH(p.GetPullRequest()),
+			Repo:        repo,
+			Timestamp:   ts,
+		}
+
+	case *ghlib.PullRequestReviewEvent:
+		r := p.GetReview()
+		state := git.ReviewState(strings.ToUpper(r.GetState()))
+		switch state {
+		case git.ReviewApproved, git.ReviewChangesRequested,
+			git.ReviewCommented, git.ReviewDismissed:
+		default:
+			return nil
+		}
+		return &git.ReviewActivity{
+			State:       state,
+			URL:         r.GetHTMLURL(),
+			PullRequest: pullRequestFromGH(p.GetPullRequest()),
+			Repo:        repo,
+			Timestamp:   ts,
+		}
+
+	case *ghlib.IssuesEvent:
+		var action git.IssueAction
+		switch p.GetAction() {
+		case "opened":
+			action = git.IssueOpened
+		case "closed":
+			action = git.IssueClosed
+		case "reopened":
+			action = git.IssueReopened
+		default:
+			return nil
+		}
+		i := p.GetIssue()
+		return &git.IssueActivity{
+			Action:    action,
+			Number:    i.GetNumber(),
+			Title:     i.GetTitle(),
+			URL:       i.GetHTMLURL(),
+			Repo:      repo,
+			Timestamp: ts,
+		}
+
+	case *ghlib.ReleaseEvent:
+		if p.GetAction() != "published" {
+			return nil
+		}
+		r := p.GetRelease()
+		return &git.ReleaseActivity{
+			Tag:        r.GetTagName(),
+			Name:       r.GetName(),
+			Prerelease: r.GetPrerelease(),
+			URL:        r.GetHTMLURL(),
+			Repo:       repo,
+			Timestamp:  ts,
+		}
+
+	default:
+		return nil
+	}
+}
+
+func pullRequestFromGH(pr *ghlib.PullRequest) git.PullRequest {
+	return git.PullRequest{
+		Number: pr.GetNumber(),
+		Title:  pr.GetTitle(),
+		URL:    pr.GetHTMLURL(),
+	}
+}
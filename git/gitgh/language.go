@@ -0,0 +1,34 @@
+package gitgh
+
+import (
+	"context"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/git/stats"
+	"go.stevenxie.me/api/v2/pkg/github"
+)
+
+// NewLanguageSource creates a new stats.LanguageSource that looks up the
+// languages of GitHub repositories using l.
+//
+// Repositories that are not hosted on GitHub have unknown languages.
+func NewLanguageSource(l *github.RepoLoader) stats.LanguageSource {
+	return languageSource{loader: l}
+}
+
+type languageSource struct {
+	loader *github.RepoLoader
+}
+
+var _ stats.LanguageSource = (*languageSource)(nil)
+
+func (src languageSource) RepoLanguage(
+	ctx context.Context,
+	repo git.Repo,
+) (*string, error) {
+	r, err := loadRepo(ctx, src.loader, repo)
+	if err != nil || (r == nil) {
+		return nil, err
+	}
+	return r.PrimaryLanguage, nil
+}
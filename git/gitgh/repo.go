@@ -0,0 +1,65 @@
+package gitgh
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/pkg/github"
+)
+
+// NewRepoMetaSource creates a new git.RepoMetaSource that looks up the
+// metadata of GitHub repositories using l.
+//
+// Repositories that are not hosted on GitHub have unknown metadata.
+func NewRepoMetaSource(l *github.RepoLoader) git.RepoMetaSource {
+	return repoMetaSource{loader: l}
+}
+
+type repoMetaSource struct {
+	loader *github.RepoLoader
+}
+
+var _ git.RepoMetaSource = (*repoMetaSource)(nil)
+
+func (src repoMetaSource) RepoMeta(
+	ctx context.Context,
+	repo git.Repo,
+) (*git.RepoMeta, error) {
+	r, err := loadRepo(ctx, src.loader, repo)
+	if err != nil || (r == nil) {
+		return nil, err
+	}
+	meta := git.RepoMeta{
+		Description:     r.Description,
+		PrimaryLanguage: r.PrimaryLanguage,
+		Languages:       make([]git.RepoLanguage, len(r.Languages)),
+		Stars:           r.Stars,
+		Topics:          r.Topics,
+		PushedAt:        r.PushedAt,
+		IsFork:          r.IsFork,
+		IsArchived:      r.IsArchived,
+	}
+	for i, l := range r.Languages {
+		meta.Languages[i] = git.RepoLanguage{Name: l.Name, Bytes: l.Size}
+	}
+	return &meta, nil
+}
+
+// loadRepo loads repo using l, if it is hosted on GitHub.
+func loadRepo(
+	ctx context.Context,
+	l *github.RepoLoader,
+	repo git.Repo,
+) (*github.Repo, error) {
+	if !strings.HasPrefix(repo.URL, _homeURL+"/") {
+		return nil, nil
+	}
+	r, err := l.Load(ctx, repo.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "gitgh: load repository")
+	}
+	return r, nil
+}
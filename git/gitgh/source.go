@@ -36,103 +36,156 @@ func (svc source) RecentCommits(
 	ctx context.Context,
 	limit int,
 ) ([]git.Commit, error) {
-	// Get current user login.
-	login, err := svc.client.CurrentUserLogin()
+	var (
+		cms       = make([]git.Commit, 0, limit)
+		seenRepos = make(map[string]zero.Struct)
+	)
+	err := svc.forEachPush(ctx, func(
+		e *ghlib.Event,
+		push *ghlib.PushEvent,
+	) bool {
+		repo := e.GetRepo().GetName()
+		if _, ok := seenRepos[repo]; ok { // enforce uniqueness
+			return true
+		}
+		if len(push.Commits) == 0 {
+			return true
+		}
+
+		// Append latest commit to cms, add repo to seen set.
+		cms = append(cms, commitFromPush(e, &push.Commits[0]))
+		seenRepos[repo] = zero.Empty()
+		return len(cms) < limit
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "gitstatgh: getting current user")
+		return nil, err
 	}
+	return cms, nil
+}
 
+// CommitHistory retrieves every commit in each recent push, ordered from
+// newest to oldest.
+func (svc source) CommitHistory(ctx context.Context) ([]git.Commit, error) {
 	var (
-		cms       = make([]git.Commit, 0, limit)
-		seenRepos = make(map[string]zero.Struct)
+		cms  []git.Commit
+		seen = make(map[string]zero.Struct)
 	)
+	err := svc.forEachPush(ctx, func(
+		e *ghlib.Event,
+		push *ghlib.PushEvent,
+	) bool {
+		// Push commits are ordered from oldest to newest.
+		for i := len(push.Commits) - 1; i >= 0; i-- {
+			pc := &push.Commits[i]
+			if _, ok := seen[pc.GetSHA()]; ok { // pushed to multiple branches
+				continue
+			}
+			cms = append(cms, commitFromPush(e, pc))
+			seen[pc.GetSHA()] = zero.Empty()
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cms, nil
+}
+
+// forEachPush calls fn with each of the current user's recent push events,
+// from newest to oldest, until fn returns false.
+func (svc source) forEachPush(
+	ctx context.Context,
+	fn func(*ghlib.Event, *ghlib.PushEvent) bool,
+) error {
+	return forEachEvent(ctx, svc.client, func(e *ghlib.Event) (bool, error) {
+		if e.GetType() != _pushEventType {
+			return true, nil
+		}
+		payload, err := e.ParsePayload()
+		if err != nil {
+			return false, errors.Wrap(err, "gitgh: failed to parse event payload")
+		}
+		return fn(e, payload.(*ghlib.PushEvent)), nil
+	})
+}
+
+// forEachEvent calls fn with each of the current user's recent events, from
+// newest to oldest, until fn returns false or an error.
+func forEachEvent(
+	ctx context.Context,
+	client *github.Client,
+	fn func(*ghlib.Event) (bool, error),
+) error {
+	// Get current user login.
+	login, err := client.CurrentUserLogin()
+	if err != nil {
+		return errors.Wrap(err, "gitgh: getting current user")
+	}
 
-	// Loop through all event pages, looking for git.
-PageLoop:
-	for page := 0; page < _maxEventsPage; page++ {
+	// Loop through all event pages.
+	for page := 1; page <= _maxEventsPage; page++ {
 		// List user events.
-		events, _, err := svc.client.GitHub().Activity.ListEventsPerformedByUser(
+		events, res, err := client.GitHub().Activity.ListEventsPerformedByUser(
 			ctx,
 			login, false,
 			&ghlib.ListOptions{Page: page},
 		)
 		if err != nil {
-			return nil, err
+			return err
 		}
-
-		// Filter and parse events.
 		for _, e := range events {
-			if e.GetType() != _pushEventType {
-				continue
-			}
-			if _, ok := seenRepos[e.GetRepo().GetName()]; ok { // enforce uniqueness
-				continue
-			}
-
-			// Extract latest commit from push event.
-			var pushCommit ghlib.PushEventCommit
-			{
-				payload, err := e.ParsePayload()
-				if err != nil {
-					return nil, errors.Wrap(
-						err,
-						"gitstatgh: failed to parse event payload",
-					)
-				}
-				push := payload.(*ghlib.PushEvent)
-				if len(push.Commits) == 0 {
-					continue
-				}
-				pushCommit = push.Commits[0]
+			next, err := fn(e)
+			if err != nil {
+				return err
 			}
-
-			author := git.CommitAuthor(*pushCommit.GetAuthor())
-
-			var committer *git.CommitAuthor
-			if c := pushCommit.GetCommitter(); c != nil {
-				ca := git.CommitAuthor(*c)
-				committer = &ca
-			}
-
-			// Construct git.Commit.
-			var (
-				repo   = e.GetRepo().GetName()
-				commit = git.Commit{
-					SHA:       pushCommit.GetSHA(),
-					Author:    author,
-					Committer: committer,
-					Message:   pushCommit.GetMessage(),
-					URL: fmt.Sprintf(
-						"%s/%s/commit/%s",
-						_homeURL,
-						repo,
-						pushCommit.GetSHA(),
-					),
-					Repo: git.Repo{
-						Name: repo,
-						URL:  fmt.Sprintf("%s/%s", _homeURL, repo),
-					},
-					Timestamp: e.GetCreatedAt(),
-				}
-			)
-
-			// Append to cms, add repo to seen set.
-			cms = append(cms, commit)
-			if len(cms) == limit {
-				break PageLoop
+			if !next {
+				return nil
 			}
-
-			seenRepos[repo] = zero.Empty()
+		}
+		if res.NextPage == 0 {
+			break
 		}
 	}
+	return nil
+}
 
-	return cms, nil
+// commitFromPush builds a git.Commit from a commit in the push event e.
+func commitFromPush(e *ghlib.Event, pc *ghlib.PushEventCommit) git.Commit {
+	var committer *git.CommitAuthor
+	if c := pc.GetCommitter(); c != nil {
+		ca := authorFromGH(c)
+		committer = &ca
+	}
+
+	repo := e.GetRepo().GetName()
+	return git.Commit{
+		SHA:       pc.GetSHA(),
+		Author:    authorFromGH(pc.GetAuthor()),
+		Committer: committer,
+		Message:   pc.GetMessage(),
+		URL: fmt.Sprintf(
+			"%s/%s/commit/%s",
+			_homeURL,
+			repo,
+			pc.GetSHA(),
+		),
+		Repo: git.Repo{
+			Name: repo,
+			URL:  fmt.Sprintf("%s/%s", _homeURL, repo),
+		},
+		Timestamp: e.GetCreatedAt(),
+	}
 }
 
-func committerFromGH(ca *ghlib.CommitAuthor) *git.CommitAuthor {
+// authorFromGH converts a ghlib.CommitAuthor into a git.CommitAuthor.
+func authorFromGH(ca *ghlib.CommitAuthor) git.CommitAuthor {
 	if ca == nil {
-		return nil
+		return git.CommitAuthor{}
+	}
+	return git.CommitAuthor{
+		Name:  ca.GetName(),
+		Email: ca.GetEmail(),
+		Login: ca.Login,
+		Date:  ca.Date,
 	}
-	conv := git.CommitAuthor(*ca)
-	return &conv
 }
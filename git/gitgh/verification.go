@@ -0,0 +1,66 @@
+package gitgh
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/pkg/github"
+)
+
+// NewVerificationSource creates a new git.VerificationSource that looks up the
+// signature verification of commits in GitHub repositories using l.
+//
+// Commits in repositories that are not hosted on GitHub have unknown
+// verifications.
+func NewVerificationSource(l *github.CommitLoader) git.VerificationSource {
+	return verificationSource{loader: l}
+}
+
+type verificationSource struct {
+	loader *github.CommitLoader
+}
+
+var _ git.VerificationSource = (*verificationSource)(nil)
+
+func (src verificationSource) CommitVerification(
+	ctx context.Context,
+	c git.Commit,
+) (*git.Verification, error) {
+	if !strings.HasPrefix(c.Repo.URL, _homeURL+"/") {
+		return nil, nil
+	}
+	gc, err := src.loader.Load(ctx, c.Repo.Name, c.SHA)
+	if err != nil {
+		return nil, errors.Wrap(err, "gitgh: load commit")
+	}
+	if gc == nil {
+		return nil, nil
+	}
+
+	sig := gc.Signature
+	if sig == nil {
+		return &git.Verification{State: "UNSIGNED"}, nil
+	}
+	ver := git.Verification{
+		Signed:   true,
+		Verified: sig.IsValid && (sig.State == "VALID"),
+		State:    sig.State,
+		Signer:   sig.Signer,
+	}
+	var format git.SignatureFormat
+	switch sig.Type {
+	case "GpgSignature":
+		format = git.SignatureGPG
+	case "SshSignature":
+		format = git.SignatureSSH
+	case "SmimeSignature":
+		format = git.SignatureSMIME
+	}
+	if format != "" {
+		ver.Format = &format
+	}
+	return &ver, nil
+}
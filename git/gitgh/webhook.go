@@ -0,0 +1,116 @@
+package gitgh
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	ghlib "github.com/google/go-github/v25/github"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+// CommitsFromWebhook parses the payload of a GitHub webhook event of type
+// eventType (the value of the 'X-GitHub-Event' header) into git.Commits,
+// ordered from newest to oldest.
+//
+// Push events yield their pushed commits, and pull request events yield the
+// merge commit of a merged pull request. Other events yield no commits, as do
+// pushes and merges by users other than login (since they aren't mine).
+func CommitsFromWebhook(
+	eventType string,
+	payload []byte,
+	login string,
+) ([]git.Commit, error) {
+	switch eventType {
+	case "push", "pull_request":
+	default:
+		return nil, nil
+	}
+	event, err := ghlib.ParseWebHook(eventType, payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "gitgh: parse webhook payload")
+	}
+
+	switch e := event.(type) {
+	case *ghlib.PushEvent:
+		// The pusher's name is their login.
+		pusher := e.GetSender().GetLogin()
+		if pusher == "" {
+			pusher = e.GetPusher().GetName()
+		}
+		if !strings.EqualFold(pusher, login) {
+			return nil, nil
+		}
+		var (
+			r    = e.GetRepo()
+			repo = git.Repo{Name: r.GetFullName(), URL: r.GetHTMLURL()}
+			ts   = r.GetPushedAt().Time
+		)
+		if ts.IsZero() {
+			ts = time.Now()
+		}
+
+		// Push commits are ordered from oldest to newest.
+		cms := make([]git.Commit, 0, len(e.Commits))
+		for i := len(e.Commits) - 1; i >= 0; i-- {
+			pc := &e.Commits[i]
+			var committer *git.CommitAuthor
+			if c := pc.GetCommitter(); c != nil {
+				ca := authorFromGH(c)
+				committer = &ca
+			}
+			author := authorFromGH(pc.GetAuthor())
+			if t := pc.GetTimestamp().Time; (author.Date == nil) && !t.IsZero() {
+				author.Date = &t
+			}
+			cms = append(cms, git.Commit{
+				SHA:       pc.GetID(),
+				Author:    author,
+				Committer: committer,
+				Message:   pc.GetMessage(),
+				URL:       pc.GetURL(),
+				Repo:      repo,
+				Timestamp: ts,
+			})
+		}
+		return cms, nil
+
+	case *ghlib.PullRequestEvent:
+		pr := e.GetPullRequest()
+		if (e.GetAction() != "closed") || !pr.GetMerged() {
+			return nil, nil
+		}
+		var (
+			r    = e.GetRepo()
+			sha  = pr.GetMergeCommitSHA()
+			by   = pr.GetMergedBy()
+			date = pr.GetMergedAt()
+		)
+		if !strings.EqualFold(by.GetLogin(), login) {
+			return nil, nil
+		}
+		return []git.Commit{{
+			SHA: sha,
+			Author: git.CommitAuthor{
+				Name:  by.GetName(),
+				Email: by.GetEmail(),
+				Login: by.Login,
+				Date:  &date,
+			},
+			Message: fmt.Sprintf("Merge pull request #%d: %s",
+				pr.GetNumber(), pr.GetTitle(),
+			),
+			URL: fmt.Sprintf("%s/commit/%s", r.GetHTMLURL(), sha),
+			Repo: git.Repo{
+				Name: r.GetFullName(),
+				URL:  r.GetHTMLURL(),
+			},
+			Timestamp: date,
+		}}, nil
+
+	default:
+		return nil, nil
+	}
+}
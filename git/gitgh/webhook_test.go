@@ -0,0 +1,110 @@
+package gitgh
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCommitsFromWebhook(t *testing.T) {
+	const (
+		pushPayload = `{
+  "ref": "refs/heads/master",
+  "repository": {
+    "full_name": "octo/api",
+    "html_url": "https://github.com/octo/api"
+  },
+  "pusher": {"name": "%s", "email": "pusher@example.com"},
+  "sender": {"login": "%s"},
+  "commits": [
+    {
+      "id": "aaa",
+      "message": "Add a feature",
+      "url": "https://github.com/octo/api/commit/aaa",
+      "timestamp": "2019-10-28T09:30:00-04:00",
+      "author": {"name": "Pusher", "email": "pusher@example.com"}
+    },
+    {
+      "id": "bbb",
+      "message": "Fix the feature",
+      "url": "https://github.com/octo/api/commit/bbb",
+      "timestamp": "2019-10-28T10:30:00-04:00",
+      "author": {"name": "Pusher", "email": "pusher@example.com"}
+    }
+  ]
+}`
+		mergePayload = `{
+  "action": "closed",
+  "repository": {
+    "full_name": "octo/api",
+    "html_url": "https://github.com/octo/api"
+  },
+  "pull_request": {
+    "number": 12,
+    "title": "Add a feature",
+    "merged": true,
+    "merged_at": "2019-10-28T11:00:00Z",
+    "merge_commit_sha": "ccc",
+    "merged_by": {"login": "%s"}
+  }
+}`
+	)
+	sprintf := func(format string, args ...interface{}) []byte {
+		return []byte(fmt.Sprintf(format, args...))
+	}
+
+	tests := []struct {
+		name      string
+		eventType string
+		payload   []byte
+		want      []string
+	}{
+		{
+			name:      "my push",
+			eventType: "push",
+			payload:   sprintf(pushPayload, "Me", "me"),
+			want:      []string{"bbb", "aaa"},
+		},
+		{
+			name:      "foreign push",
+			eventType: "push",
+			payload:   sprintf(pushPayload, "someone", "someone"),
+		},
+		{
+			name:      "my merge",
+			eventType: "pull_request",
+			payload:   sprintf(mergePayload, "me"),
+			want:      []string{"ccc"},
+		},
+		{
+			name:      "foreign merge",
+			eventType: "pull_request",
+			payload:   sprintf(mergePayload, "someone"),
+		},
+		{
+			name:      "other event",
+			eventType: "star",
+			payload:   []byte(`{}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cms, err := CommitsFromWebhook(tt.eventType, tt.payload, "me")
+			if err != nil {
+				t.Fatalf("parse webhook: %v", err)
+			}
+			shas := make([]string, len(cms))
+			for i := range cms {
+				shas[i] = cms[i].SHA
+			}
+			if len(shas) != len(tt.want) {
+				t.Fatalf("got commits %v, want %v", shas, tt.want)
+			}
+			for i := range shas {
+				if shas[i] != tt.want[i] {
+					t.Errorf("got commits %v, want %v", shas, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package gitgl
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/pkg/gitlab"
+)
+
+// NewSource creates a new git.Source that reads commits from the push events
+// of the authenticated GitLab user.
+func NewSource(c *gitlab.Client) git.Source {
+	return source{client: c}
+}
+
+const (
+	_eventsPerPage = 50
+	_maxEventsPage = 10
+
+	// _maxPushCommits is the maximum number of commits read from each push,
+	// consistent with the GitHub Events API.
+	_maxPushCommits = 20
+)
+
+type source struct {
+	client *gitlab.Client
+}
+
+var _ git.Source = (*source)(nil)
+
+type (
+	event struct {
+		ProjectID int       `json:"project_id"`
+		CreatedAt time.Time `json:"created_at"`
+		PushData  *struct {
+			RefType     string  `json:"ref_type"`
+			CommitCount int     `json:"commit_count"`
+			CommitFrom  *string `json:"commit_from"`
+			CommitTo    *string `json:"commit_to"`
+		} `json:"push_data"`
+	}
+
+	project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+		WebURL            string `json:"web_url"`
+	}
+
+	commit struct {
+		ID             string     `json:"id"`
+		Message        string     `json:"message"`
+		AuthorName     string     `json:"author_name"`
+		AuthorEmail    string     `json:"author_email"`
+		AuthoredDate   *time.Time `json:"authored_date"`
+		CommitterName  string     `json:"committer_name"`
+		CommitterEmail string     `json:"committer_email"`
+		CommittedDate  *time.Time `json:"committed_date"`
+		WebURL         string     `json:"web_url"`
+	}
+)
+
+// RecentCommits retrieves the latest `limit` Git commits across unique
+// repositories.
+func (src source) RecentCommits(
+	ctx context.Context,
+	limit int,
+) ([]git.Commit, error) {
+	var (
+		cms          = make([]git.Commit, 0, limit)
+		seenProjects = make(map[int]zero.Struct)
+	)
+	err := src.forEachPush(ctx, func(e *event, p *project) (bool, error) {
+		if _, ok := seenProjects[e.ProjectID]; ok { // enforce uniqueness
+			return true, nil
+		}
+
+		var c commit
+		if err := src.client.GetJSON(
+			ctx,
+			fmt.Sprintf(
+				"/projects/%d/repository/commits/%s",
+				e.ProjectID, url.PathEscape(*e.PushData.CommitTo),
+			),
+			&c,
+		); err != nil {
+			return false, errors.Wrap(err, "gitgl: get commit")
+		}
+
+		cms = append(cms, c.toGit(e, p))
+		seenProjects[e.ProjectID] = zero.Empty()
+		return len(cms) < limit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cms, nil
+}
+
+// CommitHistory retrieves every commit in each recent push, ordered from
+// newest to oldest.
+func (src source) CommitHistory(ctx context.Context) ([]git.Commit, error) {
+	var (
+		cms  []git.Commit
+		seen = make(map[string]zero.Struct)
+	)
+	err := src.forEachPush(ctx, func(e *event, p *project) (bool, error) {
+		pushed, err := src.pushCommits(ctx, e)
+		if err != nil {
+			return false, err
+		}
+		for i := range pushed {
+			c := &pushed[i]
+			if _, ok := seen[c.ID]; ok { // pushed to multiple branches
+				continue
+			}
+			cms = append(cms, c.toGit(e, p))
+			seen[c.ID] = zero.Empty()
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cms, nil
+}
+
+// pushCommits lists the commits in the push event e, from newest to oldest.
+func (src source) pushCommits(ctx context.Context, e *event) ([]commit, error) {
+	var (
+		pd   = e.PushData
+		base = fmt.Sprintf("/projects/%d/repository", e.ProjectID)
+	)
+
+	// If the push created a branch, there is nothing to compare against, so
+	// list the latest commits on the branch instead.
+	if pd.CommitFrom == nil {
+		n := pd.CommitCount
+		if (n <= 0) || (n > _maxPushCommits) {
+			n = _maxPushCommits
+		}
+		ps := make(url.Values)
+		ps.Set("ref_name", *pd.CommitTo)
+		ps.Set("per_page", fmt.Sprint(n))
+
+		var cms []commit
+		if err := src.client.GetJSON(
+			ctx,
+			base+"/commits?"+ps.Encode(),
+			&cms,
+		); err != nil {
+			return nil, errors.Wrap(err, "gitgl: list commits")
+		}
+		return cms, nil
+	}
+
+	ps := make(url.Values)
+	ps.Set("from", *pd.CommitFrom)
+	ps.Set("to", *pd.CommitTo)
+
+	var data struct {
+		Commits []commit `json:"commits"` // oldest to newest
+	}
+	if err := src.client.GetJSON(
+		ctx,
+		base+"/compare?"+ps.Encode(),
+		&data,
+	); err != nil {
+		return nil, errors.Wrap(err, "gitgl: compare commits")
+	}
+
+	cms := data.Commits
+	if len(cms) > _maxPushCommits {
+		cms = cms[len(cms)-_maxPushCommits:]
+	}
+	for i, j := 0, len(cms)-1; i < j; i, j = i+1, j-1 {
+		cms[i], cms[j] = cms[j], cms[i]
+	}
+	return cms, nil
+}
+
+// forEachPush calls fn with each of the current user's recent branch push
+// events (and the project that they were pushed to), from newest to oldest,
+// until fn returns false or an error.
+func (src source) forEachPush(
+	ctx context.Context,
+	fn func(*event, *project) (bool, error),
+) error {
+	user, err := src.client.CurrentUser(ctx)
+	if err != nil {
+		return errors.Wrap(err, "gitgl: getting current user")
+	}
+
+	projects := make(map[int]*project)
+	for page := 1; page <= _maxEventsPage; page++ {
+		var events []event
+		{
+			ps := make(url.Values)
+			ps.Set("action", "pushed")
+			ps.Set("per_page", fmt.Sprint(_eventsPerPage))
+			ps.Set("page", fmt.Sprint(page))
+			path := fmt.Sprintf("/users/%d/events?%s", user.ID, ps.Encode())
+			if err = src.client.GetJSON(ctx, path, &events); err != nil {
+				return errors.Wrap(err, "gitgl: list user events")
+			}
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for i := range events {
+			e := &events[i]
+			pd := e.PushData
+			if (pd == nil) || (pd.RefType != "branch") || (pd.CommitTo == nil) {
+				continue
+			}
+
+			p, ok := projects[e.ProjectID]
+			if !ok {
+				p = new(project)
+				if err = src.client.GetJSON(
+					ctx,
+					fmt.Sprintf("/projects/%d", e.ProjectID),
+					p,
+				); err != nil {
+					return errors.Wrap(err, "gitgl: get project")
+				}
+				projects[e.ProjectID] = p
+			}
+
+			next, err := fn(e, p)
+			if err != nil {
+				return err
+			}
+			if !next {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// toGit converts c, which was pushed to p in e, into a git.Commit.
+func (c *commit) toGit(e *event, p *project) git.Commit {
+	return git.Commit{
+		SHA: c.ID,
+		Author: git.CommitAuthor{
+			Name:  c.AuthorName,
+			Email: c.AuthorEmail,
+			Date:  c.AuthoredDate,
+		},
+		Committer: &git.CommitAuthor{
+			Name:  c.CommitterName,
+			Email: c.CommitterEmail,
+			Date:  c.CommittedDate,
+		},
+		Message: c.Message,
+		URL:     c.WebURL,
+		Repo: git.Repo{
+			Name: p.PathWithNamespace,
+			URL:  p.WebURL,
+		},
+		Timestamp: e.CreatedAt,
+	}
+}
@@ -2,18 +2,21 @@ package gitgql
 
 import (
 	"context"
+	"time"
 
 	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/git/stats"
 )
 
 // NewQuery creates a new Query.
-func NewQuery(svc git.Service) Query {
-	return Query{svc: svc}
+func NewQuery(svc git.Service, stats stats.Service) Query {
+	return Query{svc: svc, stats: stats}
 }
 
-// A Query resolves queries for my scheduling-related data.
+// A Query resolves queries for my Git-related data.
 type Query struct {
-	svc git.Service
+	svc   git.Service
+	stats stats.Service
 }
 
 // RecentCommits looks up my recent commits.
@@ -30,3 +33,62 @@ func (q Query) RecentCommits(
 		},
 	)
 }
+
+// Commits looks up a page of my recently pushed commits.
+func (q Query) Commits(
+	ctx context.Context,
+	repo *string,
+	since, until *time.Time,
+	workOnly *bool,
+	first *int,
+	after *string,
+) (*git.CommitPage, error) {
+	return q.svc.Commits(
+		ctx,
+		func(opt *git.CommitsOptions) {
+			opt.Repo = repo
+			opt.Since = since
+			opt.Until = until
+			opt.After = after
+			if workOnly != nil {
+				opt.WorkOnly = *workOnly
+			}
+			if first != nil {
+				opt.First = *first
+			}
+		},
+	)
+}
+
+// Contributions looks up my contribution calendar and coding statistics.
+func (q Query) Contributions(
+	ctx context.Context,
+	from, to *time.Time,
+) (*stats.Contributions, error) {
+	return q.stats.Contributions(
+		ctx,
+		func(opt *stats.ContributionsOptions) {
+			opt.From = from
+			opt.To = to
+		},
+	)
+}
+
+// Activity looks up my recent Git activity.
+func (q Query) Activity(
+	ctx context.Context,
+	types []string,
+	first *int,
+) ([]git.Activity, error) {
+	return q.svc.Activity(
+		ctx,
+		func(opt *git.ActivityOptions) {
+			for _, t := range types {
+				opt.Types = append(opt.Types, git.ActivityType(t))
+			}
+			if first != nil {
+				opt.First = *first
+			}
+		},
+	)
+}
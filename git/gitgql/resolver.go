@@ -0,0 +1,199 @@
+package gitgql
+
+import (
+	"context"
+	"time"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/git/stats"
+)
+
+// A ContributionsResolver resolves fields for a stats.Contributions.
+type ContributionsResolver struct{}
+
+//revive:disable-line:exported
+func (ContributionsResolver) BusiestWeekday(
+	_ context.Context,
+	cbs *stats.Contributions,
+) (*string, error) {
+	if cbs.BusiestWeekday == nil {
+		return nil, nil
+	}
+	s := cbs.BusiestWeekday.String()
+	return &s, nil
+}
+
+// A PullRequestActivityResolver resolves fields for a git.PullRequestActivity.
+type PullRequestActivityResolver struct{}
+
+//revive:disable-line:exported
+func (PullRequestActivityResolver) Action(
+	_ context.Context,
+	a *git.PullRequestActivity,
+) (string, error) {
+	return string(a.Action), nil
+}
+
+// A ReviewActivityResolver resolves fields for a git.ReviewActivity.
+type ReviewActivityResolver struct{}
+
+//revive:disable-line:exported
+func (ReviewActivityResolver) State(
+	_ context.Context,
+	a *git.ReviewActivity,
+) (string, error) {
+	return string(a.State), nil
+}
+
+// An IssueActivityResolver resolves fields for a git.IssueActivity.
+type IssueActivityResolver struct{}
+
+//revive:disable-line:exported
+func (IssueActivityResolver) Action(
+	_ context.Context,
+	a *git.IssueActivity,
+) (string, error) {
+	return string(a.Action), nil
+}
+
+// NewRepoResolver creates a new RepoResolver.
+func NewRepoResolver(svc git.Service) RepoResolver {
+	return RepoResolver{svc: svc}
+}
+
+// A RepoResolver resolves fields for a git.Repo, using its git.RepoMeta.
+//
+// Since each field looks up the git.RepoMeta separately, svc should batch and
+// cache these lookups.
+type RepoResolver struct {
+	svc git.Service
+}
+
+//revive:disable-line:exported
+func (res RepoResolver) Description(
+	ctx context.Context,
+	repo *git.Repo,
+) (*string, error) {
+	meta, err := res.svc.RepoMeta(ctx, *repo)
+	if err != nil || (meta == nil) {
+		return nil, err
+	}
+	return meta.Description, nil
+}
+
+//revive:disable-line:exported
+func (res RepoResolver) PrimaryLanguage(
+	ctx context.Context,
+	repo *git.Repo,
+) (*string, error) {
+	meta, err := res.svc.RepoMeta(ctx, *repo)
+	if err != nil || (meta == nil) {
+		return nil, err
+	}
+	return meta.PrimaryLanguage, nil
+}
+
+//revive:disable-line:exported
+func (res RepoResolver) Languages(
+	ctx context.Context,
+	repo *git.Repo,
+) ([]git.RepoLanguage, error) {
+	meta, err := res.svc.RepoMeta(ctx, *repo)
+	if err != nil || (meta == nil) {
+		return nil, err
+	}
+	return meta.Languages, nil
+}
+
+//revive:disable-line:exported
+func (res RepoResolver) Stars(
+	ctx context.Context,
+	repo *git.Repo,
+) (*int, error) {
+	meta, err := res.svc.RepoMeta(ctx, *repo)
+	if err != nil || (meta == nil) {
+		return nil, err
+	}
+	return &meta.Stars, nil
+}
+
+//revive:disable-line:exported
+func (res RepoResolver) Topics(
+	ctx context.Context,
+	repo *git.Repo,
+) ([]string, error) {
+	meta, err := res.svc.RepoMeta(ctx, *repo)
+	if err != nil || (meta == nil) {
+		return nil, err
+	}
+	return meta.Topics, nil
+}
+
+//revive:disable-line:exported
+func (res RepoResolver) PushedAt(
+	ctx context.Context,
+	repo *git.Repo,
+) (*time.Time, error) {
+	meta, err := res.svc.RepoMeta(ctx, *repo)
+	if err != nil || (meta == nil) {
+		return nil, err
+	}
+	return meta.PushedAt, nil
+}
+
+//revive:disable-line:exported
+func (res RepoResolver) IsFork(
+	ctx context.Context,
+	repo *git.Repo,
+) (*bool, error) {
+	meta, err := res.svc.RepoMeta(ctx, *repo)
+	if err != nil || (meta == nil) {
+		return nil, err
+	}
+	return &meta.IsFork, nil
+}
+
+//revive:disable-line:exported
+func (res RepoResolver) IsArchived(
+	ctx context.Context,
+	repo *git.Repo,
+) (*bool, error) {
+	meta, err := res.svc.RepoMeta(ctx, *repo)
+	if err != nil || (meta == nil) {
+		return nil, err
+	}
+	return &meta.IsArchived, nil
+}
+
+// NewCommitResolver creates a new CommitResolver.
+func NewCommitResolver(svc git.Service) CommitResolver {
+	return CommitResolver{svc: svc}
+}
+
+// A CommitResolver resolves fields for a git.Commit.
+type CommitResolver struct {
+	svc git.Service
+}
+
+//revive:disable-line:exported
+func (res CommitResolver) Verification(
+	ctx context.Context,
+	c *git.Commit,
+) (*git.Verification, error) {
+	return res.svc.CommitVerification(ctx, *c)
+}
+
+// A VerificationResolver resolves fields for a git.Verification.
+type VerificationResolver struct{}
+
+//revive:disable-line:exported
+func (VerificationResolver) Format(
+	_ context.Context,
+	v *git.Verification,
+) (*string, error) {
+	if v.Format == nil {
+		return nil, nil
+	}
+	s := string(*v.Format)
+	return &s, nil
+}
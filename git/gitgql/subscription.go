@@ -0,0 +1,44 @@
+package gitgql
+
+import (
+	"context"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+// NewSubscriptionResolver creates a new SubscriptionResolver.
+func NewSubscriptionResolver(stream git.Streamer) SubscriptionResolver {
+	return SubscriptionResolver{stream: stream}
+}
+
+// A SubscriptionResolver resolves Git-related GraphQL subscriptions.
+type SubscriptionResolver struct {
+	stream git.Streamer
+}
+
+// Commits opens a stream of newly pushed commits.
+func (res SubscriptionResolver) Commits(ctx context.Context) (
+	<-chan *git.Commit,
+	error,
+) {
+	var (
+		src = make(chan git.Commit, 1)
+		dst = make(chan *git.Commit, 1)
+	)
+
+	go func(src <-chan git.Commit, dst chan<- *git.Commit) {
+		for c := range src {
+			c := c
+			select {
+			case dst <- &c:
+			case <-ctx.Done():
+			}
+		}
+		close(dst)
+	}(src, dst)
+
+	if err := res.stream.StreamCommits(ctx, src); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
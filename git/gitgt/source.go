@@ -0,0 +1,184 @@
+package gitgt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/pkg/gitea"
+)
+
+// NewSource creates a new git.Source that reads commits from the activity feed
+// of the authenticated Gitea user.
+func NewSource(c *gitea.Client) git.Source {
+	return source{client: c}
+}
+
+const (
+	_pushOpType        = "commit_repo"
+	_activitiesPerPage = 50
+	_maxActivitiesPage = 10
+)
+
+type source struct {
+	client *gitea.Client
+}
+
+var _ git.Source = (*source)(nil)
+
+type (
+	activity struct {
+		OpType  string    `json:"op_type"`
+		Content string    `json:"content"` // JSON-encoded pushCommits
+		Created time.Time `json:"created"`
+		Repo    *struct {
+			FullName string `json:"full_name"`
+			HTMLURL  string `json:"html_url"`
+		} `json:"repo"`
+	}
+
+	// pushCommits is the content of a push activity; commits are ordered from
+	// newest to oldest.
+	pushCommits struct {
+		Commits []pushCommit
+	}
+
+	pushCommit struct {
+		Sha1           string
+		Message        string
+		AuthorEmail    string
+		AuthorName     string
+		CommitterEmail string
+		CommitterName  string
+		Timestamp      *time.Time
+	}
+)
+
+// RecentCommits retrieves the latest `limit` Git commits across unique
+// repositories.
+func (src source) RecentCommits(
+	ctx context.Context,
+	limit int,
+) ([]git.Commit, error) {
+	var (
+		cms       = make([]git.Commit, 0, limit)
+		seenRepos = make(map[string]zero.Struct)
+	)
+	err := src.forEachPush(ctx, func(a *activity, push *pushCommits) bool {
+		repo := a.Repo.FullName
+		if _, ok := seenRepos[repo]; ok { // enforce uniqueness
+			return true
+		}
+
+		cms = append(cms, push.Commits[0].toGit(a))
+		seenRepos[repo] = zero.Empty()
+		return len(cms) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cms, nil
+}
+
+// CommitHistory retrieves every commit in each recent push, ordered from
+// newest to oldest.
+func (src source) CommitHistory(ctx context.Context) ([]git.Commit, error) {
+	var (
+		cms  []git.Commit
+		seen = make(map[string]zero.Struct)
+	)
+	err := src.forEachPush(ctx, func(a *activity, push *pushCommits) bool {
+		for i := range push.Commits {
+			c := &push.Commits[i]
+			if _, ok := seen[c.Sha1]; ok { // pushed to multiple branches
+				continue
+			}
+			cms = append(cms, c.toGit(a))
+			seen[c.Sha1] = zero.Empty()
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cms, nil
+}
+
+// forEachPush calls fn with each of the current user's recent, non-empty push
+// activities, from newest to oldest, until fn returns false.
+func (src source) forEachPush(
+	ctx context.Context,
+	fn func(*activity, *pushCommits) bool,
+) error {
+	user, err := src.client.CurrentUser(ctx)
+	if err != nil {
+		return errors.Wrap(err, "gitgt: getting current user")
+	}
+
+	for page := 1; page <= _maxActivitiesPage; page++ {
+		var acts []activity
+		{
+			ps := make(url.Values)
+			ps.Set("only-performed-by", "true")
+			ps.Set("limit", fmt.Sprint(_activitiesPerPage))
+			ps.Set("page", fmt.Sprint(page))
+			path := fmt.Sprintf(
+				"/users/%s/activities/feeds?%s",
+				url.PathEscape(user.Login), ps.Encode(),
+			)
+			if err = src.client.GetJSON(ctx, path, &acts); err != nil {
+				return errors.Wrap(err, "gitgt: list user activities")
+			}
+		}
+		if len(acts) == 0 {
+			break
+		}
+
+		for i := range acts {
+			a := &acts[i]
+			if (a.OpType != _pushOpType) || (a.Repo == nil) {
+				continue
+			}
+			var push pushCommits
+			if err = json.Unmarshal([]byte(a.Content), &push); err != nil {
+				return errors.Wrap(err, "gitgt: parse activity content")
+			}
+			if len(push.Commits) == 0 {
+				continue
+			}
+			if !fn(a, &push) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// toGit converts c, which was pushed in a, into a git.Commit.
+func (c *pushCommit) toGit(a *activity) git.Commit {
+	return git.Commit{
+		SHA: c.Sha1,
+		Author: git.CommitAuthor{
+			Name:  c.AuthorName,
+			Email: c.AuthorEmail,
+			Date:  c.Timestamp,
+		},
+		Committer: &git.CommitAuthor{
+			Name:  c.CommitterName,
+			Email: c.CommitterEmail,
+		},
+		Message: c.Message,
+		URL:     fmt.Sprintf("%s/commit/%s", a.Repo.HTMLURL, c.Sha1),
+		Repo: git.Repo{
+			Name: a.Repo.FullName,
+			URL:  a.Repo.HTMLURL,
+		},
+		Timestamp: a.Created,
+	}
+}
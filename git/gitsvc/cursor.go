@@ -0,0 +1,62 @@
+package gitsvc
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+// A cursor identifies a position in a list of commits, ordered from newest to
+// oldest.
+//
+// Cursors identify commits by their SHA, so that they remain valid as new
+// commits are pushed. If a commit is no longer in the list, the position is
+// recovered using its timestamp.
+type cursor struct {
+	SHA       string
+	Timestamp time.Time
+}
+
+func encodeCursor(c *git.Commit) string {
+	raw := strconv.FormatInt(c.Timestamp.Unix(), 10) + ":" + c.SHA
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(s string) (cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor{}, errors.WithDetail(git.ErrInvalidCursor, err.Error())
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return cursor{}, git.ErrInvalidCursor
+	}
+	secs, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return cursor{}, errors.WithDetail(git.ErrInvalidCursor, err.Error())
+	}
+	return cursor{
+		SHA:       parts[1],
+		Timestamp: time.Unix(secs, 0),
+	}, nil
+}
+
+// index returns the index in cms of the first commit after the cursor.
+func (c cursor) index(cms []git.Commit) int {
+	for i := range cms {
+		if cms[i].SHA == c.SHA {
+			return i + 1
+		}
+	}
+	for i := range cms {
+		if cms[i].Timestamp.Before(c.Timestamp) {
+			return i
+		}
+	}
+	return len(cms)
+}
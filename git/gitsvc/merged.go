@@ -0,0 +1,135 @@
+package gitsvc
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+// NewMergedSource creates a git.Source that retrieves commits from each of
+// srcs concurrently, and interleaves them by timestamp (newest first).
+//
+// If some (but not all) of srcs fail, their errors are logged, and commits
+// from the remaining sources are returned.
+func NewMergedSource(srcs []git.Source, opts ...MergedSourceOption) git.Source {
+	if len(srcs) == 1 {
+		return srcs[0]
+	}
+	opt := MergedSourceOptions{
+		Logger: logutil.NoopEntry(),
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return mergedSource{
+		srcs: srcs,
+		log:  logutil.WithComponent(opt.Logger, (*mergedSource)(nil)),
+	}
+}
+
+// MergedSourceWithLogger configures a merged git.Source to write logs with
+// log.
+func MergedSourceWithLogger(log *logrus.Entry) MergedSourceOption {
+	return func(opt *MergedSourceOptions) { opt.Logger = log }
+}
+
+type (
+	mergedSource struct {
+		srcs []git.Source
+		log  *logrus.Entry
+	}
+
+	// MergedSourceOptions configures a merged git.Source.
+	MergedSourceOptions struct {
+		Logger *logrus.Entry
+	}
+
+	// A MergedSourceOption modifies a MergedSourceOptions.
+	MergedSourceOption func(*MergedSourceOptions)
+)
+
+var _ git.Source = (*mergedSource)(nil)
+
+func (ms mergedSource) RecentCommits(
+	ctx context.Context,
+	limit int,
+) ([]git.Commit, error) {
+	cms, err := ms.merge(
+		logutil.WithMethod(ms.log, mergedSource.RecentCommits).WithContext(ctx),
+		func(src git.Source) ([]git.Commit, error) {
+			return src.RecentCommits(ctx, limit)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(cms) > limit {
+		cms = cms[:limit:limit]
+	}
+	return cms, nil
+}
+
+func (ms mergedSource) CommitHistory(ctx context.Context) ([]git.Commit, error) {
+	return ms.merge(
+		logutil.WithMethod(ms.log, mergedSource.CommitHistory).WithContext(ctx),
+		func(src git.Source) ([]git.Commit, error) {
+			return src.CommitHistory(ctx)
+		},
+	)
+}
+
+// merge calls get with each source concurrently, and interleaves the
+// resulting commits by timestamp.
+func (ms mergedSource) merge(
+	log *logrus.Entry,
+	get func(git.Source) ([]git.Commit, error),
+) ([]git.Commit, error) {
+	var (
+		results = make([][]git.Commit, len(ms.srcs))
+		errs    = make([]error, len(ms.srcs))
+		wg      sync.WaitGroup
+	)
+	for i, src := range ms.srcs {
+		wg.Add(1)
+		go func(i int, src git.Source) {
+			defer wg.Done()
+			results[i], errs[i] = get(src)
+		}(i, src)
+	}
+	wg.Wait()
+
+	var (
+		cms      []git.Commit
+		firstErr error
+		failures int
+	)
+	for i, err := range errs {
+		if err != nil {
+			log.
+				WithError(err).
+				WithField("source", name.OfTypeFull(ms.srcs[i])).
+				Warn("Failed to get commits from source.")
+			if firstErr == nil {
+				firstErr = err
+			}
+			failures++
+			continue
+		}
+		cms = append(cms, results[i]...)
+	}
+	if failures == len(ms.srcs) {
+		return nil, firstErr
+	}
+
+	// Stable sort, to preserve the order of commits within each push.
+	sort.SliceStable(cms, func(i, j int) bool {
+		return cms[i].Timestamp.After(cms[j].Timestamp)
+	})
+	return cms, nil
+}
@@ -2,6 +2,7 @@ package gitsvc
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -25,8 +26,13 @@ func NewServicePrecacher(
 		apply(&opt)
 	}
 	log := logutil.WithComponent(opt.Logger, (*ServicePrecacher)(nil))
+	limit := _defaultLimit
+	if l := opt.Limit; l != nil {
+		limit = *l
+	}
 	return ServicePrecacher{
 		Service: svc,
+		limit:   limit,
 		pc: poll.NewPrecacher(
 			poll.ProdFunc(func() (zero.Interface, error) {
 				return svc.RecentCommits(
@@ -49,7 +55,8 @@ type (
 	// a regular interval.
 	ServicePrecacher struct {
 		git.Service
-		pc *poll.Precacher
+		pc    *poll.Precacher
+		limit int
 	}
 
 	// A ServicePrecacherOptions configures a ServicePrecacher.
@@ -64,7 +71,10 @@ type (
 	ServicePrecacherOption func(*ServicePrecacherOptions)
 )
 
-var _ git.Service = (*ServicePrecacher)(nil)
+var (
+	_ git.Service        = (*ServicePrecacher)(nil)
+	_ git.CommitIngester = (*ServicePrecacher)(nil)
+)
 
 // RecentCommits implements git.Service for a ServicePrecacher.
 func (sp ServicePrecacher) RecentCommits(
@@ -90,5 +100,46 @@ func (sp ServicePrecacher) RecentCommits(
 	return nil, nil
 }
 
+// IngestCommits implements git.CommitIngester for a ServicePrecacher.
+//
+// It updates the cached recent commits with the latest of cms from each
+// repository, without waiting for the next poll.
+func (sp ServicePrecacher) IngestCommits(
+	_ context.Context,
+	cms []git.Commit,
+) error {
+	if len(cms) == 0 {
+		return nil
+	}
+	sp.pc.Update(func(v zero.Interface) zero.Interface {
+		prev, ok := v.([]git.Commit)
+		if !ok {
+			return v
+		}
+
+		// Take the latest new commit from each repo, followed by the previous
+		// commits from all other repos.
+		var (
+			next  = make([]git.Commit, 0, len(prev)+len(cms))
+			repos = make(map[string]zero.Struct)
+		)
+		for _, c := range append(cms[:len(cms):len(cms)], prev...) {
+			if _, ok := repos[c.Repo.URL]; ok {
+				continue
+			}
+			next = append(next, c)
+			repos[c.Repo.URL] = zero.Empty()
+		}
+		sort.SliceStable(next, func(i, j int) bool {
+			return next[i].Timestamp.After(next[j].Timestamp)
+		})
+		if len(next) > sp.limit {
+			next = next[:sp.limit:sp.limit]
+		}
+		return next
+	})
+	return nil
+}
+
 // Stop stops the ServicePrecacher.
 func (sp ServicePrecacher) Stop() { sp.pc.Stop() }
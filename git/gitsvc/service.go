@@ -3,6 +3,7 @@ package gitsvc
 import (
 	"context"
 
+	"github.com/cockroachdb/errors"
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/sirupsen/logrus"
 	"go.stevenxie.me/gopkg/logutil"
@@ -13,17 +14,39 @@ import (
 )
 
 // NewService creates a new git.Service.
-func NewService(src git.Source, opts ...basic.Option) git.Service {
+//
+// acts may be nil, in which case the service will not report any
+// git.Activity. Likewise, if metas or vers are nil, no git.RepoMeta or
+// git.Verifications will be known.
+func NewService(
+	src git.Source,
+	acts git.ActivitySource,
+	metas git.RepoMetaSource,
+	vers git.VerificationSource,
+	opts ...basic.Option,
+) git.Service {
 	cfg := basic.BuildOptions(opts...)
 	return service{
 		src:    src,
+		acts:   acts,
+		metas:  metas,
+		vers:   vers,
 		log:    logutil.WithComponent(cfg.Logger, (*service)(nil)),
 		tracer: cfg.Tracer,
 	}
 }
 
+// _defaultLimit is the default number of recent commits to retrieve.
+const _defaultLimit = 10
+
+// _maxPageSize is the maximum number of commits in a page.
+const _maxPageSize = 100
+
 type service struct {
 	src    git.Source
+	acts   git.ActivitySource
+	metas  git.RepoMetaSource
+	vers   git.VerificationSource
 	log    *logrus.Entry
 	tracer opentracing.Tracer
 }
@@ -41,7 +64,7 @@ func (svc service) RecentCommits(
 	defer span.Finish()
 
 	opt := git.RecentCommitsOptions{
-		Limit: 10,
+		Limit: _defaultLimit,
 	}
 	for _, apply := range opts {
 		apply(&opt)
@@ -60,3 +83,204 @@ func (svc service) RecentCommits(
 	}
 	return cms, nil
 }
+
+func (svc service) Commits(
+	ctx context.Context,
+	opts ...git.CommitsOption,
+) (*git.CommitPage, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.Commits),
+	)
+	defer span.Finish()
+
+	opt := git.CommitsOptions{
+		First: 20,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	if opt.First <= 0 {
+		return nil, errors.New("gitsvc: page size must be positive")
+	}
+	if opt.First > _maxPageSize {
+		return nil, errors.Newf(
+			"gitsvc: page size must be at most %d",
+			_maxPageSize,
+		)
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.Commits),
+		"first":           opt.First,
+	}).WithContext(ctx)
+
+	// Decode cursor.
+	var after *cursor
+	if opt.After != nil {
+		c, err := decodeCursor(*opt.After)
+		if err != nil {
+			return nil, err
+		}
+		after = &c
+	}
+
+	log.Trace("Getting commit history...")
+	cms, err := svc.src.CommitHistory(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to get Git commit history.")
+		return nil, err
+	}
+
+	// Filter commits.
+	{
+		filtered := make([]git.Commit, 0, len(cms))
+		for _, c := range cms {
+			if (opt.Repo != nil) && (c.Repo.Name != *opt.Repo) {
+				continue
+			}
+			if (opt.Since != nil) && c.Timestamp.Before(*opt.Since) {
+				continue
+			}
+			if (opt.Until != nil) && !c.Timestamp.Before(*opt.Until) {
+				continue
+			}
+			if opt.WorkOnly && !c.IsWork() {
+				continue
+			}
+			filtered = append(filtered, c)
+		}
+		cms = filtered
+	}
+
+	// Skip past cursor.
+	if after != nil {
+		cms = cms[after.index(cms):]
+	}
+
+	// Build page.
+	page := git.CommitPage{Commits: cms}
+	if len(cms) > opt.First {
+		page.Commits = cms[:opt.First:opt.First]
+		page.HasNextPage = true
+	}
+	if n := len(page.Commits); n > 0 {
+		c := encodeCursor(&page.Commits[n-1])
+		page.EndCursor = &c
+	}
+	return &page, nil
+}
+
+func (svc service) Activity(
+	ctx context.Context,
+	opts ...git.ActivityOption,
+) ([]git.Activity, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.Activity),
+	)
+	defer span.Finish()
+
+	opt := git.ActivityOptions{
+		First: 20,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	if opt.First <= 0 {
+		return nil, errors.New("gitsvc: page size must be positive")
+	}
+	types := make(map[git.ActivityType]bool, len(opt.Types))
+	for _, t := range opt.Types {
+		if err := t.Validate(); err != nil {
+			return nil, errors.WithDetailf(err, "Unknown type '%s'.", t)
+		}
+		types[t] = true
+	}
+
+	if svc.acts == nil {
+		return []git.Activity{}, nil
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.Activity),
+		"types":           opt.Types,
+		"first":           opt.First,
+	}).WithContext(ctx)
+
+	log.Trace("Getting recent activity...")
+	acts, err := svc.acts.RecentActivity(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to get recent Git activity.")
+		return nil, err
+	}
+
+	filtered := make([]git.Activity, 0, opt.First)
+	for _, a := range acts {
+		if (len(types) > 0) && !types[a.ActivityType()] {
+			continue
+		}
+		filtered = append(filtered, a)
+		if len(filtered) == opt.First {
+			break
+		}
+	}
+	return filtered, nil
+}
+
+func (svc service) RepoMeta(
+	ctx context.Context,
+	repo git.Repo,
+) (*git.RepoMeta, error) {
+	if svc.metas == nil {
+		return nil, nil
+	}
+
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.RepoMeta),
+	)
+	defer span.Finish()
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.RepoMeta),
+		"repo":            repo.Name,
+	}).WithContext(ctx)
+
+	log.Trace("Getting repository metadata...")
+	meta, err := svc.metas.RepoMeta(ctx, repo)
+	if err != nil {
+		log.WithError(err).Error("Failed to get Git repository metadata.")
+		return nil, err
+	}
+	return meta, nil
+}
+
+func (svc service) CommitVerification(
+	ctx context.Context,
+	c git.Commit,
+) (*git.Verification, error) {
+	if svc.vers == nil {
+		return nil, nil
+	}
+
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.CommitVerification),
+	)
+	defer span.Finish()
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.CommitVerification),
+		"repo":            c.Repo.Name,
+		"sha":             c.SHA,
+	}).WithContext(ctx)
+
+	log.Trace("Getting commit verification...")
+	ver, err := svc.vers.CommitVerification(ctx, c)
+	if err != nil {
+		log.WithError(err).Error("Failed to get Git commit verification.")
+		return nil, err
+	}
+	return ver, nil
+}
@@ -0,0 +1,179 @@
+package gitsvc
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/pkg/poll"
+)
+
+// NewSourcePrecacher creates a new SourcePrecacher.
+func NewSourcePrecacher(
+	src git.Source,
+	interval time.Duration,
+	opts ...SourcePrecacherOption,
+) SourcePrecacher {
+	opt := SourcePrecacherOptions{
+		Logger: logutil.NoopEntry(),
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	log := logutil.WithComponent(opt.Logger, (*SourcePrecacher)(nil))
+	return SourcePrecacher{
+		Source: src,
+		log:    log,
+		pc: poll.NewPrecacher(
+			poll.ProdFunc(func() (zero.Interface, error) {
+				return src.CommitHistory(context.Background())
+			}),
+			interval,
+			poll.PrecacherWithLogger(log),
+		),
+		ingested: new(ingestBuffer),
+	}
+}
+
+// SourcePrecacherWithLogger configures a SourcePrecacher to write logs with
+// log.
+func SourcePrecacherWithLogger(log *logrus.Entry) SourcePrecacherOption {
+	return func(opt *SourcePrecacherOptions) { opt.Logger = log }
+}
+
+type (
+	// A SourcePrecacher is a git.Source that precaches my commit history at a
+	// regular interval, so that it can be paged through without fetching it
+	// from every forge on each request.
+	SourcePrecacher struct {
+		git.Source
+		log *logrus.Entry
+		pc  *poll.Precacher
+
+		ingested *ingestBuffer
+	}
+
+	// A SourcePrecacherOptions configures a SourcePrecacher.
+	SourcePrecacherOptions struct {
+		Logger *logrus.Entry
+	}
+
+	// A SourcePrecacherOption modifies a SourcePrecacherOptions.
+	SourcePrecacherOption func(*SourcePrecacherOptions)
+)
+
+var (
+	_ git.Source         = (*SourcePrecacher)(nil)
+	_ git.CommitIngester = (*SourcePrecacher)(nil)
+)
+
+// CommitHistory implements git.Source for a SourcePrecacher.
+//
+// If the history has not been cached yet, it is fetched from the underlying
+// git.Source. Ingested commits are included until they appear in the fetched
+// history.
+func (sp SourcePrecacher) CommitHistory(ctx context.Context) ([]git.Commit, error) {
+	cms, err := sp.history(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sp.ingested.merge(cms), nil
+}
+
+func (sp SourcePrecacher) history(ctx context.Context) ([]git.Commit, error) {
+	v, err := sp.pc.Results()
+	if err == poll.ErrCacheEmpty {
+		logutil.
+			WithMethod(sp.log, SourcePrecacher.CommitHistory).
+			WithContext(ctx).
+			Debug("Commit history not yet cached; fetching it directly.")
+		return sp.Source.CommitHistory(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cms, ok := v.([]git.Commit); ok {
+		return cms, nil
+	}
+	return nil, nil
+}
+
+// IngestCommits implements git.CommitIngester for a SourcePrecacher.
+//
+// It adds cms to the commit history, without waiting for the next poll.
+func (sp SourcePrecacher) IngestCommits(
+	_ context.Context,
+	cms []git.Commit,
+) error {
+	sp.ingested.add(cms)
+	return nil
+}
+
+// An ingestBuffer holds ingested commits until they appear in the commit
+// history fetched from a git.Source.
+type ingestBuffer struct {
+	mux sync.Mutex
+	cms []git.Commit
+}
+
+func (buf *ingestBuffer) add(cms []git.Commit) {
+	buf.mux.Lock()
+	defer buf.mux.Unlock()
+	for _, c := range cms {
+		if !containsCommit(buf.cms, c.SHA) {
+			buf.cms = append(buf.cms, c)
+		}
+	}
+}
+
+// merge returns history with the buffered commits that it is missing, sorted
+// from newest to oldest. Buffered commits that are already in history are
+// discarded.
+func (buf *ingestBuffer) merge(history []git.Commit) []git.Commit {
+	buf.mux.Lock()
+	defer buf.mux.Unlock()
+	if len(buf.cms) == 0 {
+		return history
+	}
+
+	seen := make(map[string]zero.Struct, len(history))
+	for i := range history {
+		seen[history[i].SHA] = zero.Empty()
+	}
+	missing := buf.cms[:0]
+	for _, c := range buf.cms {
+		if _, ok := seen[c.SHA]; !ok {
+			missing = append(missing, c)
+		}
+	}
+	buf.cms = missing
+	if len(missing) == 0 {
+		return history
+	}
+
+	merged := make([]git.Commit, 0, len(history)+len(missing))
+	merged = append(merged, missing...)
+	merged = append(merged, history...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Timestamp.After(merged[j].Timestamp)
+	})
+	return merged
+}
+
+func containsCommit(cms []git.Commit, sha string) bool {
+	for i := range cms {
+		if cms[i].SHA == sha {
+			return true
+		}
+	}
+	return false
+}
+
+// Stop stops the SourcePrecacher.
+func (sp SourcePrecacher) Stop() { sp.pc.Stop() }
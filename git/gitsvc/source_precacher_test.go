@@ -0,0 +1,57 @@
+package gitsvc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+// historySource is a git.Source with a fixed commit history.
+type historySource struct {
+	git.Source
+
+	mux sync.Mutex
+	cms []git.Commit
+}
+
+func (src *historySource) CommitHistory(context.Context) ([]git.Commit, error) {
+	src.mux.Lock()
+	defer src.mux.Unlock()
+	return append([]git.Commit(nil), src.cms...), nil
+}
+
+func TestSourcePrecacherIngestCommits(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		now  = time.Now()
+		old  = git.Commit{SHA: "old", Timestamp: now.Add(-time.Hour)}
+		next = git.Commit{SHA: "new", Timestamp: now}
+		src  = &historySource{cms: []git.Commit{old}}
+		sp   = NewSourcePrecacher(src, time.Hour)
+	)
+	defer sp.Stop()
+
+	// Commits are ingested whether or not the history has been cached yet.
+	if err := sp.IngestCommits(ctx, []git.Commit{next}); err != nil {
+		t.Fatalf("ingest commits: %v", err)
+	}
+	cms, err := sp.CommitHistory(ctx)
+	if err != nil {
+		t.Fatalf("get commit history: %v", err)
+	}
+	if (len(cms) != 2) || (cms[0].SHA != "new") || (cms[1].SHA != "old") {
+		t.Errorf("history = %+v, want [new old]", cms)
+	}
+
+	// Ingested commits are kept until the source catches up.
+	history := []git.Commit{next, old}
+	if cms = sp.ingested.merge(history); len(cms) != 2 {
+		t.Errorf("history = %+v, want [new old]", cms)
+	}
+	if n := len(sp.ingested.cms); n != 0 {
+		t.Errorf("got %d buffered commits, want 0", n)
+	}
+}
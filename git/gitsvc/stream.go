@@ -0,0 +1,121 @@
+package gitsvc
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/pkg/fanout"
+)
+
+// NewStreamer creates a new Streamer.
+func NewStreamer(opts ...StreamerOption) *Streamer {
+	opt := StreamerOptions{
+		Logger:     logutil.NoopEntry(),
+		BufferSize: 16,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	log := logutil.WithComponent(opt.Logger, (*Streamer)(nil))
+	return &Streamer{
+		hub: fanout.NewHub(
+			fanout.HubWithLogger(log),
+			fanout.HubWithBufferSize(opt.BufferSize),
+		),
+	}
+}
+
+// StreamerWithLogger configures a Streamer to write logs with log.
+func StreamerWithLogger(log *logrus.Entry) StreamerOption {
+	return func(opt *StreamerOptions) { opt.Logger = log }
+}
+
+// StreamerWithBufferSize configures the number of commits that a Streamer
+// buffers for each subscriber; if a subscriber falls behind, its oldest
+// buffered commits are dropped.
+func StreamerWithBufferSize(size int) StreamerOption {
+	return func(opt *StreamerOptions) { opt.BufferSize = size }
+}
+
+type (
+	// A Streamer is a git.Streamer that streams the commits that it ingests.
+	Streamer struct {
+		hub *fanout.Hub
+	}
+
+	// StreamerOptions configures a Streamer.
+	StreamerOptions struct {
+		Logger     *logrus.Entry
+		BufferSize int
+	}
+
+	// A StreamerOption modifies a StreamerOptions.
+	StreamerOption func(*StreamerOptions)
+)
+
+var (
+	_ git.Streamer       = (*Streamer)(nil)
+	_ git.CommitIngester = (*Streamer)(nil)
+)
+
+// IngestCommits implements git.CommitIngester.
+func (s *Streamer) IngestCommits(_ context.Context, cms []git.Commit) error {
+	for i := len(cms) - 1; i >= 0; i-- {
+		s.hub.Publish(cms[i])
+	}
+	return nil
+}
+
+// StreamCommits implements git.Streamer.
+func (s *Streamer) StreamCommits(
+	ctx context.Context,
+	ch chan<- git.Commit,
+) error {
+	if ch == nil {
+		panic(errors.New("gitsvc: nil channel"))
+	}
+	send := func(ctx context.Context, v zero.Interface) bool {
+		select {
+		case ch <- v.(git.Commit):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	if err := s.hub.Subscribe(
+		ctx, send,
+		func() { close(ch) },
+	); err != nil {
+		return errors.Wrap(err, "gitsvc: subscribe to commits")
+	}
+	return nil
+}
+
+// Stop stops the Streamer, and closes all open streams.
+func (s *Streamer) Stop() { s.hub.Close() }
+
+// NewIngester creates a git.CommitIngester that passes commits to each of
+// ings, in order.
+//
+// If an ingester fails, the commits are still passed to the rest; the first
+// error is returned.
+func NewIngester(ings ...git.CommitIngester) git.CommitIngester {
+	return ingesters(ings)
+}
+
+type ingesters []git.CommitIngester
+
+func (ings ingesters) IngestCommits(ctx context.Context, cms []git.Commit) error {
+	var first error
+	for _, ing := range ings {
+		if err := ing.IngestCommits(ctx, cms); (err != nil) && (first == nil) {
+			first = err
+		}
+	}
+	return first
+}
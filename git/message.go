@@ -0,0 +1,241 @@
+package git
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Message is a parsed commit message.
+type Message struct {
+	Subject string `json:"subject"`
+
+	// Body is the rest of the message after the subject, excluding trailers.
+	Body string `json:"body"`
+
+	// Type and Scope are the type and scope of a Conventional Commit
+	// (i.e. 'feat(api): ...'), if the message is one.
+	Type  *string `json:"type"`
+	Scope *string `json:"scope"`
+
+	// Breaking is true if the message is a Conventional Commit that describes a
+	// breaking change.
+	Breaking bool `json:"breaking"`
+
+	// CoAuthors are the authors credited by 'Co-authored-by' trailers.
+	CoAuthors []CommitAuthor `json:"coAuthors"`
+
+	// IssueRefs are the issues (and pull requests) that the message
+	// references.
+	IssueRefs []IssueRef `json:"issueRefs"`
+}
+
+// An IssueRef is a reference to an issue (or pull request) from a commit
+// message, like '#12' or 'owner/repo#12'.
+type IssueRef struct {
+	// Repo is the full name of the repository that the issue belongs to, if it
+	// is not the commit's own repository.
+	Repo   *string `json:"repo"`
+	Number int     `json:"number"`
+
+	// Closes is true if the reference uses a closing keyword, like 'Fixes #12'.
+	Closes bool `json:"closes"`
+}
+
+var (
+	_conventionalRegexp = regexp.MustCompile(
+		`^([A-Za-z]+)(?:\(([^()]+)\))?(!)?: \S`,
+	)
+	_trailerRegexp  = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9-]*): (.+)$`)
+	_identRegexp    = regexp.MustCompile(`^(.*?)\s*<([^<>]+)>$`)
+	_issueRefRegexp = regexp.MustCompile(
+		`(?i)(?:\b(close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+)?` +
+			`(?:\b([\w.-]+/[\w.-]+))?#(\d+)\b`,
+	)
+	_mergeRegexp = regexp.MustCompile(
+		`^Merge (pull request #\d+|(remote-tracking )?branch|tag|commit) `,
+	)
+)
+
+// ParseMessage parses a commit message.
+func ParseMessage(msg string) Message {
+	msg = strings.TrimSpace(strings.Replace(msg, "\r\n", "\n", -1))
+	m := Message{
+		CoAuthors: []CommitAuthor{},
+		IssueRefs: []IssueRef{},
+	}
+
+	// Split subject and body.
+	var body string
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		m.Subject, body = msg[:i], strings.TrimSpace(msg[i+1:])
+	} else {
+		m.Subject = msg
+	}
+
+	// Separate trailers, which form the last paragraph of the body.
+	var trailers [][2]string
+	{
+		start := strings.LastIndex(body, "\n\n")
+		last := body[start+1:]
+		if start < 0 {
+			last = body
+		}
+		trailers = parseTrailers(last, start >= 0)
+		if len(trailers) > 0 {
+			if start < 0 {
+				start = 0
+			}
+			body = strings.TrimSpace(body[:start])
+		}
+	}
+	m.Body = body
+
+	// Parse Conventional Commit header.
+	if match := _conventionalRegexp.FindStringSubmatch(m.Subject); match != nil {
+		typ := strings.ToLower(match[1])
+		m.Type = &typ
+		if match[2] != "" {
+			scope := match[2]
+			m.Scope = &scope
+		}
+		m.Breaking = match[3] != ""
+	}
+
+	// Parse trailers.
+	for _, t := range trailers {
+		switch key := strings.ToLower(t[0]); key {
+		case "co-authored-by":
+			if match := _identRegexp.FindStringSubmatch(t[1]); match != nil {
+				m.CoAuthors = append(m.CoAuthors, CommitAuthor{
+					Name:  match[1],
+					Email: match[2],
+				})
+			}
+		case "breaking-change":
+			if m.Type != nil {
+				m.Breaking = true
+			}
+		}
+	}
+	if (m.Type != nil) && (strings.Contains(body, "BREAKING CHANGE: ") ||
+		strings.Contains(body, "BREAKING-CHANGE: ")) {
+		m.Breaking = true
+	}
+
+	// Find issue references, in the subject, body, and trailers.
+	type issueKey struct {
+		repo   string
+		number int
+	}
+	seen := make(map[issueKey]int)
+	for _, loc := range _issueRefRegexp.FindAllStringSubmatchIndex(msg, -1) {
+		if !isIssueRefStart(msg[:loc[0]]) {
+			continue
+		}
+		n, err := strconv.Atoi(msg[loc[6]:loc[7]])
+		if err != nil {
+			continue
+		}
+		key := issueKey{number: n}
+		if loc[4] >= 0 {
+			key.repo = msg[loc[4]:loc[5]]
+		}
+		closes := loc[2] >= 0
+		if i, ok := seen[key]; ok {
+			m.IssueRefs[i].Closes = m.IssueRefs[i].Closes || closes
+			continue
+		}
+		ref := IssueRef{Number: n, Closes: closes}
+		if key.repo != "" {
+			ref.Repo = &key.repo
+		}
+		seen[key] = len(m.IssueRefs)
+		m.IssueRefs = append(m.IssueRefs, ref)
+	}
+	return m
+}
+
+// _trailerKeys are the (lowercase) trailer keys that are recognized, in
+// addition to '-by' keys like 'Signed-off-by'.
+var _trailerKeys = map[string]bool{
+	"breaking-change": true,
+	"bug":             true,
+	"cc":              true,
+	"change-id":       true,
+	"closes":          true,
+	"fixes":           true,
+	"refs":            true,
+	"resolves":        true,
+	"see-also":        true,
+}
+
+// parseTrailers parses a paragraph of trailers into key-value pairs, or
+// returns nil if para is not made up of trailers.
+//
+// To avoid mistaking prose (like 'Note: ...') for trailers, every key must be
+// a known trailer key or a '-by' key. If para is the only paragraph of the
+// body, (i.e. it is not separate from the rest of the body), every key must be
+// a '-by' key.
+func parseTrailers(para string, separate bool) [][2]string {
+	var trailers [][2]string
+	for _, line := range strings.Split(strings.TrimSpace(para), "\n") {
+		match := _trailerRegexp.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			return nil
+		}
+		key := strings.ToLower(match[1])
+		if !strings.HasSuffix(key, "-by") && (!separate || !_trailerKeys[key]) {
+			return nil
+		}
+		trailers = append(trailers, [2]string{match[1], match[2]})
+	}
+	return trailers
+}
+
+// isIssueRefStart reports whether an issue reference can follow prefix.
+//
+// References must be separate words that don't follow a ':' or '=' (so that
+// i.e. 'color: #123' is not mistaken for a reference).
+func isIssueRefStart(prefix string) bool {
+	if prefix == "" {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(prefix)
+	if !unicode.IsSpace(r) && !strings.ContainsRune("([{,;", r) {
+		return false
+	}
+	trimmed := strings.TrimRightFunc(prefix, unicode.IsSpace)
+	if trimmed == "" {
+		return true
+	}
+	r, _ = utf8.DecodeLastRuneInString(trimmed)
+	return (r != ':') && (r != '=')
+}
+
+// IsMerge reports whether m is the message of a merge commit, as generated by
+// Git or a forge.
+func (m *Message) IsMerge() bool { return _mergeRegexp.MatchString(m.Subject) }
+
+// ParsedMessage parses c's Message.
+func (c *Commit) ParsedMessage() *Message {
+	m := ParseMessage(c.Message)
+	return &m
+}
+
+// IsWork reports whether c is a "real" work commit, rather than a merge commit
+// or a commit by a bot.
+func (c *Commit) IsWork() bool {
+	return !c.Author.IsBot() && !c.ParsedMessage().IsMerge()
+}
+
+// IsBot reports whether a is a bot account, like 'dependabot[bot]'.
+func (a *CommitAuthor) IsBot() bool {
+	if (a.Login != nil) && strings.HasSuffix(*a.Login, "[bot]") {
+		return true
+	}
+	return strings.Contains(a.Name, "[bot]") ||
+		strings.Contains(a.Email, "[bot]@")
+}
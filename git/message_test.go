@@ -0,0 +1,254 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMessage(t *testing.T) {
+	var (
+		str  = func(s string) *string { return &s }
+		none = []IssueRef{}
+	)
+	tests := []struct {
+		name string
+		msg  string
+
+		subject, body string
+		typ, scope    *string
+		breaking      bool
+		coAuthors     []CommitAuthor
+		issueRefs     []IssueRef
+	}{
+		{
+			name:      "subject only",
+			msg:       "Fix crash on startup\n",
+			subject:   "Fix crash on startup",
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "subject and body",
+			msg:       "Fix crash\r\n\r\nThe cache was nil.\r\nNow it isn't.",
+			subject:   "Fix crash",
+			body:      "The cache was nil.\nNow it isn't.",
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "prose that looks like a trailer",
+			msg:       "Fix crash\n\nNote: breaks X",
+			subject:   "Fix crash",
+			body:      "Note: breaks X",
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "unknown keys in a separate paragraph",
+			msg:       "Fix crash\n\nDetails here.\n\nNote: breaks X\nSee: the docs",
+			subject:   "Fix crash",
+			body:      "Details here.\n\nNote: breaks X\nSee: the docs",
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:    "co-author trailers only",
+			msg:     "Add feature\n\nCo-authored-by: Jane Doe <jane@example.com>",
+			subject: "Add feature",
+			coAuthors: []CommitAuthor{
+				{Name: "Jane Doe", Email: "jane@example.com"},
+			},
+			issueRefs: none,
+		},
+		{
+			name: "known trailers after the body",
+			msg: "Add feature\n\nLonger description.\n\n" +
+				"Signed-off-by: Me <me@example.com>\n" +
+				"Co-authored-by: A <a@example.com>\n" +
+				"Co-Authored-By: B <b@example.com>\n" +
+				"Change-Id: I1234",
+			subject: "Add feature",
+			body:    "Longer description.",
+			coAuthors: []CommitAuthor{
+				{Name: "A", Email: "a@example.com"},
+				{Name: "B", Email: "b@example.com"},
+			},
+			issueRefs: none,
+		},
+		{
+			name:      "known non-by trailer as the only paragraph",
+			msg:       "Add feature\n\nChange-Id: I1234",
+			subject:   "Add feature",
+			body:      "Change-Id: I1234",
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "conventional commit",
+			msg:       "feat(api): add endpoint",
+			subject:   "feat(api): add endpoint",
+			typ:       str("feat"),
+			scope:     str("api"),
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "conventional commit with bang",
+			msg:       "Fix!: drop support for v1",
+			subject:   "Fix!: drop support for v1",
+			typ:       str("fix"),
+			breaking:  true,
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "breaking change footer",
+			msg:       "refactor: rename\n\nStuff.\n\nBREAKING-CHANGE: renamed Foo",
+			subject:   "refactor: rename",
+			body:      "Stuff.",
+			typ:       str("refactor"),
+			breaking:  true,
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "breaking change in body",
+			msg:       "feat: x\n\nBREAKING CHANGE: config moved",
+			subject:   "feat: x",
+			body:      "BREAKING CHANGE: config moved",
+			typ:       str("feat"),
+			breaking:  true,
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "not a conventional commit",
+			msg:       "Merge: thing",
+			subject:   "Merge: thing",
+			typ:       str("merge"),
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "issue references",
+			msg:       "Fix parser (#12)\n\nFixes #3, closes owner/repo#4.\nSee #3 and #12.",
+			subject:   "Fix parser (#12)",
+			body:      "Fixes #3, closes owner/repo#4.\nSee #3 and #12.",
+			coAuthors: []CommitAuthor{},
+			issueRefs: []IssueRef{
+				{Number: 12},
+				{Number: 3, Closes: true},
+				{Repo: str("owner/repo"), Number: 4, Closes: true},
+			},
+		},
+		{
+			name:      "issue reference in a trailer",
+			msg:       "Fix parser\n\nSome detail.\n\nFixes: #7",
+			subject:   "Fix parser",
+			body:      "Some detail.",
+			coAuthors: []CommitAuthor{},
+			issueRefs: []IssueRef{{Number: 7, Closes: true}},
+		},
+		{
+			name:      "hex colours",
+			msg:       "Restyle header\n\nSet color: #123 and background=#456.\nUse #fff.",
+			subject:   "Restyle header",
+			body:      "Set color: #123 and background=#456.\nUse #fff.",
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+		{
+			name:      "attached to a word",
+			msg:       "Bump to v1#2 and PR#3",
+			subject:   "Bump to v1#2 and PR#3",
+			coAuthors: []CommitAuthor{},
+			issueRefs: none,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := ParseMessage(tt.msg)
+			if m.Subject != tt.subject {
+				t.Errorf("Subject = %q, want %q", m.Subject, tt.subject)
+			}
+			if m.Body != tt.body {
+				t.Errorf("Body = %q, want %q", m.Body, tt.body)
+			}
+			if !reflect.DeepEqual(m.Type, tt.typ) {
+				t.Errorf("Type = %v, want %v", deref(m.Type), deref(tt.typ))
+			}
+			if !reflect.DeepEqual(m.Scope, tt.scope) {
+				t.Errorf("Scope = %v, want %v", deref(m.Scope), deref(tt.scope))
+			}
+			if m.Breaking != tt.breaking {
+				t.Errorf("Breaking = %t, want %t", m.Breaking, tt.breaking)
+			}
+			if !reflect.DeepEqual(m.CoAuthors, tt.coAuthors) {
+				t.Errorf("CoAuthors = %+v, want %+v", m.CoAuthors, tt.coAuthors)
+			}
+			if !reflect.DeepEqual(m.IssueRefs, tt.issueRefs) {
+				t.Errorf("IssueRefs = %+v, want %+v", m.IssueRefs, tt.issueRefs)
+			}
+		})
+	}
+}
+
+func TestCommitIsWork(t *testing.T) {
+	bot := "dependabot[bot]"
+	tests := []struct {
+		name   string
+		commit Commit
+		want   bool
+	}{
+		{
+			name:   "regular",
+			commit: Commit{Message: "Fix crash", Author: CommitAuthor{Name: "Me"}},
+			want:   true,
+		},
+		{
+			name: "merge",
+			commit: Commit{
+				Message: "Merge pull request #4 from me/branch",
+				Author:  CommitAuthor{Name: "Me"},
+			},
+		},
+		{
+			name: "merge branch",
+			commit: Commit{
+				Message: "Merge branch 'main' into feature",
+				Author:  CommitAuthor{Name: "Me"},
+			},
+		},
+		{
+			name: "bot login",
+			commit: Commit{
+				Message: "Bump lodash from 1.0 to 1.1",
+				Author:  CommitAuthor{Name: "dependabot", Login: &bot},
+			},
+		},
+		{
+			name: "bot email",
+			commit: Commit{
+				Message: "Update deps",
+				Author: CommitAuthor{
+					Name:  "renovate",
+					Email: "29139614+renovate[bot]@users.noreply.github.com",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.commit.IsWork(); got != tt.want {
+				t.Errorf("IsWork() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func deref(s *string) interface{} {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
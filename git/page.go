@@ -0,0 +1,53 @@
+package git
+
+import (
+	stderrs "errors"
+	"net/http"
+
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+type (
+	// A CommitPage is a page of Commits.
+	CommitPage struct {
+		Commits []Commit `json:"commits"`
+
+		// EndCursor is a cursor that can be used to fetch the next page; it is nil
+		// if the page is empty.
+		EndCursor   *string `json:"endCursor,omitempty"`
+		HasNextPage bool    `json:"hasNextPage"`
+	}
+
+	// RepoCommits are the Commits in a particular Repo.
+	RepoCommits struct {
+		Repo    Repo     `json:"repo"`
+		Commits []Commit `json:"commits"`
+	}
+)
+
+// ByRepo groups the page's Commits by Repo.
+//
+// Groups are ordered by their latest Commit, and retain the order of the
+// page's Commits.
+func (p *CommitPage) ByRepo() []RepoCommits {
+	var (
+		groups = make([]RepoCommits, 0)
+		index  = make(map[string]int)
+	)
+	for _, c := range p.Commits {
+		i, ok := index[c.Repo.URL]
+		if !ok {
+			i = len(groups)
+			index[c.Repo.URL] = i
+			groups = append(groups, RepoCommits{Repo: c.Repo})
+		}
+		groups[i].Commits = append(groups[i].Commits, c)
+	}
+	return groups
+}
+
+// ErrInvalidCursor is returned by a Service when a page cursor is malformed.
+var ErrInvalidCursor = exthttp.WrapWithHTTPCode(
+	stderrs.New("git: invalid cursor"),
+	http.StatusBadRequest,
+)
@@ -0,0 +1,33 @@
+package git
+
+import (
+	"context"
+	"time"
+)
+
+// RepoMeta is metadata that describes a Repo.
+type RepoMeta struct {
+	Description     *string        `json:"description"`
+	PrimaryLanguage *string        `json:"primaryLanguage"`
+	Languages       []RepoLanguage `json:"languages"` // largest first
+	Stars           int            `json:"stars"`
+	Topics          []string       `json:"topics"`
+	PushedAt        *time.Time     `json:"pushedAt"`
+	IsFork          bool           `json:"isFork"`
+	IsArchived      bool           `json:"isArchived"`
+}
+
+// A RepoLanguage is the amount of code in a Repo that is written in a
+// particular language.
+type RepoLanguage struct {
+	Name  string `json:"name"`
+	Bytes int    `json:"bytes"`
+}
+
+// A RepoMetaSource can look up the RepoMeta for a Repo.
+type RepoMetaSource interface {
+	// RepoMeta looks up the RepoMeta for repo.
+	//
+	// If the source does not know about repo, it returns nil.
+	RepoMeta(ctx context.Context, repo Repo) (*RepoMeta, error)
+}
@@ -1,6 +1,9 @@
 package git // import "go.stevenxie.me/api/v2/git"
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type (
 	// A Service handles requests for my recent commits information.
@@ -9,6 +12,21 @@ type (
 			ctx context.Context,
 			opts ...RecentCommitsOption,
 		) ([]Commit, error)
+
+		// Commits lists my recently pushed commits, from newest to oldest, a page
+		// at a time.
+		Commits(ctx context.Context, opts ...CommitsOption) (*CommitPage, error)
+
+		// Activity lists my recent Activity (other than pushes), from newest to
+		// oldest.
+		Activity(ctx context.Context, opts ...ActivityOption) ([]Activity, error)
+
+		// RepoMeta looks up the RepoMeta for repo, which is nil if unknown.
+		RepoMeta(ctx context.Context, repo Repo) (*RepoMeta, error)
+
+		// CommitVerification looks up the Verification of c, which is nil if
+		// unknown.
+		CommitVerification(ctx context.Context, c Commit) (*Verification, error)
 	}
 
 	// RecentCommitsOptions are option parameters for Service.RecentCommits.
@@ -18,4 +36,40 @@ type (
 
 	// A RecentCommitsOption modifies a RecentCommitsOptions.
 	RecentCommitsOption func(*RecentCommitsOptions)
+
+	// CommitsOptions are option parameters for Service.Commits.
+	CommitsOptions struct {
+		// Repo restricts commits to those in the repository with this name.
+		Repo *string
+
+		// Since and Until restrict commits to those pushed within a time range.
+		Since, Until *time.Time
+
+		// WorkOnly restricts commits to "real" work commits (see
+		// Commit.IsWork).
+		WorkOnly bool
+
+		// First is the maximum number of commits in a page.
+		First int
+
+		// After is a cursor, from a previous CommitPage, after which the page
+		// starts.
+		After *string
+	}
+
+	// A CommitsOption modifies a CommitsOptions.
+	CommitsOption func(*CommitsOptions)
+
+	// ActivityOptions are option parameters for Service.Activity.
+	ActivityOptions struct {
+		// Types restricts Activity to these types; if empty, all types are
+		// included.
+		Types []ActivityType
+
+		// First is the maximum amount of Activity to list.
+		First int
+	}
+
+	// An ActivityOption modifies an ActivityOptions.
+	ActivityOption func(*ActivityOptions)
 )
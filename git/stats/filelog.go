@@ -0,0 +1,161 @@
+package stats
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+// NewFileLog creates a CommitLog that stores commits in the file at path, as
+// JSON lines.
+//
+// Existing commits in the file are loaded into memory; if the file does not
+// exist, it is created. A final line that cannot be decoded (i.e. because a
+// write was interrupted) is truncated, but corruption elsewhere in the file is
+// reported as an error.
+func NewFileLog(path string) (*FileLog, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errors.Wrap(err, "stats: create log directory")
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, "stats: open log file")
+	}
+
+	fl := &FileLog{
+		file: file,
+		seen: make(map[string]zero.Struct),
+	}
+	if err = fl.load(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return fl, nil
+}
+
+// load reads the commits in fl.file into memory.
+func (fl *FileLog) load() error {
+	var (
+		r      = bufio.NewReader(fl.file)
+		offset int64
+	)
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if (err != nil) && (err != io.EOF) {
+			return errors.Wrap(err, "stats: read log file")
+		}
+		if len(data) == 0 {
+			return nil
+		}
+		_, err = r.Peek(1)
+		final := err == io.EOF
+
+		var c git.Commit
+		if err = json.Unmarshal(data, &c); err != nil {
+			if !final {
+				return errors.Wrapf(err, "stats: decode commit on line %d", line)
+			}
+			if err = fl.file.Truncate(offset); err != nil {
+				return errors.Wrap(err, "stats: truncate incomplete line")
+			}
+			return nil
+		}
+		offset += int64(len(data))
+
+		// Terminate a final line that is missing its newline, so that it
+		// isn't joined with the next commit that is written.
+		if final && (data[len(data)-1] != '\n') {
+			if _, err = fl.file.Write([]byte{'\n'}); err != nil {
+				return errors.Wrap(err, "stats: write to log file")
+			}
+		}
+
+		// Commits may have been written more than once, if an earlier write
+		// failed part-way.
+		if _, ok := fl.seen[c.SHA]; ok {
+			continue
+		}
+		fl.cms = append(fl.cms, c)
+		fl.seen[c.SHA] = zero.Empty()
+	}
+}
+
+// A FileLog is a CommitLog that is backed by a file.
+type FileLog struct {
+	mux  sync.Mutex
+	file *os.File
+	cms  []git.Commit
+	seen map[string]zero.Struct // commit SHAs
+}
+
+var _ CommitLog = (*FileLog)(nil)
+
+// AddCommits implements CommitLog.AddCommits.
+func (fl *FileLog) AddCommits(cms []git.Commit) (int, error) {
+	fl.mux.Lock()
+	defer fl.mux.Unlock()
+
+	var (
+		w     = bufio.NewWriter(fl.file)
+		enc   = json.NewEncoder(w)
+		added []git.Commit
+		batch = make(map[string]zero.Struct)
+	)
+	for i := range cms {
+		c := &cms[i]
+		if _, ok := fl.seen[c.SHA]; ok {
+			continue
+		}
+		if _, ok := batch[c.SHA]; ok {
+			continue
+		}
+		batch[c.SHA] = zero.Empty()
+		if err := enc.Encode(c); err != nil {
+			return 0, errors.Wrap(err, "stats: encode commit")
+		}
+		added = append(added, *c)
+	}
+	if len(added) == 0 {
+		return 0, nil
+	}
+	if err := w.Flush(); err != nil {
+		return 0, errors.Wrap(err, "stats: write to log file")
+	}
+
+	for _, c := range added {
+		fl.cms = append(fl.cms, c)
+		fl.seen[c.SHA] = zero.Empty()
+	}
+	return len(added), nil
+}
+
+// CommitsBetween implements CommitLog.CommitsBetween.
+func (fl *FileLog) CommitsBetween(from, to time.Time) ([]git.Commit, error) {
+	fl.mux.Lock()
+	defer fl.mux.Unlock()
+
+	var cms []git.Commit
+	for i := range fl.cms {
+		c := &fl.cms[i]
+		if t := CommitTime(c); !t.Before(from) && t.Before(to) {
+			cms = append(cms, *c)
+		}
+	}
+	return cms, nil
+}
+
+// Close closes the FileLog's underlying file.
+func (fl *FileLog) Close() error {
+	fl.mux.Lock()
+	defer fl.mux.Unlock()
+	return fl.file.Close()
+}
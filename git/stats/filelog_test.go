@@ -0,0 +1,114 @@
+package stats
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+func TestFileLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filelog")
+	if err != nil {
+		t.Fatalf("create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	const (
+		a = `{"sha":"aaa","timestamp":"2019-10-28T09:30:00Z"}` + "\n"
+		b = `{"sha":"bbb","timestamp":"2019-10-29T09:30:00Z"}` + "\n"
+	)
+	tests := []struct {
+		name     string
+		data     string
+		want     []string
+		wantErr  bool
+		wantData string
+	}{
+		{
+			name:     "torn final line",
+			data:     a + `{"sha":"bb`,
+			want:     []string{"aaa"},
+			wantData: a,
+		},
+		{
+			name:     "missing final newline",
+			data:     a + b[:len(b)-1],
+			want:     []string{"aaa", "bbb"},
+			wantData: a + b,
+		},
+		{
+			name:     "duplicate commits",
+			data:     a + b + a,
+			want:     []string{"aaa", "bbb"},
+			wantData: a + b + a,
+		},
+		{
+			name:    "corrupt line",
+			data:    a + "{\n" + b,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name+".jsonl")
+			if err := ioutil.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatalf("write log file: %v", err)
+			}
+			fl, err := NewFileLog(path)
+			if tt.wantErr {
+				if err == nil {
+					fl.Close()
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("open log: %v", err)
+			}
+			defer fl.Close()
+
+			cms, err := fl.CommitsBetween(time.Time{}, time.Now())
+			if err != nil {
+				t.Fatalf("get commits: %v", err)
+			}
+			shas := make([]string, len(cms))
+			for i := range cms {
+				shas[i] = cms[i].SHA
+			}
+			if len(shas) != len(tt.want) {
+				t.Fatalf("got commits %v, want %v", shas, tt.want)
+			}
+			for i := range shas {
+				if shas[i] != tt.want[i] {
+					t.Fatalf("got commits %v, want %v", shas, tt.want)
+				}
+			}
+
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatalf("read log file: %v", err)
+			}
+			if string(data) != tt.wantData {
+				t.Errorf("log file contains %q, want %q", data, tt.wantData)
+			}
+
+			// New commits are written on their own line.
+			n, err := fl.AddCommits([]git.Commit{{SHA: "ccc"}, {SHA: "aaa"}})
+			if err != nil {
+				t.Fatalf("add commits: %v", err)
+			}
+			if n != 1 {
+				t.Errorf("added %d commits, want 1", n)
+			}
+			reopened, err := NewFileLog(path)
+			if err != nil {
+				t.Fatalf("reopen log: %v", err)
+			}
+			reopened.Close()
+		})
+	}
+}
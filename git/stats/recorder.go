@@ -0,0 +1,106 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/pkg/poll"
+)
+
+// NewRecorder creates a new Recorder, which adds the commit history from src
+// to log at a regular interval.
+func NewRecorder(
+	src git.Source,
+	log CommitLog,
+	interval time.Duration,
+	opts ...RecorderOption,
+) *Recorder {
+	opt := RecorderOptions{
+		Logger: logutil.NoopEntry(),
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	logger := logutil.WithComponent(opt.Logger, (*Recorder)(nil))
+	return &Recorder{
+		commits: log,
+		poller: poll.NewPoller(
+			recorderActor{
+				src:     src,
+				commits: log,
+				log:     logger,
+			},
+			interval,
+			poll.PollerWithLogger(logger),
+		),
+	}
+}
+
+// RecorderWithLogger configures a Recorder to write logs with log.
+func RecorderWithLogger(log *logrus.Entry) RecorderOption {
+	return func(opt *RecorderOptions) { opt.Logger = log }
+}
+
+type (
+	// A Recorder records commits to a CommitLog.
+	Recorder struct {
+		commits CommitLog
+		poller  *poll.Poller
+	}
+
+	// RecorderOptions configures a Recorder.
+	RecorderOptions struct {
+		Logger *logrus.Entry
+	}
+
+	// A RecorderOption modifies a RecorderOptions.
+	RecorderOption func(*RecorderOptions)
+)
+
+var _ git.CommitIngester = (*Recorder)(nil)
+
+// IngestCommits implements git.CommitIngester for a Recorder.
+//
+// It records cms immediately, without waiting for the next poll.
+func (r *Recorder) IngestCommits(_ context.Context, cms []git.Commit) error {
+	if _, err := r.commits.AddCommits(cms); err != nil {
+		return errors.WithMessage(err, "stats: record commits")
+	}
+	return nil
+}
+
+// Stop stops the Recorder.
+func (r *Recorder) Stop() { r.poller.Stop() }
+
+type recorderActor struct {
+	src     git.Source
+	commits CommitLog
+	log     *logrus.Entry
+}
+
+var _ poll.Actor = (*recorderActor)(nil)
+
+func (ra recorderActor) Prod() (zero.Interface, error) {
+	return ra.src.CommitHistory(context.Background())
+}
+
+func (ra recorderActor) Recv(v zero.Interface, err error) {
+	if err != nil {
+		ra.log.WithError(err).Error("Failed to get Git commit history.")
+		return
+	}
+	n, err := ra.commits.AddCommits(v.([]git.Commit))
+	if err != nil {
+		ra.log.WithError(err).Error("Failed to record Git commits.")
+		return
+	}
+	if n > 0 {
+		ra.log.WithField("count", n).Info("Recorded new Git commits.")
+	}
+}
@@ -0,0 +1,285 @@
+package stats
+
+import (
+	"context"
+	stderrs "errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+// MaxRange is the largest range of dates that Contributions can span.
+const MaxRange = 3 * 366 * 24 * time.Hour
+
+// ErrInvalidRange is returned by a Service when a range of dates is empty,
+// or larger than MaxRange.
+var ErrInvalidRange = exthttp.WrapWithHTTPCode(
+	stderrs.New("stats: invalid date range"),
+	http.StatusBadRequest,
+)
+
+// NewService creates a new Service that computes Contributions from the
+// commits in log.
+//
+// langs may be nil, in which case Contributions will not include a language
+// breakdown.
+func NewService(
+	log CommitLog,
+	langs LanguageSource,
+	opts ...ServiceOption,
+) Service {
+	opt := ServiceOptions{
+		Logger:   logutil.NoopEntry(),
+		Tracer:   new(opentracing.NoopTracer),
+		Location: time.Local,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return &service{
+		commits: log,
+		langs:   langs,
+		loc:     opt.Location,
+		log:     logutil.WithComponent(opt.Logger, (*service)(nil)),
+		tracer:  opt.Tracer,
+
+		repoLangs: make(map[string]*string),
+	}
+}
+
+// ServiceWithLogger configures a Service to write logs with log.
+func ServiceWithLogger(log *logrus.Entry) ServiceOption {
+	return func(opt *ServiceOptions) { opt.Logger = log }
+}
+
+// ServiceWithTracer configures a Service to trace calls with t.
+func ServiceWithTracer(t opentracing.Tracer) ServiceOption {
+	return func(opt *ServiceOptions) { opt.Tracer = t }
+}
+
+// ServiceWithLocation configures a Service to bucket commits into days and
+// hours in the time zone loc.
+func ServiceWithLocation(loc *time.Location) ServiceOption {
+	return func(opt *ServiceOptions) { opt.Location = loc }
+}
+
+type (
+	service struct {
+		commits CommitLog
+		langs   LanguageSource
+		loc     *time.Location
+		log     *logrus.Entry
+		tracer  opentracing.Tracer
+
+		mux       sync.Mutex
+		repoLangs map[string]*string // by repo URL
+	}
+
+	// ServiceOptions configures a Service.
+	ServiceOptions struct {
+		Logger   *logrus.Entry
+		Tracer   opentracing.Tracer
+		Location *time.Location
+	}
+
+	// A ServiceOption modifies a ServiceOptions.
+	ServiceOption func(*ServiceOptions)
+)
+
+var _ Service = (*service)(nil)
+
+func (svc *service) Contributions(
+	ctx context.Context,
+	opts ...ContributionsOption,
+) (*Contributions, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc((*service).Contributions),
+	)
+	defer span.Finish()
+
+	var opt ContributionsOptions
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	// Determine date range.
+	var (
+		to   = svc.date(time.Now())
+		from time.Time
+	)
+	if t := opt.To; t != nil {
+		to = svc.date(*t)
+	}
+	if f := opt.From; f != nil {
+		from = svc.date(*f)
+	} else {
+		from = to.AddDate(-1, 0, 1)
+	}
+	end := to.AddDate(0, 0, 1)
+	if !from.Before(end) || (end.Sub(from) > MaxRange) {
+		return nil, ErrInvalidRange
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod((*service).Contributions),
+		"from":            from,
+		"to":              to,
+	}).WithContext(ctx)
+
+	cms, err := svc.commits.CommitsBetween(from, end)
+	if err != nil {
+		log.WithError(err).Error("Failed to read commits from log.")
+		return nil, errors.WithMessage(err, "stats: read commits")
+	}
+
+	// Build calendar.
+	var days []Day
+	index := make(map[time.Time]int)
+	for d := from; d.Before(end); d = d.AddDate(0, 0, 1) {
+		index[d] = len(days)
+		days = append(days, Day{Date: d})
+	}
+
+	var (
+		weekdays [7]int
+		hours    [24]int
+		langs    = make(map[string]int)
+		failed   = make(map[string]bool) // repos whose language lookup failed
+	)
+	for i := range cms {
+		c := &cms[i]
+		t := CommitTime(c).In(svc.loc)
+		if i, ok := index[svc.date(t)]; ok {
+			days[i].Count++
+		}
+		weekdays[t.Weekday()]++
+		hours[t.Hour()]++
+
+		if failed[c.Repo.URL] {
+			continue
+		}
+		lang, err := svc.repoLanguage(ctx, c.Repo)
+		if err != nil {
+			log.
+				WithError(err).
+				WithField("repo", c.Repo.Name).
+				Warn("Failed to look up repository language.")
+			failed[c.Repo.URL] = true
+			continue
+		}
+		if lang != nil {
+			langs[*lang]++
+		}
+	}
+
+	cbs := Contributions{
+		From:      from,
+		To:        to,
+		Total:     len(cms),
+		Days:      days,
+		Languages: make([]LanguageCount, 0, len(langs)),
+	}
+
+	// Compute streaks.
+	{
+		streak := 0
+		for _, d := range days {
+			if d.Count == 0 {
+				streak = 0
+				continue
+			}
+			streak++
+			if streak > cbs.LongestStreak {
+				cbs.LongestStreak = streak
+			}
+		}
+
+		i := len(days) - 1
+		if days[i].Count == 0 { // today's streak may not have started yet
+			i--
+		}
+		for ; (i >= 0) && (days[i].Count > 0); i-- {
+			cbs.CurrentStreak++
+		}
+	}
+
+	// Find busiest weekday and hour.
+	if len(cms) > 0 {
+		var (
+			wd time.Weekday
+			hr int
+		)
+		for d, n := range weekdays {
+			if n > weekdays[wd] {
+				wd = time.Weekday(d)
+			}
+		}
+		for h, n := range hours {
+			if n > hours[hr] {
+				hr = h
+			}
+		}
+		cbs.BusiestWeekday = &wd
+		cbs.BusiestHour = &hr
+	}
+
+	// Order languages by commit count.
+	for lang, n := range langs {
+		cbs.Languages = append(cbs.Languages, LanguageCount{
+			Language: lang,
+			Count:    n,
+		})
+	}
+	sort.Slice(cbs.Languages, func(i, j int) bool {
+		a, b := &cbs.Languages[i], &cbs.Languages[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Language < b.Language
+	})
+
+	return &cbs, nil
+}
+
+// date returns midnight of the day containing t, in svc.loc.
+func (svc *service) date(t time.Time) time.Time {
+	t = t.In(svc.loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, svc.loc)
+}
+
+// repoLanguage looks up the primary language of repo, caching the result.
+func (svc *service) repoLanguage(
+	ctx context.Context,
+	repo git.Repo,
+) (*string, error) {
+	if svc.langs == nil {
+		return nil, nil
+	}
+
+	svc.mux.Lock()
+	lang, ok := svc.repoLangs[repo.URL]
+	svc.mux.Unlock()
+	if ok {
+		return lang, nil
+	}
+
+	lang, err := svc.langs.RepoLanguage(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	svc.mux.Lock()
+	svc.repoLangs[repo.URL] = lang
+	svc.mux.Unlock()
+	return lang, nil
+}
@@ -0,0 +1,98 @@
+package stats // import "go.stevenxie.me/api/v2/git/stats"
+
+import (
+	"context"
+	"time"
+
+	"go.stevenxie.me/api/v2/git"
+)
+
+type (
+	// Contributions describe my Git activity over a range of dates, in the
+	// style of GitHub's contribution calendar.
+	Contributions struct {
+		// From and To are the first and last dates in the range (inclusive), at
+		// midnight.
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+
+		Total int   `json:"total"`
+		Days  []Day `json:"days"`
+
+		// LongestStreak is the largest number of consecutive days with commits,
+		// and CurrentStreak is the number of consecutive days with commits
+		// leading up to To (or the day before it, if there are no commits on To).
+		LongestStreak int `json:"longestStreak"`
+		CurrentStreak int `json:"currentStreak"`
+
+		// BusiestWeekday and BusiestHour are nil if there are no commits in the
+		// range.
+		BusiestWeekday *time.Weekday `json:"busiestWeekday,omitempty"`
+		BusiestHour    *int          `json:"busiestHour,omitempty"`
+
+		// Languages are the primary languages of the repositories that commits
+		// were pushed to, ordered by number of commits.
+		Languages []LanguageCount `json:"languages"`
+	}
+
+	// A Day is a date in a Contributions calendar.
+	Day struct {
+		Date  time.Time `json:"date"`
+		Count int       `json:"count"`
+	}
+
+	// A LanguageCount is the number of commits in a particular language.
+	LanguageCount struct {
+		Language string `json:"language"`
+		Count    int    `json:"count"`
+	}
+)
+
+type (
+	// A Service computes Contributions.
+	Service interface {
+		Contributions(
+			ctx context.Context,
+			opts ...ContributionsOption,
+		) (*Contributions, error)
+	}
+
+	// ContributionsOptions are option parameters for Service.Contributions.
+	ContributionsOptions struct {
+		// From and To bound the range of dates (inclusive); by default, the range
+		// spans the last year.
+		From, To *time.Time
+	}
+
+	// A ContributionsOption modifies a ContributionsOptions.
+	ContributionsOption func(*ContributionsOptions)
+)
+
+type (
+	// A CommitLog durably records Git commits.
+	CommitLog interface {
+		// AddCommits adds commits to the log, skipping those that have already
+		// been recorded. It returns the number of commits added.
+		AddCommits(cms []git.Commit) (int, error)
+
+		// CommitsBetween lists recorded commits made at or after from, and
+		// before to.
+		CommitsBetween(from, to time.Time) ([]git.Commit, error)
+	}
+
+	// A LanguageSource can look up the primary language of a git.Repo.
+	LanguageSource interface {
+		// RepoLanguage returns the primary language of repo, or nil if it is
+		// unknown.
+		RepoLanguage(ctx context.Context, repo git.Repo) (*string, error)
+	}
+)
+
+// CommitTime returns the time at which c was made: its author date, if known,
+// or otherwise the time at which it was pushed.
+func CommitTime(c *git.Commit) time.Time {
+	if d := c.Author.Date; d != nil {
+		return *d
+	}
+	return c.Timestamp
+}
@@ -0,0 +1,19 @@
+package git
+
+import "context"
+
+type (
+	// A CommitIngester ingests new commits as they are pushed.
+	CommitIngester interface {
+		// IngestCommits ingests newly pushed commits, which are ordered from
+		// newest to oldest.
+		IngestCommits(ctx context.Context, cms []Commit) error
+	}
+
+	// A Streamer can stream newly pushed commits.
+	Streamer interface {
+		// StreamCommits streams commits as they are pushed, from oldest to
+		// newest. ch is closed once ctx is done.
+		StreamCommits(ctx context.Context, ch chan<- Commit) error
+	}
+)
@@ -0,0 +1,38 @@
+package git
+
+import "context"
+
+// A Verification describes whether a Commit's signature was verified by the
+// forge that hosts it.
+type Verification struct {
+	Signed   bool `json:"signed"`
+	Verified bool `json:"verified"`
+
+	// Format is the format of the signature, if the Commit is signed and the
+	// format is known.
+	Format *SignatureFormat `json:"format"`
+
+	// State is the forge's verification state, i.e. 'VALID' or 'UNKNOWN_KEY'.
+	State string `json:"state"`
+
+	// Signer is the username of the account that signed the Commit, if known.
+	Signer *string `json:"signer"`
+}
+
+// A SignatureFormat is the format of a Commit's signature.
+type SignatureFormat string
+
+// The set of valid SignatureFormats.
+const (
+	SignatureGPG   SignatureFormat = "GPG"
+	SignatureSSH   SignatureFormat = "SSH"
+	SignatureSMIME SignatureFormat = "SMIME"
+)
+
+// A VerificationSource can look up the Verification of a Commit.
+type VerificationSource interface {
+	// CommitVerification looks up the Verification of c.
+	//
+	// If the source does not know about c, it returns nil.
+	CommitVerification(ctx context.Context, c Commit) (*Verification, error)
+}
@@ -24,6 +24,7 @@ import (
 	"go.stevenxie.me/api/v2/auth/authgql"
 	"go.stevenxie.me/api/v2/git"
 	"go.stevenxie.me/api/v2/git/gitgql"
+	"go.stevenxie.me/api/v2/git/stats"
 	"go.stevenxie.me/api/v2/location"
 	"go.stevenxie.me/api/v2/location/locgql"
 	"go.stevenxie.me/api/v2/music"
@@ -32,6 +33,7 @@ import (
 	"go.stevenxie.me/api/v2/productivity/prodgql"
 	"go.stevenxie.me/api/v2/scheduling"
 	"go.stevenxie.me/api/v2/scheduling/schedgql"
+	"go.stevenxie.me/api/v2/status"
 )
 
 // region    ************************** generated!.gotpl **************************
@@ -55,15 +57,34 @@ type ResolverRoot interface {
 	Address() AddressResolver
 	CurrentlyPlayingMusic() CurrentlyPlayingMusicResolver
 	FullAbout() FullAboutResolver
+	GitCommit() GitCommitResolver
+	GitCommitVerification() GitCommitVerificationResolver
+	GitContributions() GitContributionsResolver
+	GitIssueActivity() GitIssueActivityResolver
+	GitPullRequestActivity() GitPullRequestActivityResolver
+	GitRepo() GitRepoResolver
+	GitReviewActivity() GitReviewActivityResolver
 	LocationHistorySegment() LocationHistorySegmentResolver
 	MusicAlbum() MusicAlbumResolver
 	MusicArtist() MusicArtistResolver
+	MusicLyricLine() MusicLyricLineResolver
+	MusicRequest() MusicRequestResolver
 	MusicTrack() MusicTrackResolver
 	Mutation() MutationResolver
 	Place() PlaceResolver
 	Productivity() ProductivityResolver
+	ProductivityActivity() ProductivityActivityResolver
+	ProductivityFocusSession() ProductivityFocusSessionResolver
+	ProductivityGoal() ProductivityGoalResolver
+	ProductivityGoalProgress() ProductivityGoalProgressResolver
 	ProductivityRecord() ProductivityRecordResolver
+	ProductivityTrend() ProductivityTrendResolver
+	ProductivityTrendPoint() ProductivityTrendPointResolver
 	Query() QueryResolver
+	SchedulingBooking() SchedulingBookingResolver
+	SchedulingEvent() SchedulingEventResolver
+	SchedulingTravelBlock() SchedulingTravelBlockResolver
+	Status() StatusResolver
 	Subscription() SubscriptionResolver
 	TransitDeparture() TransitDepartureResolver
 }
@@ -117,29 +138,146 @@ type ComplexityRoot struct {
 	}
 
 	GitCommit struct {
-		Author    func(childComplexity int) int
-		Committer func(childComplexity int) int
-		Message   func(childComplexity int) int
-		Repo      func(childComplexity int) int
-		SHA       func(childComplexity int) int
-		Timestamp func(childComplexity int) int
-		URL       func(childComplexity int) int
+		Author        func(childComplexity int) int
+		Committer     func(childComplexity int) int
+		Message       func(childComplexity int) int
+		ParsedMessage func(childComplexity int) int
+		Repo          func(childComplexity int) int
+		SHA           func(childComplexity int) int
+		Timestamp     func(childComplexity int) int
+		URL           func(childComplexity int) int
+		Verification  func(childComplexity int) int
 	}
 
 	GitCommitAuthor struct {
 		Date  func(childComplexity int) int
 		Email func(childComplexity int) int
+		IsBot func(childComplexity int) int
 		Login func(childComplexity int) int
 		Name  func(childComplexity int) int
 	}
 
+	GitCommitMessage struct {
+		Body      func(childComplexity int) int
+		Breaking  func(childComplexity int) int
+		CoAuthors func(childComplexity int) int
+		IsMerge   func(childComplexity int) int
+		IssueRefs func(childComplexity int) int
+		Scope     func(childComplexity int) int
+		Subject   func(childComplexity int) int
+		Type      func(childComplexity int) int
+	}
+
+	GitCommitPage struct {
+		ByRepo      func(childComplexity int) int
+		Commits     func(childComplexity int) int
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+	}
+
+	GitCommitVerification struct {
+		Format   func(childComplexity int) int
+		Signed   func(childComplexity int) int
+		Signer   func(childComplexity int) int
+		State    func(childComplexity int) int
+		Verified func(childComplexity int) int
+	}
+
+	GitContributionDay struct {
+		Count func(childComplexity int) int
+		Date  func(childComplexity int) int
+	}
+
+	GitContributions struct {
+		BusiestHour    func(childComplexity int) int
+		BusiestWeekday func(childComplexity int) int
+		CurrentStreak  func(childComplexity int) int
+		Days           func(childComplexity int) int
+		From           func(childComplexity int) int
+		Languages      func(childComplexity int) int
+		LongestStreak  func(childComplexity int) int
+		To             func(childComplexity int) int
+		Total          func(childComplexity int) int
+	}
+
+	GitIssueActivity struct {
+		Action    func(childComplexity int) int
+		Number    func(childComplexity int) int
+		Repo      func(childComplexity int) int
+		Timestamp func(childComplexity int) int
+		Title     func(childComplexity int) int
+		URL       func(childComplexity int) int
+	}
+
+	GitIssueRef struct {
+		Closes func(childComplexity int) int
+		Number func(childComplexity int) int
+		Repo   func(childComplexity int) int
+	}
+
+	GitLanguageCount struct {
+		Count    func(childComplexity int) int
+		Language func(childComplexity int) int
+	}
+
+	GitPullRequest struct {
+		Number func(childComplexity int) int
+		Title  func(childComplexity int) int
+		URL    func(childComplexity int) int
+	}
+
+	GitPullRequestActivity struct {
+		Action      func(childComplexity int) int
+		PullRequest func(childComplexity int) int
+		Repo        func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+	}
+
 	GitQuery struct {
+		Activity      func(childComplexity int, types []string, first *int) int
+		Commits       func(childComplexity int, repo *string, since *time.Time, until *time.Time, workOnly *bool, first *int, after *string) int
+		Contributions func(childComplexity int, from *time.Time, to *time.Time) int
 		RecentCommits func(childComplexity int, limit *int) int
 	}
 
+	GitReleaseActivity struct {
+		Name       func(childComplexity int) int
+		Prerelease func(childComplexity int) int
+		Repo       func(childComplexity int) int
+		Tag        func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+		URL        func(childComplexity int) int
+	}
+
 	GitRepo struct {
-		Name func(childComplexity int) int
-		URL  func(childComplexity int) int
+		Description     func(childComplexity int) int
+		IsArchived      func(childComplexity int) int
+		IsFork          func(childComplexity int) int
+		Languages       func(childComplexity int) int
+		Name            func(childComplexity int) int
+		PrimaryLanguage func(childComplexity int) int
+		PushedAt        func(childComplexity int) int
+		Stars           func(childComplexity int) int
+		Topics          func(childComplexity int) int
+		URL             func(childComplexity int) int
+	}
+
+	GitRepoCommits struct {
+		Commits func(childComplexity int) int
+		Repo    func(childComplexity int) int
+	}
+
+	GitRepoLanguage struct {
+		Bytes func(childComplexity int) int
+		Name  func(childComplexity int) int
+	}
+
+	GitReviewActivity struct {
+		PullRequest func(childComplexity int) int
+		Repo        func(childComplexity int) int
+		State       func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+		URL         func(childComplexity int) int
 	}
 
 	LocationHistorySegment struct {
@@ -191,13 +329,43 @@ type ComplexityRoot struct {
 		Width  func(childComplexity int) int
 	}
 
+	MusicLyricLine struct {
+		Text func(childComplexity int) int
+		Time func(childComplexity int) int
+	}
+
+	MusicLyrics struct {
+		Lines  func(childComplexity int) int
+		Synced func(childComplexity int) int
+		Text   func(childComplexity int) int
+	}
+
 	MusicMutation struct {
-		Pause func(childComplexity int) int
-		Play  func(childComplexity int, resource *music.Selector) int
+		ApproveRequest func(childComplexity int, id string) int
+		Pause          func(childComplexity int) int
+		Play           func(childComplexity int, resource *music.Selector) int
+		RejectRequest  func(childComplexity int, id string) int
 	}
 
 	MusicQuery struct {
-		Current func(childComplexity int) int
+		Current  func(childComplexity int) int
+		Requests func(childComplexity int, code *string) int
+	}
+
+	MusicRequest struct {
+		CreatedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Requester  func(childComplexity int) int
+		ResolvedAt func(childComplexity int) int
+		Resource   func(childComplexity int) int
+		Status     func(childComplexity int) int
+		Track      func(childComplexity int) int
+	}
+
+	MusicRequestedResource struct {
+		ID   func(childComplexity int) int
+		Kind func(childComplexity int) int
+		URI  func(childComplexity int) int
 	}
 
 	MusicTrack struct {
@@ -206,12 +374,16 @@ type ComplexityRoot struct {
 		Duration    func(childComplexity int) int
 		ExternalURL func(childComplexity int) int
 		ID          func(childComplexity int) int
+		Lyrics      func(childComplexity int) int
 		Name        func(childComplexity int) int
 		URI         func(childComplexity int) int
 	}
 
 	Mutation struct {
-		Music func(childComplexity int, code string) int
+		BookMeeting  func(childComplexity int, code string, booking schedgql.BookingInput) int
+		Music        func(childComplexity int, code string) int
+		Productivity func(childComplexity int, code string) int
+		RequestMusic func(childComplexity int, code string, resource music.Selector, requester *string) int
 	}
 
 	NearbyTransitDeparture struct {
@@ -230,8 +402,20 @@ type ComplexityRoot struct {
 	}
 
 	Productivity struct {
-		Records func(childComplexity int) int
-		Score   func(childComplexity int) int
+		Activities      func(childComplexity int, code string, limit *int) int
+		FocusSessions   func(childComplexity int, code *string) int
+		Goals           func(childComplexity int, code string) int
+		Records         func(childComplexity int) int
+		Score           func(childComplexity int) int
+		ScoreModel      func(childComplexity int) int
+		TopDistractions func(childComplexity int, code string, limit *int) int
+	}
+
+	ProductivityActivity struct {
+		Category func(childComplexity int) int
+		Duration func(childComplexity int) int
+		Group    func(childComplexity int) int
+		Name     func(childComplexity int) int
 	}
 
 	ProductivityCategory struct {
@@ -240,28 +424,143 @@ type ComplexityRoot struct {
 		Weight func(childComplexity int) int
 	}
 
+	ProductivityFocusSession struct {
+		End     func(childComplexity int) int
+		ID      func(childComplexity int) int
+		Label   func(childComplexity int) int
+		Records func(childComplexity int) int
+		Score   func(childComplexity int) int
+		Start   func(childComplexity int) int
+	}
+
+	ProductivityGoal struct {
+		Category  func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Duration  func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Kind      func(childComplexity int) int
+		Weekdays  func(childComplexity int) int
+	}
+
+	ProductivityGoalProgress struct {
+		Active   func(childComplexity int) int
+		Breached func(childComplexity int) int
+		Date     func(childComplexity int) int
+		Duration func(childComplexity int) int
+		Goal     func(childComplexity int) int
+		Met      func(childComplexity int) int
+		Percent  func(childComplexity int) int
+	}
+
+	ProductivityMutation struct {
+		CreateGoal        func(childComplexity int, goal prodgql.GoalInput) int
+		DeleteGoal        func(childComplexity int, id string) int
+		StartFocusSession func(childComplexity int, label *string) int
+		StopFocusSession  func(childComplexity int) int
+		UpdateGoal        func(childComplexity int, id string, goal prodgql.GoalInput) int
+	}
+
 	ProductivityRecord struct {
 		Category func(childComplexity int) int
 		Duration func(childComplexity int) int
 	}
 
+	ProductivityTrend struct {
+		From       func(childComplexity int) int
+		Points     func(childComplexity int) int
+		Resolution func(childComplexity int) int
+		To         func(childComplexity int) int
+	}
+
+	ProductivityTrendPoint struct {
+		End           func(childComplexity int) int
+		MovingAverage func(childComplexity int) int
+		Records       func(childComplexity int) int
+		Score         func(childComplexity int) int
+		Start         func(childComplexity int) int
+		WeekOverWeek  func(childComplexity int) int
+	}
+
 	Query struct {
-		About        func(childComplexity int, code *string) int
-		Assist       func(childComplexity int) int
-		Auth         func(childComplexity int) int
-		Git          func(childComplexity int) int
-		Location     func(childComplexity int) int
-		Music        func(childComplexity int) int
-		Productivity func(childComplexity int) int
-		Scheduling   func(childComplexity int) int
+		About             func(childComplexity int, code *string) int
+		Assist            func(childComplexity int) int
+		Auth              func(childComplexity int) int
+		Git               func(childComplexity int) int
+		Location          func(childComplexity int) int
+		Music             func(childComplexity int) int
+		Productivity      func(childComplexity int) int
+		ProductivityTrend func(childComplexity int, from time.Time, to time.Time, resolution *string, window *int) int
+		Scheduling        func(childComplexity int) int
+		Status            func(childComplexity int, code *string) int
+	}
+
+	SchedulingBooking struct {
+		CreatedAt func(childComplexity int) int
+		Email     func(childComplexity int) int
+		End       func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Ics       func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Notes     func(childComplexity int) int
+		Start     func(childComplexity int) int
+		Title     func(childComplexity int) int
+	}
+
+	SchedulingEvent struct {
+		AllDay        func(childComplexity int) int
+		Attendees     func(childComplexity int) int
+		Busy          func(childComplexity int) int
+		ConferenceURL func(childComplexity int) int
+		End           func(childComplexity int) int
+		Location      func(childComplexity int) int
+		Start         func(childComplexity int) int
+		Title         func(childComplexity int) int
+		Transparency  func(childComplexity int) int
 	}
 
 	SchedulingQuery struct {
-		BusyTimes func(childComplexity int, code *string, date *time.Time) int
+		Availability func(childComplexity int, from time.Time, to time.Time, duration int, workingHours *schedgql.WorkingHoursInput, buffer *int, limit *int) int
+		BusyTimes    func(childComplexity int, code *string, date *time.Time, from *time.Time, to *time.Time) int
+		Events       func(childComplexity int, code *string, date *time.Time) int
+		TravelBlocks func(childComplexity int, code *string, date *time.Time) int
+	}
+
+	SchedulingSlot struct {
+		End   func(childComplexity int) int
+		Score func(childComplexity int) int
+		Start func(childComplexity int) int
+	}
+
+	SchedulingTravelBlock struct {
+		Departure   func(childComplexity int) int
+		Destination func(childComplexity int) int
+		Distance    func(childComplexity int) int
+		Duration    func(childComplexity int) int
+		End         func(childComplexity int) int
+		Estimated   func(childComplexity int) int
+		Feasible    func(childComplexity int) int
+		Origin      func(childComplexity int) int
+		Slack       func(childComplexity int) int
+		Start       func(childComplexity int) int
+		Warning     func(childComplexity int) int
+	}
+
+	Status struct {
+		Activity   func(childComplexity int) int
+		Confidence func(childComplexity int) int
+		Detail     func(childComplexity int) int
+		Since      func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+		Until      func(childComplexity int) int
 	}
 
 	Subscription struct {
-		Music func(childComplexity int) int
+		GitCommits               func(childComplexity int) int
+		Music                    func(childComplexity int) int
+		MusicLyricLine           func(childComplexity int) int
+		MusicRequests            func(childComplexity int, code *string) int
+		ProductivityGoalBreached func(childComplexity int, code string) int
+		Status                   func(childComplexity int, code *string) int
 	}
 
 	TimeSpan struct {
@@ -319,6 +618,34 @@ type FullAboutResolver interface {
 	Birthday(ctx context.Context, obj *about.About) (string, error)
 	Age(ctx context.Context, obj *about.About) (string, error)
 }
+type GitCommitResolver interface {
+	Verification(ctx context.Context, obj *git.Commit) (*git.Verification, error)
+}
+type GitCommitVerificationResolver interface {
+	Format(ctx context.Context, obj *git.Verification) (*string, error)
+}
+type GitContributionsResolver interface {
+	BusiestWeekday(ctx context.Context, obj *stats.Contributions) (*string, error)
+}
+type GitIssueActivityResolver interface {
+	Action(ctx context.Context, obj *git.IssueActivity) (string, error)
+}
+type GitPullRequestActivityResolver interface {
+	Action(ctx context.Context, obj *git.PullRequestActivity) (string, error)
+}
+type GitRepoResolver interface {
+	Description(ctx context.Context, obj *git.Repo) (*string, error)
+	PrimaryLanguage(ctx context.Context, obj *git.Repo) (*string, error)
+	Languages(ctx context.Context, obj *git.Repo) ([]git.RepoLanguage, error)
+	Stars(ctx context.Context, obj *git.Repo) (*int, error)
+	Topics(ctx context.Context, obj *git.Repo) ([]string, error)
+	PushedAt(ctx context.Context, obj *git.Repo) (*time.Time, error)
+	IsFork(ctx context.Context, obj *git.Repo) (*bool, error)
+	IsArchived(ctx context.Context, obj *git.Repo) (*bool, error)
+}
+type GitReviewActivityResolver interface {
+	State(ctx context.Context, obj *git.ReviewActivity) (string, error)
+}
 type LocationHistorySegmentResolver interface {
 	Address(ctx context.Context, obj *location.HistorySegment) (*string, error)
 
@@ -330,26 +657,68 @@ type MusicAlbumResolver interface {
 type MusicArtistResolver interface {
 	Albums(ctx context.Context, obj *music.Artist, limit *int, offset *int) ([]music.Album, error)
 }
+type MusicLyricLineResolver interface {
+	Time(ctx context.Context, obj *music.LyricLine) (int, error)
+}
+type MusicRequestResolver interface {
+	Resource(ctx context.Context, obj *music.Request) (*musicgql.RequestedResource, error)
+
+	Track(ctx context.Context, obj *music.Request) (*music.Track, error)
+	Status(ctx context.Context, obj *music.Request) (string, error)
+}
 type MusicTrackResolver interface {
 	Album(ctx context.Context, obj *music.Track) (*music.Album, error)
 	Duration(ctx context.Context, obj *music.Track) (int, error)
+	Lyrics(ctx context.Context, obj *music.Track) (*music.Lyrics, error)
 }
 type MutationResolver interface {
 	Music(ctx context.Context, code string) (*musicgql.Mutation, error)
+	Productivity(ctx context.Context, code string) (*prodgql.Mutation, error)
+	RequestMusic(ctx context.Context, code string, resource music.Selector, requester *string) (*music.Request, error)
+	BookMeeting(ctx context.Context, code string, booking schedgql.BookingInput) (*scheduling.Booking, error)
 }
 type PlaceResolver interface {
 	TimeZone(ctx context.Context, obj *location.Place) (*locgql.TimeZone, error)
 }
 type ProductivityResolver interface {
 	Score(ctx context.Context, obj *productivity.Productivity) (*int, error)
+
+	Activities(ctx context.Context, obj *productivity.Productivity, code string, limit *int) ([]productivity.Activity, error)
+	TopDistractions(ctx context.Context, obj *productivity.Productivity, code string, limit *int) ([]productivity.Activity, error)
+	Goals(ctx context.Context, obj *productivity.Productivity, code string) ([]productivity.GoalProgress, error)
+	FocusSessions(ctx context.Context, obj *productivity.Productivity, code *string) ([]productivity.FocusSession, error)
+}
+type ProductivityActivityResolver interface {
+	Category(ctx context.Context, obj *productivity.Activity) (*prodgql.Category, error)
+	Duration(ctx context.Context, obj *productivity.Activity) (int, error)
+}
+type ProductivityFocusSessionResolver interface {
+	Score(ctx context.Context, obj *productivity.FocusSession) (*int, error)
+}
+type ProductivityGoalResolver interface {
+	Category(ctx context.Context, obj *productivity.Goal) (*prodgql.Category, error)
+	Kind(ctx context.Context, obj *productivity.Goal) (string, error)
+	Duration(ctx context.Context, obj *productivity.Goal) (int, error)
+	Weekdays(ctx context.Context, obj *productivity.Goal) ([]string, error)
+}
+type ProductivityGoalProgressResolver interface {
+	Duration(ctx context.Context, obj *productivity.GoalProgress) (int, error)
 }
 type ProductivityRecordResolver interface {
 	Category(ctx context.Context, obj *productivity.Record) (*prodgql.Category, error)
 	Duration(ctx context.Context, obj *productivity.Record) (int, error)
 }
+type ProductivityTrendResolver interface {
+	Resolution(ctx context.Context, obj *productivity.Trend) (string, error)
+}
+type ProductivityTrendPointResolver interface {
+	Score(ctx context.Context, obj *productivity.TrendPoint) (*int, error)
+}
 type QueryResolver interface {
 	About(ctx context.Context, code *string) (about.ContactInfo, error)
 	Productivity(ctx context.Context) (*productivity.Productivity, error)
+	ProductivityTrend(ctx context.Context, from time.Time, to time.Time, resolution *string, window *int) (*productivity.Trend, error)
+	Status(ctx context.Context, code *string) (*status.Status, error)
 	Assist(ctx context.Context) (*assistgql.Query, error)
 	Git(ctx context.Context) (*gitgql.Query, error)
 	Auth(ctx context.Context) (*authgql.Query, error)
@@ -357,8 +726,27 @@ type QueryResolver interface {
 	Location(ctx context.Context) (*locgql.Query, error)
 	Scheduling(ctx context.Context) (*schedgql.Query, error)
 }
+type SchedulingBookingResolver interface {
+	Ics(ctx context.Context, obj *scheduling.Booking) (string, error)
+}
+type SchedulingEventResolver interface {
+	Transparency(ctx context.Context, obj *scheduling.Event) (string, error)
+}
+type SchedulingTravelBlockResolver interface {
+	Duration(ctx context.Context, obj *scheduling.TravelBlock) (int, error)
+
+	Slack(ctx context.Context, obj *scheduling.TravelBlock) (int, error)
+}
+type StatusResolver interface {
+	Activity(ctx context.Context, obj *status.Status) (string, error)
+}
 type SubscriptionResolver interface {
 	Music(ctx context.Context) (<-chan *music.CurrentlyPlaying, error)
+	MusicLyricLine(ctx context.Context) (<-chan *music.LyricLine, error)
+	MusicRequests(ctx context.Context, code *string) (<-chan []music.Request, error)
+	GitCommits(ctx context.Context) (<-chan *git.Commit, error)
+	ProductivityGoalBreached(ctx context.Context, code string) (<-chan *productivity.GoalProgress, error)
+	Status(ctx context.Context, code *string) (<-chan *status.Status, error)
 }
 type TransitDepartureResolver interface {
 	RelativeTimes(ctx context.Context, obj *transit.Departure) ([]string, error)
@@ -587,6 +975,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GitCommit.Message(childComplexity), true
 
+	case "GitCommit.parsedMessage":
+		if e.complexity.GitCommit.ParsedMessage == nil {
+			break
+		}
+
+		return e.complexity.GitCommit.ParsedMessage(childComplexity), true
+
 	case "GitCommit.repo":
 		if e.complexity.GitCommit.Repo == nil {
 			break
@@ -615,6 +1010,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GitCommit.URL(childComplexity), true
 
+	case "GitCommit.verification":
+		if e.complexity.GitCommit.Verification == nil {
+			break
+		}
+
+		return e.complexity.GitCommit.Verification(childComplexity), true
+
 	case "GitCommitAuthor.date":
 		if e.complexity.GitCommitAuthor.Date == nil {
 			break
@@ -629,6 +1031,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GitCommitAuthor.Email(childComplexity), true
 
+	case "GitCommitAuthor.isBot":
+		if e.complexity.GitCommitAuthor.IsBot == nil {
+			break
+		}
+
+		return e.complexity.GitCommitAuthor.IsBot(childComplexity), true
+
 	case "GitCommitAuthor.login":
 		if e.complexity.GitCommitAuthor.Login == nil {
 			break
@@ -643,2785 +1052,13865 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GitCommitAuthor.Name(childComplexity), true
 
-	case "GitQuery.recentCommits":
-		if e.complexity.GitQuery.RecentCommits == nil {
+	case "GitCommitMessage.body":
+		if e.complexity.GitCommitMessage.Body == nil {
 			break
 		}
 
-		args, err := ec.field_GitQuery_recentCommits_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.GitCommitMessage.Body(childComplexity), true
+
+	case "GitCommitMessage.breaking":
+		if e.complexity.GitCommitMessage.Breaking == nil {
+			break
 		}
 
-		return e.complexity.GitQuery.RecentCommits(childComplexity, args["limit"].(*int)), true
+		return e.complexity.GitCommitMessage.Breaking(childComplexity), true
 
-	case "GitRepo.name":
-		if e.complexity.GitRepo.Name == nil {
+	case "GitCommitMessage.coAuthors":
+		if e.complexity.GitCommitMessage.CoAuthors == nil {
 			break
 		}
 
-		return e.complexity.GitRepo.Name(childComplexity), true
+		return e.complexity.GitCommitMessage.CoAuthors(childComplexity), true
 
-	case "GitRepo.url":
-		if e.complexity.GitRepo.URL == nil {
+	case "GitCommitMessage.isMerge":
+		if e.complexity.GitCommitMessage.IsMerge == nil {
 			break
 		}
 
-		return e.complexity.GitRepo.URL(childComplexity), true
+		return e.complexity.GitCommitMessage.IsMerge(childComplexity), true
 
-	case "LocationHistorySegment.address":
-		if e.complexity.LocationHistorySegment.Address == nil {
+	case "GitCommitMessage.issueRefs":
+		if e.complexity.GitCommitMessage.IssueRefs == nil {
 			break
 		}
 
-		return e.complexity.LocationHistorySegment.Address(childComplexity), true
+		return e.complexity.GitCommitMessage.IssueRefs(childComplexity), true
 
-	case "LocationHistorySegment.category":
-		if e.complexity.LocationHistorySegment.Category == nil {
+	case "GitCommitMessage.scope":
+		if e.complexity.GitCommitMessage.Scope == nil {
 			break
 		}
 
-		return e.complexity.LocationHistorySegment.Category(childComplexity), true
+		return e.complexity.GitCommitMessage.Scope(childComplexity), true
 
-	case "LocationHistorySegment.coordinates":
-		if e.complexity.LocationHistorySegment.Coordinates == nil {
+	case "GitCommitMessage.subject":
+		if e.complexity.GitCommitMessage.Subject == nil {
 			break
 		}
 
-		return e.complexity.LocationHistorySegment.Coordinates(childComplexity), true
+		return e.complexity.GitCommitMessage.Subject(childComplexity), true
 
-	case "LocationHistorySegment.description":
-		if e.complexity.LocationHistorySegment.Description == nil {
+	case "GitCommitMessage.type":
+		if e.complexity.GitCommitMessage.Type == nil {
 			break
 		}
 
-		return e.complexity.LocationHistorySegment.Description(childComplexity), true
+		return e.complexity.GitCommitMessage.Type(childComplexity), true
 
-	case "LocationHistorySegment.distance":
-		if e.complexity.LocationHistorySegment.Distance == nil {
+	case "GitCommitPage.byRepo":
+		if e.complexity.GitCommitPage.ByRepo == nil {
 			break
 		}
 
-		return e.complexity.LocationHistorySegment.Distance(childComplexity), true
+		return e.complexity.GitCommitPage.ByRepo(childComplexity), true
 
-	case "LocationHistorySegment.place":
-		if e.complexity.LocationHistorySegment.Place == nil {
+	case "GitCommitPage.commits":
+		if e.complexity.GitCommitPage.Commits == nil {
 			break
 		}
 
-		return e.complexity.LocationHistorySegment.Place(childComplexity), true
+		return e.complexity.GitCommitPage.Commits(childComplexity), true
 
-	case "LocationHistorySegment.timeSpan":
-		if e.complexity.LocationHistorySegment.TimeSpan == nil {
+	case "GitCommitPage.endCursor":
+		if e.complexity.GitCommitPage.EndCursor == nil {
 			break
 		}
 
-		return e.complexity.LocationHistorySegment.TimeSpan(childComplexity), true
+		return e.complexity.GitCommitPage.EndCursor(childComplexity), true
 
-	case "LocationQuery.history":
-		if e.complexity.LocationQuery.History == nil {
+	case "GitCommitPage.hasNextPage":
+		if e.complexity.GitCommitPage.HasNextPage == nil {
 			break
 		}
 
-		args, err := ec.field_LocationQuery_history_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.GitCommitPage.HasNextPage(childComplexity), true
+
+	case "GitCommitVerification.format":
+		if e.complexity.GitCommitVerification.Format == nil {
+			break
 		}
 
-		return e.complexity.LocationQuery.History(childComplexity, args["code"].(string), args["date"].(*time.Time)), true
+		return e.complexity.GitCommitVerification.Format(childComplexity), true
 
-	case "LocationQuery.region":
-		if e.complexity.LocationQuery.Region == nil {
+	case "GitCommitVerification.signed":
+		if e.complexity.GitCommitVerification.Signed == nil {
 			break
 		}
 
-		return e.complexity.LocationQuery.Region(childComplexity), true
+		return e.complexity.GitCommitVerification.Signed(childComplexity), true
 
-	case "MaskedAbout.approxAge":
-		if e.complexity.MaskedAbout.ApproxAge == nil {
+	case "GitCommitVerification.signer":
+		if e.complexity.GitCommitVerification.Signer == nil {
 			break
 		}
 
-		return e.complexity.MaskedAbout.ApproxAge(childComplexity), true
+		return e.complexity.GitCommitVerification.Signer(childComplexity), true
 
-	case "MaskedAbout.email":
-		if e.complexity.MaskedAbout.Email == nil {
+	case "GitCommitVerification.state":
+		if e.complexity.GitCommitVerification.State == nil {
 			break
 		}
 
-		return e.complexity.MaskedAbout.Email(childComplexity), true
+		return e.complexity.GitCommitVerification.State(childComplexity), true
 
-	case "MaskedAbout.iq":
-		if e.complexity.MaskedAbout.IQ == nil {
+	case "GitCommitVerification.verified":
+		if e.complexity.GitCommitVerification.Verified == nil {
 			break
 		}
 
-		return e.complexity.MaskedAbout.IQ(childComplexity), true
+		return e.complexity.GitCommitVerification.Verified(childComplexity), true
 
-	case "MaskedAbout.name":
-		if e.complexity.MaskedAbout.Name == nil {
+	case "GitContributionDay.count":
+		if e.complexity.GitContributionDay.Count == nil {
 			break
 		}
 
-		return e.complexity.MaskedAbout.Name(childComplexity), true
+		return e.complexity.GitContributionDay.Count(childComplexity), true
 
-	case "MaskedAbout.skills":
-		if e.complexity.MaskedAbout.Skills == nil {
+	case "GitContributionDay.date":
+		if e.complexity.GitContributionDay.Date == nil {
 			break
 		}
 
-		return e.complexity.MaskedAbout.Skills(childComplexity), true
+		return e.complexity.GitContributionDay.Date(childComplexity), true
 
-	case "MaskedAbout.type":
-		if e.complexity.MaskedAbout.Type == nil {
+	case "GitContributions.busiestHour":
+		if e.complexity.GitContributions.BusiestHour == nil {
 			break
 		}
 
-		return e.complexity.MaskedAbout.Type(childComplexity), true
+		return e.complexity.GitContributions.BusiestHour(childComplexity), true
 
-	case "MaskedAbout.whereabouts":
-		if e.complexity.MaskedAbout.Whereabouts == nil {
+	case "GitContributions.busiestWeekday":
+		if e.complexity.GitContributions.BusiestWeekday == nil {
 			break
 		}
 
-		return e.complexity.MaskedAbout.Whereabouts(childComplexity), true
+		return e.complexity.GitContributions.BusiestWeekday(childComplexity), true
 
-	case "MusicAlbum.artists":
-		if e.complexity.MusicAlbum.Artists == nil {
+	case "GitContributions.currentStreak":
+		if e.complexity.GitContributions.CurrentStreak == nil {
 			break
 		}
 
-		return e.complexity.MusicAlbum.Artists(childComplexity), true
+		return e.complexity.GitContributions.CurrentStreak(childComplexity), true
 
-	case "MusicAlbum.externalURL":
-		if e.complexity.MusicAlbum.ExternalURL == nil {
+	case "GitContributions.days":
+		if e.complexity.GitContributions.Days == nil {
 			break
 		}
 
-		return e.complexity.MusicAlbum.ExternalURL(childComplexity), true
+		return e.complexity.GitContributions.Days(childComplexity), true
 
-	case "MusicAlbum.id":
-		if e.complexity.MusicAlbum.ID == nil {
+	case "GitContributions.from":
+		if e.complexity.GitContributions.From == nil {
 			break
 		}
 
-		return e.complexity.MusicAlbum.ID(childComplexity), true
+		return e.complexity.GitContributions.From(childComplexity), true
 
-	case "MusicAlbum.images":
-		if e.complexity.MusicAlbum.Images == nil {
+	case "GitContributions.languages":
+		if e.complexity.GitContributions.Languages == nil {
 			break
 		}
 
-		return e.complexity.MusicAlbum.Images(childComplexity), true
+		return e.complexity.GitContributions.Languages(childComplexity), true
 
-	case "MusicAlbum.name":
-		if e.complexity.MusicAlbum.Name == nil {
+	case "GitContributions.longestStreak":
+		if e.complexity.GitContributions.LongestStreak == nil {
 			break
 		}
 
-		return e.complexity.MusicAlbum.Name(childComplexity), true
+		return e.complexity.GitContributions.LongestStreak(childComplexity), true
 
-	case "MusicAlbum.tracks":
-		if e.complexity.MusicAlbum.Tracks == nil {
+	case "GitContributions.to":
+		if e.complexity.GitContributions.To == nil {
 			break
 		}
 
-		args, err := ec.field_MusicAlbum_tracks_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.GitContributions.To(childComplexity), true
+
+	case "GitContributions.total":
+		if e.complexity.GitContributions.Total == nil {
+			break
 		}
 
-		return e.complexity.MusicAlbum.Tracks(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+		return e.complexity.GitContributions.Total(childComplexity), true
 
-	case "MusicAlbum.uri":
-		if e.complexity.MusicAlbum.URI == nil {
+	case "GitIssueActivity.action":
+		if e.complexity.GitIssueActivity.Action == nil {
 			break
 		}
 
-		return e.complexity.MusicAlbum.URI(childComplexity), true
+		return e.complexity.GitIssueActivity.Action(childComplexity), true
 
-	case "MusicArtist.albums":
-		if e.complexity.MusicArtist.Albums == nil {
+	case "GitIssueActivity.number":
+		if e.complexity.GitIssueActivity.Number == nil {
 			break
 		}
 
-		args, err := ec.field_MusicArtist_albums_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.GitIssueActivity.Number(childComplexity), true
+
+	case "GitIssueActivity.repo":
+		if e.complexity.GitIssueActivity.Repo == nil {
+			break
 		}
 
-		return e.complexity.MusicArtist.Albums(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+		return e.complexity.GitIssueActivity.Repo(childComplexity), true
 
-	case "MusicArtist.externalURL":
-		if e.complexity.MusicArtist.ExternalURL == nil {
+	case "GitIssueActivity.timestamp":
+		if e.complexity.GitIssueActivity.Timestamp == nil {
 			break
 		}
 
-		return e.complexity.MusicArtist.ExternalURL(childComplexity), true
+		return e.complexity.GitIssueActivity.Timestamp(childComplexity), true
 
-	case "MusicArtist.id":
-		if e.complexity.MusicArtist.ID == nil {
+	case "GitIssueActivity.title":
+		if e.complexity.GitIssueActivity.Title == nil {
 			break
 		}
 
-		return e.complexity.MusicArtist.ID(childComplexity), true
+		return e.complexity.GitIssueActivity.Title(childComplexity), true
 
-	case "MusicArtist.name":
-		if e.complexity.MusicArtist.Name == nil {
+	case "GitIssueActivity.url":
+		if e.complexity.GitIssueActivity.URL == nil {
 			break
 		}
 
-		return e.complexity.MusicArtist.Name(childComplexity), true
+		return e.complexity.GitIssueActivity.URL(childComplexity), true
 
-	case "MusicArtist.uri":
-		if e.complexity.MusicArtist.URI == nil {
+	case "GitIssueRef.closes":
+		if e.complexity.GitIssueRef.Closes == nil {
 			break
 		}
 
-		return e.complexity.MusicArtist.URI(childComplexity), true
+		return e.complexity.GitIssueRef.Closes(childComplexity), true
 
-	case "MusicImage.height":
-		if e.complexity.MusicImage.Height == nil {
+	case "GitIssueRef.number":
+		if e.complexity.GitIssueRef.Number == nil {
 			break
 		}
 
-		return e.complexity.MusicImage.Height(childComplexity), true
+		return e.complexity.GitIssueRef.Number(childComplexity), true
 
-	case "MusicImage.url":
-		if e.complexity.MusicImage.URL == nil {
+	case "GitIssueRef.repo":
+		if e.complexity.GitIssueRef.Repo == nil {
 			break
 		}
 
-		return e.complexity.MusicImage.URL(childComplexity), true
+		return e.complexity.GitIssueRef.Repo(childComplexity), true
 
-	case "MusicImage.width":
-		if e.complexity.MusicImage.Width == nil {
+	case "GitLanguageCount.count":
+		if e.complexity.GitLanguageCount.Count == nil {
 			break
 		}
 
-		return e.complexity.MusicImage.Width(childComplexity), true
+		return e.complexity.GitLanguageCount.Count(childComplexity), true
 
-	case "MusicMutation.pause":
-		if e.complexity.MusicMutation.Pause == nil {
+	case "GitLanguageCount.language":
+		if e.complexity.GitLanguageCount.Language == nil {
 			break
 		}
 
-		return e.complexity.MusicMutation.Pause(childComplexity), true
+		return e.complexity.GitLanguageCount.Language(childComplexity), true
 
-	case "MusicMutation.play":
-		if e.complexity.MusicMutation.Play == nil {
+	case "GitPullRequest.number":
+		if e.complexity.GitPullRequest.Number == nil {
 			break
 		}
 
-		args, err := ec.field_MusicMutation_play_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.GitPullRequest.Number(childComplexity), true
+
+	case "GitPullRequest.title":
+		if e.complexity.GitPullRequest.Title == nil {
+			break
 		}
 
-		return e.complexity.MusicMutation.Play(childComplexity, args["resource"].(*music.Selector)), true
+		return e.complexity.GitPullRequest.Title(childComplexity), true
 
-	case "MusicQuery.current":
-		if e.complexity.MusicQuery.Current == nil {
+	case "GitPullRequest.url":
+		if e.complexity.GitPullRequest.URL == nil {
 			break
 		}
 
-		return e.complexity.MusicQuery.Current(childComplexity), true
+		return e.complexity.GitPullRequest.URL(childComplexity), true
 
-	case "MusicTrack.album":
-		if e.complexity.MusicTrack.Album == nil {
+	case "GitPullRequestActivity.action":
+		if e.complexity.GitPullRequestActivity.Action == nil {
 			break
 		}
 
-		return e.complexity.MusicTrack.Album(childComplexity), true
+		return e.complexity.GitPullRequestActivity.Action(childComplexity), true
 
-	case "MusicTrack.artists":
-		if e.complexity.MusicTrack.Artists == nil {
+	case "GitPullRequestActivity.pullRequest":
+		if e.complexity.GitPullRequestActivity.PullRequest == nil {
 			break
 		}
 
-		return e.complexity.MusicTrack.Artists(childComplexity), true
+		return e.complexity.GitPullRequestActivity.PullRequest(childComplexity), true
 
-	case "MusicTrack.duration":
-		if e.complexity.MusicTrack.Duration == nil {
+	case "GitPullRequestActivity.repo":
+		if e.complexity.GitPullRequestActivity.Repo == nil {
 			break
 		}
 
-		return e.complexity.MusicTrack.Duration(childComplexity), true
+		return e.complexity.GitPullRequestActivity.Repo(childComplexity), true
 
-	case "MusicTrack.externalURL":
-		if e.complexity.MusicTrack.ExternalURL == nil {
+	case "GitPullRequestActivity.timestamp":
+		if e.complexity.GitPullRequestActivity.Timestamp == nil {
 			break
 		}
 
-		return e.complexity.MusicTrack.ExternalURL(childComplexity), true
+		return e.complexity.GitPullRequestActivity.Timestamp(childComplexity), true
 
-	case "MusicTrack.id":
-		if e.complexity.MusicTrack.ID == nil {
+	case "GitQuery.activity":
+		if e.complexity.GitQuery.Activity == nil {
 			break
 		}
 
-		return e.complexity.MusicTrack.ID(childComplexity), true
-
-	case "MusicTrack.name":
-		if e.complexity.MusicTrack.Name == nil {
-			break
+		args, err := ec.field_GitQuery_activity_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.MusicTrack.Name(childComplexity), true
+		return e.complexity.GitQuery.Activity(childComplexity, args["types"].([]string), args["first"].(*int)), true
 
-	case "MusicTrack.uri":
-		if e.complexity.MusicTrack.URI == nil {
+	case "GitQuery.commits":
+		if e.complexity.GitQuery.Commits == nil {
 			break
 		}
 
-		return e.complexity.MusicTrack.URI(childComplexity), true
+		args, err := ec.field_GitQuery_commits_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Mutation.music":
-		if e.complexity.Mutation.Music == nil {
+		return e.complexity.GitQuery.Commits(childComplexity, args["repo"].(*string), args["since"].(*time.Time), args["until"].(*time.Time), args["workOnly"].(*bool), args["first"].(*int), args["after"].(*string)), true
+
+	case "GitQuery.contributions":
+		if e.complexity.GitQuery.Contributions == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_music_args(context.TODO(), rawArgs)
+		args, err := ec.field_GitQuery_contributions_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.Music(childComplexity, args["code"].(string)), true
+		return e.complexity.GitQuery.Contributions(childComplexity, args["from"].(*time.Time), args["to"].(*time.Time)), true
 
-	case "NearbyTransitDeparture.departure":
-		if e.complexity.NearbyTransitDeparture.Departure == nil {
+	case "GitQuery.recentCommits":
+		if e.complexity.GitQuery.RecentCommits == nil {
 			break
 		}
 
-		return e.complexity.NearbyTransitDeparture.Departure(childComplexity), true
-
-	case "NearbyTransitDeparture.distance":
-		if e.complexity.NearbyTransitDeparture.Distance == nil {
-			break
+		args, err := ec.field_GitQuery_recentCommits_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.NearbyTransitDeparture.Distance(childComplexity), true
+		return e.complexity.GitQuery.RecentCommits(childComplexity, args["limit"].(*int)), true
 
-	case "Place.address":
-		if e.complexity.Place.Address == nil {
+	case "GitReleaseActivity.name":
+		if e.complexity.GitReleaseActivity.Name == nil {
 			break
 		}
 
-		return e.complexity.Place.Address(childComplexity), true
+		return e.complexity.GitReleaseActivity.Name(childComplexity), true
 
-	case "Place.id":
-		if e.complexity.Place.ID == nil {
+	case "GitReleaseActivity.prerelease":
+		if e.complexity.GitReleaseActivity.Prerelease == nil {
 			break
 		}
 
-		return e.complexity.Place.ID(childComplexity), true
+		return e.complexity.GitReleaseActivity.Prerelease(childComplexity), true
 
-	case "Place.level":
-		if e.complexity.Place.Level == nil {
+	case "GitReleaseActivity.repo":
+		if e.complexity.GitReleaseActivity.Repo == nil {
 			break
 		}
 
-		return e.complexity.Place.Level(childComplexity), true
+		return e.complexity.GitReleaseActivity.Repo(childComplexity), true
 
-	case "Place.position":
-		if e.complexity.Place.Position == nil {
+	case "GitReleaseActivity.tag":
+		if e.complexity.GitReleaseActivity.Tag == nil {
 			break
 		}
 
-		return e.complexity.Place.Position(childComplexity), true
+		return e.complexity.GitReleaseActivity.Tag(childComplexity), true
 
-	case "Place.shape":
-		if e.complexity.Place.Shape == nil {
+	case "GitReleaseActivity.timestamp":
+		if e.complexity.GitReleaseActivity.Timestamp == nil {
 			break
 		}
 
-		return e.complexity.Place.Shape(childComplexity), true
+		return e.complexity.GitReleaseActivity.Timestamp(childComplexity), true
 
-	case "Place.timeZone":
-		if e.complexity.Place.TimeZone == nil {
+	case "GitReleaseActivity.url":
+		if e.complexity.GitReleaseActivity.URL == nil {
 			break
 		}
 
-		return e.complexity.Place.TimeZone(childComplexity), true
+		return e.complexity.GitReleaseActivity.URL(childComplexity), true
 
-	case "Place.type":
-		if e.complexity.Place.Type == nil {
+	case "GitRepo.description":
+		if e.complexity.GitRepo.Description == nil {
 			break
 		}
 
-		return e.complexity.Place.Type(childComplexity), true
+		return e.complexity.GitRepo.Description(childComplexity), true
 
-	case "Productivity.records":
-		if e.complexity.Productivity.Records == nil {
+	case "GitRepo.isArchived":
+		if e.complexity.GitRepo.IsArchived == nil {
 			break
 		}
 
-		return e.complexity.Productivity.Records(childComplexity), true
+		return e.complexity.GitRepo.IsArchived(childComplexity), true
 
-	case "Productivity.score":
-		if e.complexity.Productivity.Score == nil {
+	case "GitRepo.isFork":
+		if e.complexity.GitRepo.IsFork == nil {
 			break
 		}
 
-		return e.complexity.Productivity.Score(childComplexity), true
+		return e.complexity.GitRepo.IsFork(childComplexity), true
 
-	case "ProductivityCategory.id":
-		if e.complexity.ProductivityCategory.ID == nil {
+	case "GitRepo.languages":
+		if e.complexity.GitRepo.Languages == nil {
 			break
 		}
 
-		return e.complexity.ProductivityCategory.ID(childComplexity), true
+		return e.complexity.GitRepo.Languages(childComplexity), true
 
-	case "ProductivityCategory.name":
-		if e.complexity.ProductivityCategory.Name == nil {
+	case "GitRepo.name":
+		if e.complexity.GitRepo.Name == nil {
 			break
 		}
 
-		return e.complexity.ProductivityCategory.Name(childComplexity), true
+		return e.complexity.GitRepo.Name(childComplexity), true
 
-	case "ProductivityCategory.weight":
-		if e.complexity.ProductivityCategory.Weight == nil {
+	case "GitRepo.primaryLanguage":
+		if e.complexity.GitRepo.PrimaryLanguage == nil {
 			break
 		}
 
-		return e.complexity.ProductivityCategory.Weight(childComplexity), true
+		return e.complexity.GitRepo.PrimaryLanguage(childComplexity), true
 
-	case "ProductivityRecord.category":
-		if e.complexity.ProductivityRecord.Category == nil {
+	case "GitRepo.pushedAt":
+		if e.complexity.GitRepo.PushedAt == nil {
 			break
 		}
 
-		return e.complexity.ProductivityRecord.Category(childComplexity), true
+		return e.complexity.GitRepo.PushedAt(childComplexity), true
 
-	case "ProductivityRecord.duration":
-		if e.complexity.ProductivityRecord.Duration == nil {
+	case "GitRepo.stars":
+		if e.complexity.GitRepo.Stars == nil {
 			break
 		}
 
-		return e.complexity.ProductivityRecord.Duration(childComplexity), true
+		return e.complexity.GitRepo.Stars(childComplexity), true
 
-	case "Query.about":
-		if e.complexity.Query.About == nil {
+	case "GitRepo.topics":
+		if e.complexity.GitRepo.Topics == nil {
 			break
 		}
 
-		args, err := ec.field_Query_about_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
+		return e.complexity.GitRepo.Topics(childComplexity), true
 
-		return e.complexity.Query.About(childComplexity, args["code"].(*string)), true
-
-	case "Query.assist":
-		if e.complexity.Query.Assist == nil {
+	case "GitRepo.url":
+		if e.complexity.GitRepo.URL == nil {
 			break
 		}
 
-		return e.complexity.Query.Assist(childComplexity), true
+		return e.complexity.GitRepo.URL(childComplexity), true
 
-	case "Query.auth":
-		if e.complexity.Query.Auth == nil {
+	case "GitRepoCommits.commits":
+		if e.complexity.GitRepoCommits.Commits == nil {
 			break
 		}
 
-		return e.complexity.Query.Auth(childComplexity), true
+		return e.complexity.GitRepoCommits.Commits(childComplexity), true
 
-	case "Query.git":
-		if e.complexity.Query.Git == nil {
+	case "GitRepoCommits.repo":
+		if e.complexity.GitRepoCommits.Repo == nil {
 			break
 		}
 
-		return e.complexity.Query.Git(childComplexity), true
+		return e.complexity.GitRepoCommits.Repo(childComplexity), true
 
-	case "Query.location":
-		if e.complexity.Query.Location == nil {
+	case "GitRepoLanguage.bytes":
+		if e.complexity.GitRepoLanguage.Bytes == nil {
 			break
 		}
 
-		return e.complexity.Query.Location(childComplexity), true
+		return e.complexity.GitRepoLanguage.Bytes(childComplexity), true
 
-	case "Query.music":
-		if e.complexity.Query.Music == nil {
+	case "GitRepoLanguage.name":
+		if e.complexity.GitRepoLanguage.Name == nil {
 			break
 		}
 
-		return e.complexity.Query.Music(childComplexity), true
+		return e.complexity.GitRepoLanguage.Name(childComplexity), true
 
-	case "Query.productivity":
-		if e.complexity.Query.Productivity == nil {
+	case "GitReviewActivity.pullRequest":
+		if e.complexity.GitReviewActivity.PullRequest == nil {
 			break
 		}
 
-		return e.complexity.Query.Productivity(childComplexity), true
+		return e.complexity.GitReviewActivity.PullRequest(childComplexity), true
 
-	case "Query.scheduling":
-		if e.complexity.Query.Scheduling == nil {
+	case "GitReviewActivity.repo":
+		if e.complexity.GitReviewActivity.Repo == nil {
 			break
 		}
 
-		return e.complexity.Query.Scheduling(childComplexity), true
+		return e.complexity.GitReviewActivity.Repo(childComplexity), true
 
-	case "SchedulingQuery.busyTimes":
-		if e.complexity.SchedulingQuery.BusyTimes == nil {
+	case "GitReviewActivity.state":
+		if e.complexity.GitReviewActivity.State == nil {
 			break
 		}
 
-		args, err := ec.field_SchedulingQuery_busyTimes_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
+		return e.complexity.GitReviewActivity.State(childComplexity), true
 
-		return e.complexity.SchedulingQuery.BusyTimes(childComplexity, args["code"].(*string), args["date"].(*time.Time)), true
-
-	case "Subscription.music":
-		if e.complexity.Subscription.Music == nil {
+	case "GitReviewActivity.timestamp":
+		if e.complexity.GitReviewActivity.Timestamp == nil {
 			break
 		}
 
-		return e.complexity.Subscription.Music(childComplexity), true
+		return e.complexity.GitReviewActivity.Timestamp(childComplexity), true
 
-	case "TimeSpan.end":
-		if e.complexity.TimeSpan.End == nil {
+	case "GitReviewActivity.url":
+		if e.complexity.GitReviewActivity.URL == nil {
 			break
 		}
 
-		return e.complexity.TimeSpan.End(childComplexity), true
+		return e.complexity.GitReviewActivity.URL(childComplexity), true
 
-	case "TimeSpan.start":
-		if e.complexity.TimeSpan.Start == nil {
+	case "LocationHistorySegment.address":
+		if e.complexity.LocationHistorySegment.Address == nil {
 			break
 		}
 
-		return e.complexity.TimeSpan.Start(childComplexity), true
+		return e.complexity.LocationHistorySegment.Address(childComplexity), true
 
-	case "TimeZone.name":
-		if e.complexity.TimeZone.Name == nil {
+	case "LocationHistorySegment.category":
+		if e.complexity.LocationHistorySegment.Category == nil {
 			break
 		}
 
-		return e.complexity.TimeZone.Name(childComplexity), true
+		return e.complexity.LocationHistorySegment.Category(childComplexity), true
 
-	case "TimeZone.offset":
-		if e.complexity.TimeZone.Offset == nil {
+	case "LocationHistorySegment.coordinates":
+		if e.complexity.LocationHistorySegment.Coordinates == nil {
 			break
 		}
 
-		return e.complexity.TimeZone.Offset(childComplexity), true
+		return e.complexity.LocationHistorySegment.Coordinates(childComplexity), true
 
-	case "TransitDeparture.realtime":
-		if e.complexity.TransitDeparture.Realtime == nil {
+	case "LocationHistorySegment.description":
+		if e.complexity.LocationHistorySegment.Description == nil {
 			break
 		}
 
-		return e.complexity.TransitDeparture.Realtime(childComplexity), true
+		return e.complexity.LocationHistorySegment.Description(childComplexity), true
 
-	case "TransitDeparture.relativeTimes":
-		if e.complexity.TransitDeparture.RelativeTimes == nil {
+	case "LocationHistorySegment.distance":
+		if e.complexity.LocationHistorySegment.Distance == nil {
 			break
 		}
 
-		return e.complexity.TransitDeparture.RelativeTimes(childComplexity), true
+		return e.complexity.LocationHistorySegment.Distance(childComplexity), true
 
-	case "TransitDeparture.station":
-		if e.complexity.TransitDeparture.Station == nil {
+	case "LocationHistorySegment.place":
+		if e.complexity.LocationHistorySegment.Place == nil {
 			break
 		}
 
-		return e.complexity.TransitDeparture.Station(childComplexity), true
+		return e.complexity.LocationHistorySegment.Place(childComplexity), true
 
-	case "TransitDeparture.times":
-		if e.complexity.TransitDeparture.Times == nil {
+	case "LocationHistorySegment.timeSpan":
+		if e.complexity.LocationHistorySegment.TimeSpan == nil {
 			break
 		}
 
-		return e.complexity.TransitDeparture.Times(childComplexity), true
+		return e.complexity.LocationHistorySegment.TimeSpan(childComplexity), true
 
-	case "TransitDeparture.transport":
-		if e.complexity.TransitDeparture.Transport == nil {
+	case "LocationQuery.history":
+		if e.complexity.LocationQuery.History == nil {
 			break
 		}
 
-		return e.complexity.TransitDeparture.Transport(childComplexity), true
-
-	case "TransitOperator.code":
-		if e.complexity.TransitOperator.Code == nil {
-			break
+		args, err := ec.field_LocationQuery_history_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.TransitOperator.Code(childComplexity), true
+		return e.complexity.LocationQuery.History(childComplexity, args["code"].(string), args["date"].(*time.Time)), true
 
-	case "TransitOperator.name":
-		if e.complexity.TransitOperator.Name == nil {
+	case "LocationQuery.region":
+		if e.complexity.LocationQuery.Region == nil {
 			break
 		}
 
-		return e.complexity.TransitOperator.Name(childComplexity), true
+		return e.complexity.LocationQuery.Region(childComplexity), true
 
-	case "TransitQuery.findDepartures":
-		if e.complexity.TransitQuery.FindDepartures == nil {
+	case "MaskedAbout.approxAge":
+		if e.complexity.MaskedAbout.ApproxAge == nil {
 			break
 		}
 
-		args, err := ec.field_TransitQuery_findDepartures_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.TransitQuery.FindDepartures(childComplexity, args["route"].(string), args["coords"].(locgql.CoordinatesInput), args["radius"].(*int), args["singleSet"].(*bool)), true
+		return e.complexity.MaskedAbout.ApproxAge(childComplexity), true
 
-	case "TransitQuery.nearbyTransports":
-		if e.complexity.TransitQuery.NearbyTransports == nil {
+	case "MaskedAbout.email":
+		if e.complexity.MaskedAbout.Email == nil {
 			break
 		}
 
-		args, err := ec.field_TransitQuery_nearbyTransports_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.TransitQuery.NearbyTransports(childComplexity, args["coords"].(locgql.CoordinatesInput), args["radius"].(*int), args["limit"].(*int)), true
+		return e.complexity.MaskedAbout.Email(childComplexity), true
 
-	case "TransitStation.coordinates":
-		if e.complexity.TransitStation.Coordinates == nil {
+	case "MaskedAbout.iq":
+		if e.complexity.MaskedAbout.IQ == nil {
 			break
 		}
 
-		return e.complexity.TransitStation.Coordinates(childComplexity), true
+		return e.complexity.MaskedAbout.IQ(childComplexity), true
 
-	case "TransitStation.id":
-		if e.complexity.TransitStation.ID == nil {
+	case "MaskedAbout.name":
+		if e.complexity.MaskedAbout.Name == nil {
 			break
 		}
 
-		return e.complexity.TransitStation.ID(childComplexity), true
+		return e.complexity.MaskedAbout.Name(childComplexity), true
 
-	case "TransitStation.name":
-		if e.complexity.TransitStation.Name == nil {
+	case "MaskedAbout.skills":
+		if e.complexity.MaskedAbout.Skills == nil {
 			break
 		}
 
-		return e.complexity.TransitStation.Name(childComplexity), true
+		return e.complexity.MaskedAbout.Skills(childComplexity), true
 
-	case "Transport.category":
-		if e.complexity.Transport.Category == nil {
+	case "MaskedAbout.type":
+		if e.complexity.MaskedAbout.Type == nil {
 			break
 		}
 
-		return e.complexity.Transport.Category(childComplexity), true
+		return e.complexity.MaskedAbout.Type(childComplexity), true
 
-	case "Transport.direction":
-		if e.complexity.Transport.Direction == nil {
+	case "MaskedAbout.whereabouts":
+		if e.complexity.MaskedAbout.Whereabouts == nil {
 			break
 		}
 
-		return e.complexity.Transport.Direction(childComplexity), true
+		return e.complexity.MaskedAbout.Whereabouts(childComplexity), true
 
-	case "Transport.operator":
-		if e.complexity.Transport.Operator == nil {
+	case "MusicAlbum.artists":
+		if e.complexity.MusicAlbum.Artists == nil {
 			break
 		}
 
-		return e.complexity.Transport.Operator(childComplexity), true
+		return e.complexity.MusicAlbum.Artists(childComplexity), true
 
-	case "Transport.route":
-		if e.complexity.Transport.Route == nil {
+	case "MusicAlbum.externalURL":
+		if e.complexity.MusicAlbum.ExternalURL == nil {
 			break
 		}
 
-		return e.complexity.Transport.Route(childComplexity), true
+		return e.complexity.MusicAlbum.ExternalURL(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "MusicAlbum.id":
+		if e.complexity.MusicAlbum.ID == nil {
+			break
+		}
 
-func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+		return e.complexity.MusicAlbum.ID(childComplexity), true
 
-	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
-		data := ec._Query(ctx, op.SelectionSet)
-		var buf bytes.Buffer
-		data.MarshalGQL(&buf)
-		return buf.Bytes()
-	})
+	case "MusicAlbum.images":
+		if e.complexity.MusicAlbum.Images == nil {
+			break
+		}
 
-	return &graphql.Response{
-		Data:       buf,
-		Errors:     ec.Errors,
-		Extensions: ec.Extensions,
-	}
-}
+		return e.complexity.MusicAlbum.Images(childComplexity), true
 
-func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	case "MusicAlbum.name":
+		if e.complexity.MusicAlbum.Name == nil {
+			break
+		}
 
-	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
-		data := ec._Mutation(ctx, op.SelectionSet)
-		var buf bytes.Buffer
-		data.MarshalGQL(&buf)
-		return buf.Bytes()
-	})
+		return e.complexity.MusicAlbum.Name(childComplexity), true
 
-	return &graphql.Response{
-		Data:       buf,
-		Errors:     ec.Errors,
-		Extensions: ec.Extensions,
-	}
-}
+	case "MusicAlbum.tracks":
+		if e.complexity.MusicAlbum.Tracks == nil {
+			break
+		}
 
-func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+		args, err := ec.field_MusicAlbum_tracks_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	next := ec._Subscription(ctx, op.SelectionSet)
-	if ec.Errors != nil {
-		return graphql.OneShot(&graphql.Response{Data: []byte("null"), Errors: ec.Errors})
-	}
+		return e.complexity.MusicAlbum.Tracks(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
 
-	var buf bytes.Buffer
-	return func() *graphql.Response {
-		buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
-			buf.Reset()
-			data := next()
+	case "MusicAlbum.uri":
+		if e.complexity.MusicAlbum.URI == nil {
+			break
+		}
 
-			if data == nil {
-				return nil
-			}
-			data.MarshalGQL(&buf)
-			return buf.Bytes()
-		})
+		return e.complexity.MusicAlbum.URI(childComplexity), true
 
-		if buf == nil {
-			return nil
+	case "MusicArtist.albums":
+		if e.complexity.MusicArtist.Albums == nil {
+			break
 		}
 
-		return &graphql.Response{
-			Data:       buf,
-			Errors:     ec.Errors,
-			Extensions: ec.Extensions,
+		args, err := ec.field_MusicArtist_albums_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}
-}
 
-type executionContext struct {
-	*graphql.RequestContext
-	*executableSchema
-}
+		return e.complexity.MusicArtist.Albums(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(parsedSchema), nil
-}
+	case "MusicArtist.externalURL":
+		if e.complexity.MusicArtist.ExternalURL == nil {
+			break
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
-}
+		return e.complexity.MusicArtist.ExternalURL(childComplexity), true
 
-var parsedSchema = gqlparser.MustLoadSchema(
-	&ast.Source{Name: "schema/about.graphql", Input: `"""
-A ` + "`" + `PartialAbout` + "`" + ` provides partial basic personal information.
-"""
-interface PartialAbout {
-  name: String!
-  email: String!
-  type: String!
-  iq: Boolean!
-  skills: [String!]!
-}
+	case "MusicArtist.id":
+		if e.complexity.MusicArtist.ID == nil {
+			break
+		}
 
-"""
-An ` + "`" + `FullAbout` + "`" + ` provides full basic personal information.
-"""
-type FullAbout implements PartialAbout {
-  name: String!
-  email: String!
-  type: String!
-  birthday: String!
-  age: String!
-  iq: Boolean!
-  skills: [String!]!
-  location: Coordinates!
-}
+		return e.complexity.MusicArtist.ID(childComplexity), true
 
-"""
-A ` + "`" + `MaskedAbout` + "`" + ` provides obfuscated basic personal information.
-"""
-type MaskedAbout implements PartialAbout {
-  name: String!
-  email: String!
-  type: String!
-  approxAge: String!
-  iq: Boolean!
-  skills: [String!]!
-  whereabouts: String!
-}
-`},
-	&ast.Source{Name: "schema/assist.graphql", Input: `type AssistQuery {
-  transit: TransitQuery!
-}
-`},
-	&ast.Source{Name: "schema/auth.graphql", Input: `type AuthQuery {
-  """
-  Get the permissions associated with a particular access code.
-  """
-  permissions(code: String!): [String!]!
-}
-`},
-	&ast.Source{Name: "schema/git.graphql", Input: `type GitQuery {
-  recentCommits(limit: Int): [GitCommit!]!
-}
+	case "MusicArtist.name":
+		if e.complexity.MusicArtist.Name == nil {
+			break
+		}
 
-"""
-A ` + "`" + `GitCommit` + "`" + ` represents a Git commit.
-"""
-type GitCommit {
-  sha: String!
-  author: GitCommitAuthor!
-  committer: GitCommitAuthor
-  message: String!
-  url: String!
-  repo: GitRepo!
-  timestamp: Time!
-}
+		return e.complexity.MusicArtist.Name(childComplexity), true
 
-"""
-A ` + "`" + `GitCommitAuthor` + "`" + ` authors or commits a ` + "`" + `GitCommit` + "`" + `.
-"""
-type GitCommitAuthor {
-  date: Time
-  name: String
-  email: String
-  login: String
-}
+	case "MusicArtist.uri":
+		if e.complexity.MusicArtist.URI == nil {
+			break
+		}
 
-"""
-A ` + "`" + `GitRepo` + "`" + ` contains ` + "`" + `GitCommit` + "`" + `s.
-"""
-type GitRepo {
-  name: String!
-  url: String!
-}
-`},
-	&ast.Source{Name: "schema/location.graphql", Input: `type LocationQuery {
-  region: Place!
+		return e.complexity.MusicArtist.URI(childComplexity), true
 
-  """
-  Get my recent location history, or the location history for a specified date.
-  """
-  history(code: String!, date: Time): [LocationHistorySegment!]!
-}
+	case "MusicImage.height":
+		if e.complexity.MusicImage.Height == nil {
+			break
+		}
 
-"""
-A ` + "`" + `LocationHistorySegment` + "`" + ` is a segment of my location history.
-"""
-type LocationHistorySegment {
-  place: String!
-  address: String
-  description: String!
-  category: String!
-  distance: Int
-  timeSpan: TimeSpan!
-  coordinates: [Coordinates!]!
-}
+		return e.complexity.MusicImage.Height(childComplexity), true
 
-"""
-A ` + "`" + `Place` + "`" + ` represents a geographical location.
-"""
-type Place {
-  id: ID!
-  level: String!
-  type: String!
-  position: Coordinates!
-  timeZone: TimeZone
-  address: Address!
-  shape: [Coordinates!]
-}
+	case "MusicImage.url":
+		if e.complexity.MusicImage.URL == nil {
+			break
+		}
 
-"""
-` + "`" + `Coordinates` + "`" + ` are a point in 3D space.
-"""
-type Coordinates {
-  x: Float!
-  y: Float!
-  z: Float!
-}
+		return e.complexity.MusicImage.URL(childComplexity), true
 
-"""
-A ` + "`" + `CoordinatesInput` + "`" + ` is used to create a ` + "`" + `Coordinates` + "`" + `.
+	case "MusicImage.width":
+		if e.complexity.MusicImage.Width == nil {
+			break
+		}
 
-If its ` + "`" + `z` + "`" + ` component is not set, it will default to zero.
-"""
-input CoordinatesInput {
-  x: Float!
-  y: Float!
-  z: Float
-}
+		return e.complexity.MusicImage.Width(childComplexity), true
 
-"""
-An ` + "`" + `Address` + "`" + ` describes the position of a ` + "`" + `Place` + "`" + `.
-"""
-type Address {
-  label: String!
-  country: String!
-  state: String!
-  county: String!
-  city: String!
-  district: String
-  postcode: String!
-  street: String
-  number: String
-}
+	case "MusicLyricLine.text":
+		if e.complexity.MusicLyricLine.Text == nil {
+			break
+		}
 
-"""
-A ` + "`" + `TimeZone` + "`" + ` describes a time zone in terms of its name and offset.
-"""
-type TimeZone {
-  name: String!
+		return e.complexity.MusicLyricLine.Text(childComplexity), true
 
-  """
-  The offset in seconds east of UTC.
-  """
-  offset: Int!
-}
-`},
-	&ast.Source{Name: "schema/music.graphql", Input: `type MusicQuery {
-  current: CurrentlyPlayingMusic
-}
+	case "MusicLyricLine.time":
+		if e.complexity.MusicLyricLine.Time == nil {
+			break
+		}
 
-type MusicMutation {
-  """
-  Play a specified resource, or resume playback for the current track.
-  """
-  play(resource: MusicSelector): Boolean!
+		return e.complexity.MusicLyricLine.Time(childComplexity), true
 
-  """
-  Pause playback for the current track.
-  """
-  pause: Boolean!
-}
+	case "MusicLyrics.lines":
+		if e.complexity.MusicLyrics.Lines == nil {
+			break
+		}
 
-"""
-A ` + "`" + `MusicSelector` + "`" + ` is used to select a music resource.
-"""
-input MusicSelector {
-  uri: String
-  track: MusicResource
-  album: MusicResource
-  artist: MusicResource
-  playlist: MusicResource
-}
+		return e.complexity.MusicLyrics.Lines(childComplexity), true
 
-"""
-A ` + "`" + `MusicResource` + "`" + ` specifies a music resource by its ID.
-"""
-input MusicResource {
-  id: ID!
-}
+	case "MusicLyrics.synced":
+		if e.complexity.MusicLyrics.Synced == nil {
+			break
+		}
 
-"""
-` + "`" + `CurrentlyPlayingMusic` + "`" + ` contains information about the music that I'm listening
-to right now.
-"""
-type CurrentlyPlayingMusic {
-  timestamp: Time!
-  track: MusicTrack!
-  playing: Boolean!
+		return e.complexity.MusicLyrics.Synced(childComplexity), true
 
-  """
-  The progress of the track, in milliseconds.
-  """
-  progress: Int!
-}
+	case "MusicLyrics.text":
+		if e.complexity.MusicLyrics.Text == nil {
+			break
+		}
 
-"""
-A ` + "`" + `MusicTrack` + "`" + ` contains information about a music track.
-"""
-type MusicTrack {
-  id: ID!
-  uri: String!
-  name: String!
-  externalURL: String!
-  artists: [MusicArtist!]!
-  album: MusicAlbum!
+		return e.complexity.MusicLyrics.Text(childComplexity), true
 
-  """
-  The duration of the track, in milliseconds.
-  """
-  duration: Int!
-}
+	case "MusicMutation.approveRequest":
+		if e.complexity.MusicMutation.ApproveRequest == nil {
+			break
+		}
 
-"""
-A ` + "`" + `MusicArtist` + "`" + ` is the composer of a ` + "`" + `MusicTrack` + "`" + `.
-"""
-type MusicArtist {
-  id: ID!
-  uri: String!
-  name: String!
-  externalURL: String!
-  albums(limit: Int, offset: Int): [MusicAlbum!]!
-}
+		args, err := ec.field_MusicMutation_approveRequest_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-"""
-A ` + "`" + `MusicAlbum` + "`" + ` is an organization of ` + "`" + `MusicTrack` + "`" + `s.
-"""
-type MusicAlbum {
-  id: ID!
-  uri: String!
-  name: String!
-  externalURL: String!
-  images: [MusicImage!]!
-  artists: [MusicArtist!]!
-  tracks(limit: Int, offset: Int): [MusicTrack!]
-}
+		return e.complexity.MusicMutation.ApproveRequest(childComplexity, args["id"].(string)), true
 
-"""
-A ` + "`" + `MusicImage` + "`" + ` describes the cover images for ` + "`" + `MusicArtist` + "`" + `s and ` + "`" + `MusicAlbum` + "`" + `s.
-"""
-type MusicImage {
-  height: Int!
-  width: Int!
-  url: String!
-}
-`},
-	&ast.Source{Name: "schema/productivity.graphql", Input: `"""
-` + "`" + `Productivity` + "`" + ` is a measure of productivity for a given day.
-"""
-type Productivity {
-  records: [ProductivityRecord!]!
+	case "MusicMutation.pause":
+		if e.complexity.MusicMutation.Pause == nil {
+			break
+		}
 
-  """
-  Score is a number between 0 and 100, computed as follows:
-  https://help.rescuetime.com/article/73-how-is-my-productivity-pulse-calculated
-  """
-  score: Int
-}
+		return e.complexity.MusicMutation.Pause(childComplexity), true
 
-"""
-A ` + "`" + `ProductivityRecord` + "`" + ` is a record of the time spent doing activities of a
-particular ` + "`" + `ProductivityCategory` + "`" + `.
-"""
-type ProductivityRecord {
-  category: ProductivityCategory!
+	case "MusicMutation.play":
+		if e.complexity.MusicMutation.Play == nil {
+			break
+		}
 
-  """
-  The duration of the record, in seconds.
-  """
-  duration: Int!
-}
+		args, err := ec.field_MusicMutation_play_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-"""
-A ` + "`" + `ProductivityCategory` + "`" + ` represents a degree of productivity.
-"""
-type ProductivityCategory {
-  id: ID!
-  name: String!
-  weight: Int!
-}
-`},
-	&ast.Source{Name: "schema/root.graphql", Input: `type Query {
-  """
-  Get my basic personal information.
-  """
-  about(code: String): PartialAbout!
+		return e.complexity.MusicMutation.Play(childComplexity, args["resource"].(*music.Selector)), true
 
-  """
-  Get my current productivity information.
-  """
-  productivity: Productivity!
+	case "MusicMutation.rejectRequest":
+		if e.complexity.MusicMutation.RejectRequest == nil {
+			break
+		}
 
-  """
-  Utility queries, used by personal assistants.
-  """
-  assist: AssistQuery!
+		args, err := ec.field_MusicMutation_rejectRequest_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-  git: GitQuery!
-  auth: AuthQuery!
-  music: MusicQuery!
-  location: LocationQuery!
-  scheduling: SchedulingQuery!
-}
+		return e.complexity.MusicMutation.RejectRequest(childComplexity, args["id"].(string)), true
 
-type Mutation {
-  music(code: String!): MusicMutation!
-}
+	case "MusicQuery.current":
+		if e.complexity.MusicQuery.Current == nil {
+			break
+		}
 
-type Subscription {
-  music: CurrentlyPlayingMusic
-}
-`},
-	&ast.Source{Name: "schema/scalars.graphql", Input: `"""
-A ` + "`" + `Time` + "`" + ` represents an instant in time.
-"""
-scalar Time
-`},
-	&ast.Source{Name: "schema/scheduling.graphql", Input: `"""
-A ` + "`" + `TimeSpan` + "`" + ` represents a span of time.
-"""
-type TimeSpan {
-  start: Time!
-  end: Time!
-}
+		return e.complexity.MusicQuery.Current(childComplexity), true
 
-type SchedulingQuery {
-  busyTimes(code: String, date: Time): [TimeSpan!]!
-}
-`},
-	&ast.Source{Name: "schema/transit.graphql", Input: `type TransitQuery {
-  """
-  Find nearby transit departures.
+	case "MusicQuery.requests":
+		if e.complexity.MusicQuery.Requests == nil {
+			break
+		}
 
-  Optionally specify a radius (in meters), and whether or not you want to
-  restrict results to a single set that is unique by Transport direction.
-  """
-  findDepartures(
-    route: String!
-    coords: CoordinatesInput!
-    radius: Int
-    singleSet: Boolean
-  ): [NearbyTransitDeparture!]!
+		args, err := ec.field_MusicQuery_requests_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-  nearbyTransports(coords: CoordinatesInput!, radius: Int, limit: Int): [Transport!]!
-}
+		return e.complexity.MusicQuery.Requests(childComplexity, args["code"].(*string)), true
 
-"""
-A ` + "`" + `NearbyTransitDeparture` + "`" + ` is a ` + "`" + `TransitDeparture` + "`" + ` that is happening nearby.
-"""
-type NearbyTransitDeparture {
-  departure: TransitDeparture!
+	case "MusicRequest.createdAt":
+		if e.complexity.MusicRequest.CreatedAt == nil {
+			break
+		}
 
-  """
-  The distance from the departure, in meters.
-  """
-  distance: Int!
-}
+		return e.complexity.MusicRequest.CreatedAt(childComplexity), true
 
-"""
-A ` + "`" + `TransitDeparture` + "`" + ` contains information about the departure of a ` + "`" + `Transport` + "`" + `
-from a particular ` + "`" + `Station` + "`" + `.
-"""
-type TransitDeparture {
-  times: [Time!]!
-  transport: Transport!
-  station: TransitStation!
+	case "MusicRequest.id":
+		if e.complexity.MusicRequest.ID == nil {
+			break
+		}
 
-  """
-  The relative departure times, in described text.
-  """
-  relativeTimes: [String!]!
-  realtime: Boolean!
-}
+		return e.complexity.MusicRequest.ID(childComplexity), true
 
-"""
-A ` + "`" + `Transport` + "`" + ` is a vehicle travelling on a transit route.
-"""
-type Transport {
-  route: String!
-  direction: String!
-  category: String!
-  operator: TransitOperator!
-}
+	case "MusicRequest.requester":
+		if e.complexity.MusicRequest.Requester == nil {
+			break
+		}
 
-"""
-An ` + "`" + `Operator` + "`" + ` represents a transit system operator.
-"""
-type TransitOperator {
-  code: String!
-  name: String!
-}
+		return e.complexity.MusicRequest.Requester(childComplexity), true
 
-"""
-A ` + "`" + `Station` + "`" + ` is a place where one can board a ` + "`" + `Transport` + "`" + `.
-"""
-type TransitStation {
-  id: ID!
-  name: String!
-  coordinates: Coordinates!
-}
-`},
-)
+	case "MusicRequest.resolvedAt":
+		if e.complexity.MusicRequest.ResolvedAt == nil {
+			break
+		}
 
-// endregion ************************** generated!.gotpl **************************
+		return e.complexity.MusicRequest.ResolvedAt(childComplexity), true
 
-// region    ***************************** args.gotpl *****************************
+	case "MusicRequest.resource":
+		if e.complexity.MusicRequest.Resource == nil {
+			break
+		}
 
-func (ec *executionContext) field_AuthQuery_permissions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["code"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicRequest.Resource(childComplexity), true
+
+	case "MusicRequest.status":
+		if e.complexity.MusicRequest.Status == nil {
+			break
 		}
-	}
-	args["code"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_GitQuery_recentCommits_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *int
-	if tmp, ok := rawArgs["limit"]; ok {
-		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicRequest.Status(childComplexity), true
+
+	case "MusicRequest.track":
+		if e.complexity.MusicRequest.Track == nil {
+			break
 		}
-	}
-	args["limit"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_LocationQuery_history_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["code"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicRequest.Track(childComplexity), true
+
+	case "MusicRequestedResource.id":
+		if e.complexity.MusicRequestedResource.ID == nil {
+			break
 		}
-	}
-	args["code"] = arg0
-	var arg1 *time.Time
-	if tmp, ok := rawArgs["date"]; ok {
-		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.MusicRequestedResource.ID(childComplexity), true
+
+	case "MusicRequestedResource.kind":
+		if e.complexity.MusicRequestedResource.Kind == nil {
+			break
 		}
-	}
-	args["date"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_MusicAlbum_tracks_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *int
-	if tmp, ok := rawArgs["limit"]; ok {
-		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicRequestedResource.Kind(childComplexity), true
+
+	case "MusicRequestedResource.uri":
+		if e.complexity.MusicRequestedResource.URI == nil {
+			break
 		}
-	}
-	args["limit"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["offset"]; ok {
-		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.MusicRequestedResource.URI(childComplexity), true
+
+	case "MusicTrack.album":
+		if e.complexity.MusicTrack.Album == nil {
+			break
 		}
-	}
-	args["offset"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_MusicArtist_albums_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *int
-	if tmp, ok := rawArgs["limit"]; ok {
-		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicTrack.Album(childComplexity), true
+
+	case "MusicTrack.artists":
+		if e.complexity.MusicTrack.Artists == nil {
+			break
 		}
-	}
-	args["limit"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["offset"]; ok {
-		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.MusicTrack.Artists(childComplexity), true
+
+	case "MusicTrack.duration":
+		if e.complexity.MusicTrack.Duration == nil {
+			break
 		}
-	}
-	args["offset"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_MusicMutation_play_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *music.Selector
-	if tmp, ok := rawArgs["resource"]; ok {
-		arg0, err = ec.unmarshalOMusicSelector2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐSelector(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicTrack.Duration(childComplexity), true
+
+	case "MusicTrack.externalURL":
+		if e.complexity.MusicTrack.ExternalURL == nil {
+			break
 		}
-	}
-	args["resource"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_music_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["code"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicTrack.ExternalURL(childComplexity), true
+
+	case "MusicTrack.id":
+		if e.complexity.MusicTrack.ID == nil {
+			break
 		}
-	}
-	args["code"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicTrack.ID(childComplexity), true
+
+	case "MusicTrack.lyrics":
+		if e.complexity.MusicTrack.Lyrics == nil {
+			break
 		}
-	}
-	args["name"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_about_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["code"]; ok {
-		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.MusicTrack.Lyrics(childComplexity), true
+
+	case "MusicTrack.name":
+		if e.complexity.MusicTrack.Name == nil {
+			break
 		}
-	}
-	args["code"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_SchedulingQuery_busyTimes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["code"]; ok {
-		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		return e.complexity.MusicTrack.Name(childComplexity), true
+
+	case "MusicTrack.uri":
+		if e.complexity.MusicTrack.URI == nil {
+			break
+		}
+
+		return e.complexity.MusicTrack.URI(childComplexity), true
+
+	case "Mutation.bookMeeting":
+		if e.complexity.Mutation.BookMeeting == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_bookMeeting_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["code"] = arg0
-	var arg1 *time.Time
-	if tmp, ok := rawArgs["date"]; ok {
-		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.Mutation.BookMeeting(childComplexity, args["code"].(string), args["booking"].(schedgql.BookingInput)), true
+
+	case "Mutation.music":
+		if e.complexity.Mutation.Music == nil {
+			break
 		}
-	}
-	args["date"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_TransitQuery_findDepartures_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["route"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		args, err := ec.field_Mutation_music_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["route"] = arg0
-	var arg1 locgql.CoordinatesInput
-	if tmp, ok := rawArgs["coords"]; ok {
-		arg1, err = ec.unmarshalNCoordinatesInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐCoordinatesInput(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.Mutation.Music(childComplexity, args["code"].(string)), true
+
+	case "Mutation.productivity":
+		if e.complexity.Mutation.Productivity == nil {
+			break
 		}
-	}
-	args["coords"] = arg1
-	var arg2 *int
-	if tmp, ok := rawArgs["radius"]; ok {
-		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+
+		args, err := ec.field_Mutation_productivity_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["radius"] = arg2
-	var arg3 *bool
-	if tmp, ok := rawArgs["singleSet"]; ok {
-		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.Mutation.Productivity(childComplexity, args["code"].(string)), true
+
+	case "Mutation.requestMusic":
+		if e.complexity.Mutation.RequestMusic == nil {
+			break
 		}
-	}
-	args["singleSet"] = arg3
-	return args, nil
-}
 
-func (ec *executionContext) field_TransitQuery_nearbyTransports_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 locgql.CoordinatesInput
-	if tmp, ok := rawArgs["coords"]; ok {
-		arg0, err = ec.unmarshalNCoordinatesInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐCoordinatesInput(ctx, tmp)
+		args, err := ec.field_Mutation_requestMusic_args(context.TODO(), rawArgs)
 		if err != nil {
-			return nil, err
+			return 0, false
 		}
-	}
-	args["coords"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["radius"]; ok {
-		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.Mutation.RequestMusic(childComplexity, args["code"].(string), args["resource"].(music.Selector), args["requester"].(*string)), true
+
+	case "NearbyTransitDeparture.departure":
+		if e.complexity.NearbyTransitDeparture.Departure == nil {
+			break
 		}
-	}
-	args["radius"] = arg1
-	var arg2 *int
-	if tmp, ok := rawArgs["limit"]; ok {
-		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.NearbyTransitDeparture.Departure(childComplexity), true
+
+	case "NearbyTransitDeparture.distance":
+		if e.complexity.NearbyTransitDeparture.Distance == nil {
+			break
 		}
-	}
-	args["limit"] = arg2
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.NearbyTransitDeparture.Distance(childComplexity), true
+
+	case "Place.address":
+		if e.complexity.Place.Address == nil {
+			break
 		}
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.Place.Address(childComplexity), true
+
+	case "Place.id":
+		if e.complexity.Place.ID == nil {
+			break
 		}
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.Place.ID(childComplexity), true
 
-// region    ************************** directives.gotpl **************************
+	case "Place.level":
+		if e.complexity.Place.Level == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.Place.Level(childComplexity), true
 
-// region    **************************** field.gotpl *****************************
+	case "Place.position":
+		if e.complexity.Place.Position == nil {
+			break
+		}
 
-func (ec *executionContext) _Address_label(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Place.Position(childComplexity), true
+
+	case "Place.shape":
+		if e.complexity.Place.Shape == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Label, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Place.Shape(childComplexity), true
+
+	case "Place.timeZone":
+		if e.complexity.Place.TimeZone == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Address_country(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Place.TimeZone(childComplexity), true
+
+	case "Place.type":
+		if e.complexity.Place.Type == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Country, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Place.Type(childComplexity), true
+
+	case "Productivity.activities":
+		if e.complexity.Productivity.Activities == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Address_state(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Productivity_activities_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.State, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Productivity.Activities(childComplexity, args["code"].(string), args["limit"].(*int)), true
+
+	case "Productivity.focusSessions":
+		if e.complexity.Productivity.FocusSessions == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Address_county(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Productivity_focusSessions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.County, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Productivity.FocusSessions(childComplexity, args["code"].(*string)), true
+
+	case "Productivity.goals":
+		if e.complexity.Productivity.Goals == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Address_city(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Productivity_goals_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.City, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Productivity.Goals(childComplexity, args["code"].(string)), true
+
+	case "Productivity.records":
+		if e.complexity.Productivity.Records == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Address_district(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Productivity.Records(childComplexity), true
+
+	case "Productivity.score":
+		if e.complexity.Productivity.Score == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Address().District(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Address_postcode(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Productivity.Score(childComplexity), true
+
+	case "Productivity.scoreModel":
+		if e.complexity.Productivity.ScoreModel == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Postcode, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Productivity.ScoreModel(childComplexity), true
+
+	case "Productivity.topDistractions":
+		if e.complexity.Productivity.TopDistractions == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Address_street(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Productivity_topDistractions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Address().Street(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Address_number(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Productivity.TopDistractions(childComplexity, args["code"].(string), args["limit"].(*int)), true
+
+	case "ProductivityActivity.category":
+		if e.complexity.ProductivityActivity.Category == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Address",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Address().Number(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _AssistQuery_transit(ctx context.Context, field graphql.CollectedField, obj *assistgql.Query) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityActivity.Category(childComplexity), true
+
+	case "ProductivityActivity.duration":
+		if e.complexity.ProductivityActivity.Duration == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "AssistQuery",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Transit, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityActivity.Duration(childComplexity), true
+
+	case "ProductivityActivity.group":
+		if e.complexity.ProductivityActivity.Group == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(transgql.Query)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTransitQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚋtransgqlᚐQuery(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _AuthQuery_permissions(ctx context.Context, field graphql.CollectedField, obj *authgql.Query) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityActivity.Group(childComplexity), true
+
+	case "ProductivityActivity.name":
+		if e.complexity.ProductivityActivity.Name == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "AuthQuery",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_AuthQuery_permissions_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Permissions(ctx, args["code"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityActivity.Name(childComplexity), true
+
+	case "ProductivityCategory.id":
+		if e.complexity.ProductivityCategory.ID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Coordinates_x(ctx context.Context, field graphql.CollectedField, obj *location.Coordinates) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityCategory.ID(childComplexity), true
+
+	case "ProductivityCategory.name":
+		if e.complexity.ProductivityCategory.Name == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Coordinates",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.X, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityCategory.Name(childComplexity), true
+
+	case "ProductivityCategory.weight":
+		if e.complexity.ProductivityCategory.Weight == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(float64)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Coordinates_y(ctx context.Context, field graphql.CollectedField, obj *location.Coordinates) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityCategory.Weight(childComplexity), true
+
+	case "ProductivityFocusSession.end":
+		if e.complexity.ProductivityFocusSession.End == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Coordinates",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Y, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityFocusSession.End(childComplexity), true
+
+	case "ProductivityFocusSession.id":
+		if e.complexity.ProductivityFocusSession.ID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(float64)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Coordinates_z(ctx context.Context, field graphql.CollectedField, obj *location.Coordinates) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityFocusSession.ID(childComplexity), true
+
+	case "ProductivityFocusSession.label":
+		if e.complexity.ProductivityFocusSession.Label == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Coordinates",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Z, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityFocusSession.Label(childComplexity), true
+
+	case "ProductivityFocusSession.records":
+		if e.complexity.ProductivityFocusSession.Records == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(float64)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _CurrentlyPlayingMusic_timestamp(ctx context.Context, field graphql.CollectedField, obj *music.CurrentlyPlaying) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityFocusSession.Records(childComplexity), true
+
+	case "ProductivityFocusSession.score":
+		if e.complexity.ProductivityFocusSession.Score == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "CurrentlyPlayingMusic",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityFocusSession.Score(childComplexity), true
+
+	case "ProductivityFocusSession.start":
+		if e.complexity.ProductivityFocusSession.Start == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _CurrentlyPlayingMusic_track(ctx context.Context, field graphql.CollectedField, obj *music.CurrentlyPlaying) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityFocusSession.Start(childComplexity), true
+
+	case "ProductivityGoal.category":
+		if e.complexity.ProductivityGoal.Category == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "CurrentlyPlayingMusic",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Track, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(music.Track)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNMusicTrack2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _CurrentlyPlayingMusic_playing(ctx context.Context, field graphql.CollectedField, obj *music.CurrentlyPlaying) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityGoal.Category(childComplexity), true
+
+	case "ProductivityGoal.createdAt":
+		if e.complexity.ProductivityGoal.CreatedAt == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "CurrentlyPlayingMusic",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Playing, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityGoal.CreatedAt(childComplexity), true
+
+	case "ProductivityGoal.duration":
+		if e.complexity.ProductivityGoal.Duration == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _CurrentlyPlayingMusic_progress(ctx context.Context, field graphql.CollectedField, obj *music.CurrentlyPlaying) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityGoal.Duration(childComplexity), true
+
+	case "ProductivityGoal.id":
+		if e.complexity.ProductivityGoal.ID == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "CurrentlyPlayingMusic",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.CurrentlyPlayingMusic().Progress(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityGoal.ID(childComplexity), true
+
+	case "ProductivityGoal.kind":
+		if e.complexity.ProductivityGoal.Kind == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(int)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _FullAbout_name(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityGoal.Kind(childComplexity), true
+
+	case "ProductivityGoal.weekdays":
+		if e.complexity.ProductivityGoal.Weekdays == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "FullAbout",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityGoal.Weekdays(childComplexity), true
+
+	case "ProductivityGoalProgress.active":
+		if e.complexity.ProductivityGoalProgress.Active == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _FullAbout_email(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityGoalProgress.Active(childComplexity), true
+
+	case "ProductivityGoalProgress.breached":
+		if e.complexity.ProductivityGoalProgress.Breached == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "FullAbout",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityGoalProgress.Breached(childComplexity), true
+
+	case "ProductivityGoalProgress.date":
+		if e.complexity.ProductivityGoalProgress.Date == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _FullAbout_type(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityGoalProgress.Date(childComplexity), true
+
+	case "ProductivityGoalProgress.duration":
+		if e.complexity.ProductivityGoalProgress.Duration == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "FullAbout",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityGoalProgress.Duration(childComplexity), true
+
+	case "ProductivityGoalProgress.goal":
+		if e.complexity.ProductivityGoalProgress.Goal == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _FullAbout_birthday(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityGoalProgress.Goal(childComplexity), true
+
+	case "ProductivityGoalProgress.met":
+		if e.complexity.ProductivityGoalProgress.Met == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "FullAbout",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.FullAbout().Birthday(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityGoalProgress.Met(childComplexity), true
+
+	case "ProductivityGoalProgress.percent":
+		if e.complexity.ProductivityGoalProgress.Percent == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _FullAbout_age(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityGoalProgress.Percent(childComplexity), true
+
+	case "ProductivityMutation.createGoal":
+		if e.complexity.ProductivityMutation.CreateGoal == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "FullAbout",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.FullAbout().Age(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		args, err := ec.field_ProductivityMutation_createGoal_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _FullAbout_iq(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityMutation.CreateGoal(childComplexity, args["goal"].(prodgql.GoalInput)), true
+
+	case "ProductivityMutation.deleteGoal":
+		if e.complexity.ProductivityMutation.DeleteGoal == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "FullAbout",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IQ, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		args, err := ec.field_ProductivityMutation_deleteGoal_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _FullAbout_skills(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityMutation.DeleteGoal(childComplexity, args["id"].(string)), true
+
+	case "ProductivityMutation.startFocusSession":
+		if e.complexity.ProductivityMutation.StartFocusSession == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "FullAbout",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Skills, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		args, err := ec.field_ProductivityMutation_startFocusSession_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _FullAbout_location(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityMutation.StartFocusSession(childComplexity, args["label"].(*string)), true
+
+	case "ProductivityMutation.stopFocusSession":
+		if e.complexity.ProductivityMutation.StopFocusSession == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "FullAbout",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityMutation.StopFocusSession(childComplexity), true
+
+	case "ProductivityMutation.updateGoal":
+		if e.complexity.ProductivityMutation.UpdateGoal == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(location.Coordinates)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommit_sha(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_ProductivityMutation_updateGoal_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommit",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SHA, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityMutation.UpdateGoal(childComplexity, args["id"].(string), args["goal"].(prodgql.GoalInput)), true
+
+	case "ProductivityRecord.category":
+		if e.complexity.ProductivityRecord.Category == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommit_author(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityRecord.Category(childComplexity), true
+
+	case "ProductivityRecord.duration":
+		if e.complexity.ProductivityRecord.Duration == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommit",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Author, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityRecord.Duration(childComplexity), true
+
+	case "ProductivityTrend.from":
+		if e.complexity.ProductivityTrend.From == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(git.CommitAuthor)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNGitCommitAuthor2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommit_committer(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityTrend.From(childComplexity), true
+
+	case "ProductivityTrend.points":
+		if e.complexity.ProductivityTrend.Points == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommit",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Committer, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*git.CommitAuthor)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOGitCommitAuthor2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommit_message(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityTrend.Points(childComplexity), true
+
+	case "ProductivityTrend.resolution":
+		if e.complexity.ProductivityTrend.Resolution == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommit",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityTrend.Resolution(childComplexity), true
+
+	case "ProductivityTrend.to":
+		if e.complexity.ProductivityTrend.To == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommit_url(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityTrend.To(childComplexity), true
+
+	case "ProductivityTrendPoint.end":
+		if e.complexity.ProductivityTrendPoint.End == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommit",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityTrendPoint.End(childComplexity), true
+
+	case "ProductivityTrendPoint.movingAverage":
+		if e.complexity.ProductivityTrendPoint.MovingAverage == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommit_repo(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityTrendPoint.MovingAverage(childComplexity), true
+
+	case "ProductivityTrendPoint.records":
+		if e.complexity.ProductivityTrendPoint.Records == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommit",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Repo, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityTrendPoint.Records(childComplexity), true
+
+	case "ProductivityTrendPoint.score":
+		if e.complexity.ProductivityTrendPoint.Score == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(git.Repo)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommit_timestamp(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityTrendPoint.Score(childComplexity), true
+
+	case "ProductivityTrendPoint.start":
+		if e.complexity.ProductivityTrendPoint.Start == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommit",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ProductivityTrendPoint.Start(childComplexity), true
+
+	case "ProductivityTrendPoint.weekOverWeek":
+		if e.complexity.ProductivityTrendPoint.WeekOverWeek == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommitAuthor_date(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ProductivityTrendPoint.WeekOverWeek(childComplexity), true
+
+	case "Query.about":
+		if e.complexity.Query.About == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommitAuthor",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommitAuthor_name(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Query_about_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommitAuthor",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _GitCommitAuthor_email(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Query.About(childComplexity, args["code"].(*string)), true
+
+	case "Query.assist":
+		if e.complexity.Query.Assist == nil {
+			break
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "GitCommitAuthor",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
+
+		return e.complexity.Query.Assist(childComplexity), true
+
+	case "Query.auth":
+		if e.complexity.Query.Auth == nil {
+			break
+		}
+
+		return e.complexity.Query.Auth(childComplexity), true
+
+	case "Query.git":
+		if e.complexity.Query.Git == nil {
+			break
+		}
+
+		return e.complexity.Query.Git(childComplexity), true
+
+	case "Query.location":
+		if e.complexity.Query.Location == nil {
+			break
+		}
+
+		return e.complexity.Query.Location(childComplexity), true
+
+	case "Query.music":
+		if e.complexity.Query.Music == nil {
+			break
+		}
+
+		return e.complexity.Query.Music(childComplexity), true
+
+	case "Query.productivity":
+		if e.complexity.Query.Productivity == nil {
+			break
+		}
+
+		return e.complexity.Query.Productivity(childComplexity), true
+
+	case "Query.productivityTrend":
+		if e.complexity.Query.ProductivityTrend == nil {
+			break
+		}
+
+		args, err := ec.field_Query_productivityTrend_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProductivityTrend(childComplexity, args["from"].(time.Time), args["to"].(time.Time), args["resolution"].(*string), args["window"].(*int)), true
+
+	case "Query.scheduling":
+		if e.complexity.Query.Scheduling == nil {
+			break
+		}
+
+		return e.complexity.Query.Scheduling(childComplexity), true
+
+	case "Query.status":
+		if e.complexity.Query.Status == nil {
+			break
+		}
+
+		args, err := ec.field_Query_status_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Status(childComplexity, args["code"].(*string)), true
+
+	case "SchedulingBooking.createdAt":
+		if e.complexity.SchedulingBooking.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.CreatedAt(childComplexity), true
+
+	case "SchedulingBooking.email":
+		if e.complexity.SchedulingBooking.Email == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.Email(childComplexity), true
+
+	case "SchedulingBooking.end":
+		if e.complexity.SchedulingBooking.End == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.End(childComplexity), true
+
+	case "SchedulingBooking.id":
+		if e.complexity.SchedulingBooking.ID == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.ID(childComplexity), true
+
+	case "SchedulingBooking.ics":
+		if e.complexity.SchedulingBooking.Ics == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.Ics(childComplexity), true
+
+	case "SchedulingBooking.name":
+		if e.complexity.SchedulingBooking.Name == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.Name(childComplexity), true
+
+	case "SchedulingBooking.notes":
+		if e.complexity.SchedulingBooking.Notes == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.Notes(childComplexity), true
+
+	case "SchedulingBooking.start":
+		if e.complexity.SchedulingBooking.Start == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.Start(childComplexity), true
+
+	case "SchedulingBooking.title":
+		if e.complexity.SchedulingBooking.Title == nil {
+			break
+		}
+
+		return e.complexity.SchedulingBooking.Title(childComplexity), true
+
+	case "SchedulingEvent.allDay":
+		if e.complexity.SchedulingEvent.AllDay == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.AllDay(childComplexity), true
+
+	case "SchedulingEvent.attendees":
+		if e.complexity.SchedulingEvent.Attendees == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.Attendees(childComplexity), true
+
+	case "SchedulingEvent.busy":
+		if e.complexity.SchedulingEvent.Busy == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.Busy(childComplexity), true
+
+	case "SchedulingEvent.conferenceURL":
+		if e.complexity.SchedulingEvent.ConferenceURL == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.ConferenceURL(childComplexity), true
+
+	case "SchedulingEvent.end":
+		if e.complexity.SchedulingEvent.End == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.End(childComplexity), true
+
+	case "SchedulingEvent.location":
+		if e.complexity.SchedulingEvent.Location == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.Location(childComplexity), true
+
+	case "SchedulingEvent.start":
+		if e.complexity.SchedulingEvent.Start == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.Start(childComplexity), true
+
+	case "SchedulingEvent.title":
+		if e.complexity.SchedulingEvent.Title == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.Title(childComplexity), true
+
+	case "SchedulingEvent.transparency":
+		if e.complexity.SchedulingEvent.Transparency == nil {
+			break
+		}
+
+		return e.complexity.SchedulingEvent.Transparency(childComplexity), true
+
+	case "SchedulingQuery.availability":
+		if e.complexity.SchedulingQuery.Availability == nil {
+			break
+		}
+
+		args, err := ec.field_SchedulingQuery_availability_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SchedulingQuery.Availability(childComplexity, args["from"].(time.Time), args["to"].(time.Time), args["duration"].(int), args["workingHours"].(*schedgql.WorkingHoursInput), args["buffer"].(*int), args["limit"].(*int)), true
+
+	case "SchedulingQuery.busyTimes":
+		if e.complexity.SchedulingQuery.BusyTimes == nil {
+			break
+		}
+
+		args, err := ec.field_SchedulingQuery_busyTimes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SchedulingQuery.BusyTimes(childComplexity, args["code"].(*string), args["date"].(*time.Time), args["from"].(*time.Time), args["to"].(*time.Time)), true
+
+	case "SchedulingQuery.events":
+		if e.complexity.SchedulingQuery.Events == nil {
+			break
+		}
+
+		args, err := ec.field_SchedulingQuery_events_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SchedulingQuery.Events(childComplexity, args["code"].(*string), args["date"].(*time.Time)), true
+
+	case "SchedulingQuery.travelBlocks":
+		if e.complexity.SchedulingQuery.TravelBlocks == nil {
+			break
+		}
+
+		args, err := ec.field_SchedulingQuery_travelBlocks_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SchedulingQuery.TravelBlocks(childComplexity, args["code"].(*string), args["date"].(*time.Time)), true
+
+	case "SchedulingSlot.end":
+		if e.complexity.SchedulingSlot.End == nil {
+			break
+		}
+
+		return e.complexity.SchedulingSlot.End(childComplexity), true
+
+	case "SchedulingSlot.score":
+		if e.complexity.SchedulingSlot.Score == nil {
+			break
+		}
+
+		return e.complexity.SchedulingSlot.Score(childComplexity), true
+
+	case "SchedulingSlot.start":
+		if e.complexity.SchedulingSlot.Start == nil {
+			break
+		}
+
+		return e.complexity.SchedulingSlot.Start(childComplexity), true
+
+	case "SchedulingTravelBlock.departure":
+		if e.complexity.SchedulingTravelBlock.Departure == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Departure(childComplexity), true
+
+	case "SchedulingTravelBlock.destination":
+		if e.complexity.SchedulingTravelBlock.Destination == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Destination(childComplexity), true
+
+	case "SchedulingTravelBlock.distance":
+		if e.complexity.SchedulingTravelBlock.Distance == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Distance(childComplexity), true
+
+	case "SchedulingTravelBlock.duration":
+		if e.complexity.SchedulingTravelBlock.Duration == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Duration(childComplexity), true
+
+	case "SchedulingTravelBlock.end":
+		if e.complexity.SchedulingTravelBlock.End == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.End(childComplexity), true
+
+	case "SchedulingTravelBlock.estimated":
+		if e.complexity.SchedulingTravelBlock.Estimated == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Estimated(childComplexity), true
+
+	case "SchedulingTravelBlock.feasible":
+		if e.complexity.SchedulingTravelBlock.Feasible == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Feasible(childComplexity), true
+
+	case "SchedulingTravelBlock.origin":
+		if e.complexity.SchedulingTravelBlock.Origin == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Origin(childComplexity), true
+
+	case "SchedulingTravelBlock.slack":
+		if e.complexity.SchedulingTravelBlock.Slack == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Slack(childComplexity), true
+
+	case "SchedulingTravelBlock.start":
+		if e.complexity.SchedulingTravelBlock.Start == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Start(childComplexity), true
+
+	case "SchedulingTravelBlock.warning":
+		if e.complexity.SchedulingTravelBlock.Warning == nil {
+			break
+		}
+
+		return e.complexity.SchedulingTravelBlock.Warning(childComplexity), true
+
+	case "Status.activity":
+		if e.complexity.Status.Activity == nil {
+			break
+		}
+
+		return e.complexity.Status.Activity(childComplexity), true
+
+	case "Status.confidence":
+		if e.complexity.Status.Confidence == nil {
+			break
+		}
+
+		return e.complexity.Status.Confidence(childComplexity), true
+
+	case "Status.detail":
+		if e.complexity.Status.Detail == nil {
+			break
+		}
+
+		return e.complexity.Status.Detail(childComplexity), true
+
+	case "Status.since":
+		if e.complexity.Status.Since == nil {
+			break
+		}
+
+		return e.complexity.Status.Since(childComplexity), true
+
+	case "Status.timestamp":
+		if e.complexity.Status.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.Status.Timestamp(childComplexity), true
+
+	case "Status.until":
+		if e.complexity.Status.Until == nil {
+			break
+		}
+
+		return e.complexity.Status.Until(childComplexity), true
+
+	case "Subscription.gitCommits":
+		if e.complexity.Subscription.GitCommits == nil {
+			break
+		}
+
+		return e.complexity.Subscription.GitCommits(childComplexity), true
+
+	case "Subscription.music":
+		if e.complexity.Subscription.Music == nil {
+			break
+		}
+
+		return e.complexity.Subscription.Music(childComplexity), true
+
+	case "Subscription.musicLyricLine":
+		if e.complexity.Subscription.MusicLyricLine == nil {
+			break
+		}
+
+		return e.complexity.Subscription.MusicLyricLine(childComplexity), true
+
+	case "Subscription.musicRequests":
+		if e.complexity.Subscription.MusicRequests == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_musicRequests_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.MusicRequests(childComplexity, args["code"].(*string)), true
+
+	case "Subscription.productivityGoalBreached":
+		if e.complexity.Subscription.ProductivityGoalBreached == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_productivityGoalBreached_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.ProductivityGoalBreached(childComplexity, args["code"].(string)), true
+
+	case "Subscription.status":
+		if e.complexity.Subscription.Status == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_status_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.Status(childComplexity, args["code"].(*string)), true
+
+	case "TimeSpan.end":
+		if e.complexity.TimeSpan.End == nil {
+			break
+		}
+
+		return e.complexity.TimeSpan.End(childComplexity), true
+
+	case "TimeSpan.start":
+		if e.complexity.TimeSpan.Start == nil {
+			break
+		}
+
+		return e.complexity.TimeSpan.Start(childComplexity), true
+
+	case "TimeZone.name":
+		if e.complexity.TimeZone.Name == nil {
+			break
+		}
+
+		return e.complexity.TimeZone.Name(childComplexity), true
+
+	case "TimeZone.offset":
+		if e.complexity.TimeZone.Offset == nil {
+			break
+		}
+
+		return e.complexity.TimeZone.Offset(childComplexity), true
+
+	case "TransitDeparture.realtime":
+		if e.complexity.TransitDeparture.Realtime == nil {
+			break
+		}
+
+		return e.complexity.TransitDeparture.Realtime(childComplexity), true
+
+	case "TransitDeparture.relativeTimes":
+		if e.complexity.TransitDeparture.RelativeTimes == nil {
+			break
+		}
+
+		return e.complexity.TransitDeparture.RelativeTimes(childComplexity), true
+
+	case "TransitDeparture.station":
+		if e.complexity.TransitDeparture.Station == nil {
+			break
+		}
+
+		return e.complexity.TransitDeparture.Station(childComplexity), true
+
+	case "TransitDeparture.times":
+		if e.complexity.TransitDeparture.Times == nil {
+			break
+		}
+
+		return e.complexity.TransitDeparture.Times(childComplexity), true
+
+	case "TransitDeparture.transport":
+		if e.complexity.TransitDeparture.Transport == nil {
+			break
+		}
+
+		return e.complexity.TransitDeparture.Transport(childComplexity), true
+
+	case "TransitOperator.code":
+		if e.complexity.TransitOperator.Code == nil {
+			break
+		}
+
+		return e.complexity.TransitOperator.Code(childComplexity), true
+
+	case "TransitOperator.name":
+		if e.complexity.TransitOperator.Name == nil {
+			break
+		}
+
+		return e.complexity.TransitOperator.Name(childComplexity), true
+
+	case "TransitQuery.findDepartures":
+		if e.complexity.TransitQuery.FindDepartures == nil {
+			break
+		}
+
+		args, err := ec.field_TransitQuery_findDepartures_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TransitQuery.FindDepartures(childComplexity, args["route"].(string), args["coords"].(locgql.CoordinatesInput), args["radius"].(*int), args["singleSet"].(*bool)), true
+
+	case "TransitQuery.nearbyTransports":
+		if e.complexity.TransitQuery.NearbyTransports == nil {
+			break
+		}
+
+		args, err := ec.field_TransitQuery_nearbyTransports_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TransitQuery.NearbyTransports(childComplexity, args["coords"].(locgql.CoordinatesInput), args["radius"].(*int), args["limit"].(*int)), true
+
+	case "TransitStation.coordinates":
+		if e.complexity.TransitStation.Coordinates == nil {
+			break
+		}
+
+		return e.complexity.TransitStation.Coordinates(childComplexity), true
+
+	case "TransitStation.id":
+		if e.complexity.TransitStation.ID == nil {
+			break
+		}
+
+		return e.complexity.TransitStation.ID(childComplexity), true
+
+	case "TransitStation.name":
+		if e.complexity.TransitStation.Name == nil {
+			break
+		}
+
+		return e.complexity.TransitStation.Name(childComplexity), true
+
+	case "Transport.category":
+		if e.complexity.Transport.Category == nil {
+			break
+		}
+
+		return e.complexity.Transport.Category(childComplexity), true
+
+	case "Transport.direction":
+		if e.complexity.Transport.Direction == nil {
+			break
+		}
+
+		return e.complexity.Transport.Direction(childComplexity), true
+
+	case "Transport.operator":
+		if e.complexity.Transport.Operator == nil {
+			break
+		}
+
+		return e.complexity.Transport.Operator(childComplexity), true
+
+	case "Transport.route":
+		if e.complexity.Transport.Route == nil {
+			break
+		}
+
+		return e.complexity.Transport.Route(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+		data := ec._Query(ctx, op.SelectionSet)
+		var buf bytes.Buffer
+		data.MarshalGQL(&buf)
+		return buf.Bytes()
+	})
+
+	return &graphql.Response{
+		Data:       buf,
+		Errors:     ec.Errors,
+		Extensions: ec.Extensions,
+	}
+}
+
+func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+		data := ec._Mutation(ctx, op.SelectionSet)
+		var buf bytes.Buffer
+		data.MarshalGQL(&buf)
+		return buf.Bytes()
+	})
+
+	return &graphql.Response{
+		Data:       buf,
+		Errors:     ec.Errors,
+		Extensions: ec.Extensions,
+	}
+}
+
+func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+
+	next := ec._Subscription(ctx, op.SelectionSet)
+	if ec.Errors != nil {
+		return graphql.OneShot(&graphql.Response{Data: []byte("null"), Errors: ec.Errors})
+	}
+
+	var buf bytes.Buffer
+	return func() *graphql.Response {
+		buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+			buf.Reset()
+			data := next()
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+			return buf.Bytes()
+		})
+
+		if buf == nil {
+			return nil
+		}
+
+		return &graphql.Response{
+			Data:       buf,
+			Errors:     ec.Errors,
+			Extensions: ec.Extensions,
+		}
+	}
+}
+
+type executionContext struct {
+	*graphql.RequestContext
+	*executableSchema
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(parsedSchema), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
+}
+
+var parsedSchema = gqlparser.MustLoadSchema(
+	&ast.Source{Name: "schema/about.graphql", Input: `"""
+A ` + "`" + `PartialAbout` + "`" + ` provides partial basic personal information.
+"""
+interface PartialAbout {
+  name: String!
+  email: String!
+  type: String!
+  iq: Boolean!
+  skills: [String!]!
+}
+
+"""
+An ` + "`" + `FullAbout` + "`" + ` provides full basic personal information.
+"""
+type FullAbout implements PartialAbout {
+  name: String!
+  email: String!
+  type: String!
+  birthday: String!
+  age: String!
+  iq: Boolean!
+  skills: [String!]!
+  location: Coordinates!
+}
+
+"""
+A ` + "`" + `MaskedAbout` + "`" + ` provides obfuscated basic personal information.
+"""
+type MaskedAbout implements PartialAbout {
+  name: String!
+  email: String!
+  type: String!
+  approxAge: String!
+  iq: Boolean!
+  skills: [String!]!
+  whereabouts: String!
+}
+`},
+	&ast.Source{Name: "schema/assist.graphql", Input: `type AssistQuery {
+  transit: TransitQuery!
+}
+`},
+	&ast.Source{Name: "schema/auth.graphql", Input: `type AuthQuery {
+  """
+  Get the permissions associated with a particular access code.
+  """
+  permissions(code: String!): [String!]!
+}
+`},
+	&ast.Source{Name: "schema/git.graphql", Input: `type GitQuery {
+  """
+  Get the latest commit from each of my recently pushed-to repositories.
+  """
+  recentCommits(limit: Int): [GitCommit!]!
+
+  """
+  Get every commit that I've recently pushed, from newest to oldest.
+
+  Commits are paginated; use ` + "`" + `after` + "`" + ` with the ` + "`" + `endCursor` + "`" + ` of a previous page
+  to fetch the next page. Pages contain ` + "`" + `first` + "`" + ` (default: 20, max: 100)
+  commits.
+  """
+  commits(
+    repo: String
+    since: Time
+    until: Time
+
+    """
+    Exclude merge commits, and commits by bots.
+    """
+    workOnly: Boolean
+    first: Int
+    after: String
+  ): GitCommitPage!
+
+  """
+  Get my contribution calendar and coding statistics for a range of dates
+  (inclusive). By default, the range spans the last year.
+  """
+  contributions(from: Time, to: Time): GitContributions!
+
+  """
+  Get my recent activity (other than pushes), from newest to oldest.
+
+  ` + "`" + `types` + "`" + ` restricts activity to the specified types, which are any of
+  ` + "`" + `PULL_REQUEST` + "`" + `, ` + "`" + `REVIEW` + "`" + `, ` + "`" + `ISSUE` + "`" + `, or ` + "`" + `RELEASE` + "`" + `.
+  """
+  activity(types: [String!], first: Int): [GitActivity!]!
+}
+
+"""
+A ` + "`" + `GitCommitPage` + "`" + ` is a page of ` + "`" + `GitCommit` + "`" + `s.
+"""
+type GitCommitPage {
+  commits: [GitCommit!]!
+  endCursor: String
+  hasNextPage: Boolean!
+
+  """
+  The page's commits, grouped by repository.
+  """
+  byRepo: [GitRepoCommits!]!
+}
+
+"""
+` + "`" + `GitRepoCommits` + "`" + ` are the ` + "`" + `GitCommit` + "`" + `s in a ` + "`" + `GitRepo` + "`" + `.
+"""
+type GitRepoCommits {
+  repo: GitRepo!
+  commits: [GitCommit!]!
+}
+
+"""
+A ` + "`" + `GitCommit` + "`" + ` represents a Git commit.
+"""
+type GitCommit {
+  sha: String!
+  author: GitCommitAuthor!
+  committer: GitCommitAuthor
+  message: String!
+  url: String!
+  repo: GitRepo!
+  timestamp: Time!
+
+  """
+  The commit's ` + "`" + `message` + "`" + `, parsed into its parts.
+  """
+  parsedMessage: GitCommitMessage!
+
+  """
+  Whether the commit's signature was verified. Only known for commits hosted
+  on GitHub; otherwise, it is ` + "`" + `null` + "`" + `.
+  """
+  verification: GitCommitVerification
+}
+
+"""
+A ` + "`" + `GitCommitAuthor` + "`" + ` authors or commits a ` + "`" + `GitCommit` + "`" + `.
+"""
+type GitCommitAuthor {
+  name: String!
+  email: String!
+  login: String
+  date: Time
+
+  """
+  Whether the author is a bot account (i.e. ` + "`" + `dependabot[bot]` + "`" + `).
+  """
+  isBot: Boolean!
+}
+
+"""
+A ` + "`" + `GitCommitMessage` + "`" + ` is a parsed commit message.
+"""
+type GitCommitMessage {
+  subject: String!
+
+  """
+  The rest of the message after the subject, excluding trailers.
+  """
+  body: String!
+
+  """
+  The type and scope of a Conventional Commit (i.e. ` + "`" + `feat(api): ...` + "`" + `).
+  """
+  type: String
+  scope: String
+  breaking: Boolean!
+
+  """
+  Authors credited with ` + "`" + `Co-authored-by` + "`" + ` trailers.
+  """
+  coAuthors: [GitCommitAuthor!]!
+  issueRefs: [GitIssueRef!]!
+
+  """
+  Whether the message is that of a merge commit.
+  """
+  isMerge: Boolean!
+}
+
+"""
+A ` + "`" + `GitIssueRef` + "`" + ` is a reference to an issue or pull request from a commit
+message, like ` + "`" + `#12` + "`" + ` or ` + "`" + `owner/repo#12` + "`" + `.
+"""
+type GitIssueRef {
+  """
+  The full name of the issue's repository, if it isn't the commit's own.
+  """
+  repo: String
+  number: Int!
+
+  """
+  Whether the reference uses a closing keyword, like ` + "`" + `Fixes #12` + "`" + `.
+  """
+  closes: Boolean!
+}
+
+"""
+A ` + "`" + `GitCommitVerification` + "`" + ` describes whether a ` + "`" + `GitCommit` + "`" + `'s signature was
+verified.
+"""
+type GitCommitVerification {
+  signed: Boolean!
+  verified: Boolean!
+
+  """
+  One of ` + "`" + `GPG` + "`" + `, ` + "`" + `SSH` + "`" + `, or ` + "`" + `SMIME` + "`" + `, if known.
+  """
+  format: String
+
+  """
+  The forge's verification state, i.e. ` + "`" + `VALID` + "`" + `, ` + "`" + `UNSIGNED` + "`" + `, or ` + "`" + `UNKNOWN_KEY` + "`" + `.
+  """
+  state: String!
+  signer: String
+}
+
+"""
+A ` + "`" + `GitRepo` + "`" + ` contains ` + "`" + `GitCommit` + "`" + `s.
+
+Fields other than ` + "`" + `name` + "`" + ` and ` + "`" + `url` + "`" + ` are only known for repositories hosted on
+GitHub; otherwise, they are ` + "`" + `null` + "`" + `.
+"""
+type GitRepo {
+  name: String!
+  url: String!
+
+  description: String
+  primaryLanguage: String
+
+  """
+  The languages that the repository is written in, from largest to smallest.
+  """
+  languages: [GitRepoLanguage!]
+  stars: Int
+  topics: [String!]
+  pushedAt: Time
+  isFork: Boolean
+  isArchived: Boolean
+}
+
+"""
+A ` + "`" + `GitRepoLanguage` + "`" + ` is the amount of code in a ` + "`" + `GitRepo` + "`" + ` that is written in a
+particular language.
+"""
+type GitRepoLanguage {
+  name: String!
+  bytes: Int!
+}
+
+"""
+` + "`" + `GitContributions` + "`" + ` describe my Git activity over a range of dates.
+"""
+type GitContributions {
+  from: Time!
+  to: Time!
+  total: Int!
+  days: [GitContributionDay!]!
+
+  longestStreak: Int!
+  currentStreak: Int!
+
+  """
+  The name of the weekday with the most commits (i.e. ` + "`" + `Monday` + "`" + `).
+  """
+  busiestWeekday: String
+  busiestHour: Int
+
+  languages: [GitLanguageCount!]!
+}
+
+type GitContributionDay {
+  date: Time!
+  count: Int!
+}
+
+type GitLanguageCount {
+  language: String!
+  count: Int!
+}
+
+"""
+A ` + "`" + `GitActivity` + "`" + ` is something that I did on a Git forge, other than pushing
+commits.
+"""
+union GitActivity =
+    GitPullRequestActivity
+  | GitReviewActivity
+  | GitIssueActivity
+  | GitReleaseActivity
+
+"""
+A ` + "`" + `GitPullRequest` + "`" + ` identifies a pull request.
+"""
+type GitPullRequest {
+  number: Int!
+  title: String!
+  url: String!
+}
+
+"""
+A ` + "`" + `GitPullRequestActivity` + "`" + ` is an action that I performed on a pull request.
+"""
+type GitPullRequestActivity {
+  """
+  One of ` + "`" + `OPENED` + "`" + `, ` + "`" + `MERGED` + "`" + `, ` + "`" + `CLOSED` + "`" + `, or ` + "`" + `REOPENED` + "`" + `.
+  """
+  action: String!
+  pullRequest: GitPullRequest!
+  repo: GitRepo!
+  timestamp: Time!
+}
+
+"""
+A ` + "`" + `GitReviewActivity` + "`" + ` is a review that I submitted on a pull request.
+"""
+type GitReviewActivity {
+  """
+  One of ` + "`" + `APPROVED` + "`" + `, ` + "`" + `CHANGES_REQUESTED` + "`" + `, ` + "`" + `COMMENTED` + "`" + `, or ` + "`" + `DISMISSED` + "`" + `.
+  """
+  state: String!
+  url: String!
+  pullRequest: GitPullRequest!
+  repo: GitRepo!
+  timestamp: Time!
+}
+
+"""
+A ` + "`" + `GitIssueActivity` + "`" + ` is an action that I performed on an issue.
+"""
+type GitIssueActivity {
+  """
+  One of ` + "`" + `OPENED` + "`" + `, ` + "`" + `CLOSED` + "`" + `, or ` + "`" + `REOPENED` + "`" + `.
+  """
+  action: String!
+  number: Int!
+  title: String!
+  url: String!
+  repo: GitRepo!
+  timestamp: Time!
+}
+
+"""
+A ` + "`" + `GitReleaseActivity` + "`" + ` is a release that I published.
+"""
+type GitReleaseActivity {
+  tag: String!
+  name: String!
+  prerelease: Boolean!
+  url: String!
+  repo: GitRepo!
+  timestamp: Time!
+}
+`},
+	&ast.Source{Name: "schema/location.graphql", Input: `type LocationQuery {
+  region: Place!
+
+  """
+  Get my recent location history, or the location history for a specified date.
+  """
+  history(code: String!, date: Time): [LocationHistorySegment!]!
+}
+
+"""
+A ` + "`" + `LocationHistorySegment` + "`" + ` is a segment of my location history.
+"""
+type LocationHistorySegment {
+  place: String!
+  address: String
+  description: String!
+  category: String!
+  distance: Int
+  timeSpan: TimeSpan!
+  coordinates: [Coordinates!]!
+}
+
+"""
+A ` + "`" + `Place` + "`" + ` represents a geographical location.
+"""
+type Place {
+  id: ID!
+  level: String!
+  type: String!
+  position: Coordinates!
+  timeZone: TimeZone
+  address: Address!
+  shape: [Coordinates!]
+}
+
+"""
+` + "`" + `Coordinates` + "`" + ` are a point in 3D space.
+"""
+type Coordinates {
+  x: Float!
+  y: Float!
+  z: Float!
+}
+
+"""
+A ` + "`" + `CoordinatesInput` + "`" + ` is used to create a ` + "`" + `Coordinates` + "`" + `.
+
+If its ` + "`" + `z` + "`" + ` component is not set, it will default to zero.
+"""
+input CoordinatesInput {
+  x: Float!
+  y: Float!
+  z: Float
+}
+
+"""
+An ` + "`" + `Address` + "`" + ` describes the position of a ` + "`" + `Place` + "`" + `.
+"""
+type Address {
+  label: String!
+  country: String!
+  state: String!
+  county: String!
+  city: String!
+  district: String
+  postcode: String!
+  street: String
+  number: String
+}
+
+"""
+A ` + "`" + `TimeZone` + "`" + ` describes a time zone in terms of its name and offset.
+"""
+type TimeZone {
+  name: String!
+
+  """
+  The offset in seconds east of UTC.
+  """
+  offset: Int!
+}
+`},
+	&ast.Source{Name: "schema/music.graphql", Input: `type MusicQuery {
+  current: CurrentlyPlayingMusic
+
+  """
+  Get pending music requests, as well as recently resolved ones.
+
+  Requester names are only visible to a code with the ` + "`" + `music.control` + "`" + `
+  permission.
+  """
+  requests(code: String): [MusicRequest!]!
+}
+
+type MusicMutation {
+  """
+  Play a specified resource, or resume playback for the current track.
+  """
+  play(resource: MusicSelector): Boolean!
+
+  """
+  Pause playback for the current track.
+  """
+  pause: Boolean!
+
+  """
+  Approve a pending music request, adding its resource to the play queue.
+  """
+  approveRequest(id: ID!): MusicRequest!
+
+  """
+  Reject a pending music request.
+  """
+  rejectRequest(id: ID!): MusicRequest!
+}
+
+"""
+A ` + "`" + `MusicRequest` + "`" + ` is a request for a music resource to be added to my play
+queue, which must be approved before it takes effect.
+"""
+type MusicRequest {
+  id: ID!
+  resource: MusicRequestedResource!
+  requester: String
+
+  """
+  The requested track, if the request selects a track by its ID.
+  """
+  track: MusicTrack
+
+  """
+  One of ` + "`" + `PENDING` + "`" + `, ` + "`" + `APPROVED` + "`" + `, or ` + "`" + `REJECTED` + "`" + `.
+  """
+  status: String!
+  createdAt: Time!
+  resolvedAt: Time
+}
+
+"""
+A ` + "`" + `MusicRequestedResource` + "`" + ` describes the resource selected by a
+` + "`" + `MusicRequest` + "`" + `.
+"""
+type MusicRequestedResource {
+  """
+  One of ` + "`" + `track` + "`" + `, ` + "`" + `album` + "`" + `, ` + "`" + `artist` + "`" + `, or ` + "`" + `playlist` + "`" + `; null if the resource was
+  selected by its URI.
+  """
+  kind: String
+  id: ID
+  uri: String
+}
+
+"""
+A ` + "`" + `MusicSelector` + "`" + ` is used to select a music resource.
+"""
+input MusicSelector {
+  uri: String
+  track: MusicResource
+  album: MusicResource
+  artist: MusicResource
+  playlist: MusicResource
+}
+
+"""
+A ` + "`" + `MusicResource` + "`" + ` specifies a music resource by its ID.
+"""
+input MusicResource {
+  id: ID!
+}
+
+"""
+` + "`" + `CurrentlyPlayingMusic` + "`" + ` contains information about the music that I'm listening
+to right now.
+"""
+type CurrentlyPlayingMusic {
+  timestamp: Time!
+  track: MusicTrack!
+  playing: Boolean!
+
+  """
+  The progress of the track, in milliseconds.
+  """
+  progress: Int!
+}
+
+"""
+A ` + "`" + `MusicTrack` + "`" + ` contains information about a music track.
+"""
+type MusicTrack {
+  id: ID!
+  uri: String!
+  name: String!
+  externalURL: String!
+  artists: [MusicArtist!]!
+  album: MusicAlbum!
+
+  """
+  The duration of the track, in milliseconds.
+  """
+  duration: Int!
+
+  """
+  The lyrics of the track, if available.
+  """
+  lyrics: MusicLyrics
+}
+
+"""
+` + "`" + `MusicLyrics` + "`" + ` are the lyrics of a ` + "`" + `MusicTrack` + "`" + `.
+"""
+type MusicLyrics {
+  text: String!
+
+  """
+  Whether or not the lyrics are time-synced (i.e. ` + "`" + `lines` + "`" + ` is non-empty).
+  """
+  synced: Boolean!
+  lines: [MusicLyricLine!]!
+}
+
+"""
+A ` + "`" + `MusicLyricLine` + "`" + ` is a line of lyrics that is synced to a point in a
+` + "`" + `MusicTrack` + "`" + `.
+"""
+type MusicLyricLine {
+  """
+  The time at which the line starts, in milliseconds.
+  """
+  time: Int!
+  text: String!
+}
+
+"""
+A ` + "`" + `MusicArtist` + "`" + ` is the composer of a ` + "`" + `MusicTrack` + "`" + `.
+"""
+type MusicArtist {
+  id: ID!
+  uri: String!
+  name: String!
+  externalURL: String!
+  albums(limit: Int, offset: Int): [MusicAlbum!]!
+}
+
+"""
+A ` + "`" + `MusicAlbum` + "`" + ` is an organization of ` + "`" + `MusicTrack` + "`" + `s.
+"""
+type MusicAlbum {
+  id: ID!
+  uri: String!
+  name: String!
+  externalURL: String!
+  images: [MusicImage!]!
+  artists: [MusicArtist!]!
+  tracks(limit: Int, offset: Int): [MusicTrack!]
+}
+
+"""
+A ` + "`" + `MusicImage` + "`" + ` describes the cover images for ` + "`" + `MusicArtist` + "`" + `s and ` + "`" + `MusicAlbum` + "`" + `s.
+"""
+type MusicImage {
+  height: Int!
+  width: Int!
+  url: String!
+}
+`},
+	&ast.Source{Name: "schema/productivity.graphql", Input: `"""
+` + "`" + `Productivity` + "`" + ` is a measure of productivity for a given day.
+"""
+type Productivity {
+  records: [ProductivityRecord!]!
+
+  """
+  Score is a number between 0 and 100, computed using the model named by
+  ` + "`" + `scoreModel` + "`" + `; ` + "`" + `null` + "`" + ` if no time was recorded.
+  """
+  score: Int
+
+  """
+  The scoring model used to compute ` + "`" + `score` + "`" + `. This is either ` + "`" + `pulse` + "`" + `, which is
+  computed as follows:
+  https://help.rescuetime.com/article/73-how-is-my-productivity-pulse-calculated
+
+  or ` + "`" + `weighted` + "`" + `, which is computed like ` + "`" + `pulse` + "`" + ` but with custom category
+  weights. Either may be followed by modifiers like ` + "`" + `+exclude-neutral` + "`" + ` (neutral
+  time is ignored) or ` + "`" + `+time-of-day` + "`" + ` (time is weighted by the hour of day at
+  which it was recorded).
+  """
+  scoreModel: String!
+
+  """
+  The applications and websites that I used, from longest to shortest use.
+
+  Requires a code with the ` + "`" + `productivity.activities` + "`" + ` permission.
+  """
+  activities(code: String!, limit: Int): [ProductivityActivity!]!
+
+  """
+  My most distracting activities, from longest to shortest use.
+
+  Requires a code with the ` + "`" + `productivity.activities` + "`" + ` permission.
+  """
+  topDistractions(code: String!, limit: Int): [ProductivityActivity!]!
+
+  """
+  The progress made towards each of my goals on this day.
+
+  Requires a code with the ` + "`" + `productivity.goals` + "`" + ` permission.
+  """
+  goals(code: String!): [ProductivityGoalProgress!]!
+
+  """
+  The focus sessions that I started on this day, from oldest to newest.
+
+  Their labels are hidden unless ` + "`" + `code` + "`" + ` has the ` + "`" + `productivity.goals` + "`" + `
+  permission.
+  """
+  focusSessions(code: String): [ProductivityFocusSession!]!
+}
+
+"""
+A ` + "`" + `ProductivityActivity` + "`" + ` is the time spent using a particular application or
+website.
+"""
+type ProductivityActivity {
+  name: String!
+
+  """
+  The source's own classification of the activity, like RescueTime's
+  ` + "`" + `Software Development` + "`" + `.
+  """
+  group: String
+  category: ProductivityCategory!
+
+  """
+  The duration of the activity, in seconds.
+  """
+  duration: Int!
+}
+
+"""
+A ` + "`" + `ProductivityRecord` + "`" + ` is a record of the time spent doing activities of a
+particular ` + "`" + `ProductivityCategory` + "`" + `.
+"""
+type ProductivityRecord {
+  category: ProductivityCategory!
+
+  """
+  The duration of the record, in seconds.
+  """
+  duration: Int!
+}
+
+"""
+A ` + "`" + `ProductivityCategory` + "`" + ` represents a degree of productivity.
+"""
+type ProductivityCategory {
+  id: ID!
+  name: String!
+  weight: Int!
+}
+
+"""
+A ` + "`" + `ProductivityTrend` + "`" + ` describes my productivity over a range of time.
+"""
+type ProductivityTrend {
+  from: Time!
+  to: Time!
+
+  """
+  One of ` + "`" + `HOUR` + "`" + `, ` + "`" + `DAY` + "`" + `, or ` + "`" + `WEEK` + "`" + `.
+  """
+  resolution: String!
+  points: [ProductivityTrendPoint!]!
+}
+
+"""
+A ` + "`" + `ProductivityTrendPoint` + "`" + ` describes my productivity during a single interval
+within a ` + "`" + `ProductivityTrend` + "`" + `.
+"""
+type ProductivityTrendPoint {
+  start: Time!
+  end: Time!
+  records: [ProductivityRecord!]!
+
+  """
+  Computed like ` + "`" + `Productivity.score` + "`" + `; ` + "`" + `null` + "`" + ` if no time was recorded.
+  """
+  score: Int
+
+  """
+  The average score of the trailing intervals, including this one.
+  """
+  movingAverage: Float
+
+  """
+  The change in score since the same interval a week earlier.
+  """
+  weekOverWeek: Int
+}
+
+type ProductivityMutation {
+  createGoal(goal: ProductivityGoalInput!): ProductivityGoal!
+  updateGoal(id: ID!, goal: ProductivityGoalInput!): ProductivityGoal!
+  deleteGoal(id: ID!): ProductivityGoal!
+
+  """
+  Start a focus session. Only one focus session may be ongoing at a time.
+  """
+  startFocusSession(label: String): ProductivityFocusSession!
+
+  """
+  Stop the ongoing focus session.
+  """
+  stopFocusSession: ProductivityFocusSession!
+}
+
+"""
+A ` + "`" + `ProductivityGoal` + "`" + ` is a daily limit or target for the time spent doing
+activities of a particular ` + "`" + `ProductivityCategory` + "`" + `.
+"""
+type ProductivityGoal {
+  id: ID!
+  category: ProductivityCategory!
+
+  """
+  Either ` + "`" + `AT_MOST` + "`" + ` (a limit) or ` + "`" + `AT_LEAST` + "`" + ` (a target).
+  """
+  kind: String!
+
+  """
+  The duration of the limit or target, in seconds.
+  """
+  duration: Int!
+
+  """
+  The days on which the goal applies, like ` + "`" + `Monday` + "`" + `; empty if it applies every
+  day.
+  """
+  weekdays: [String!]!
+  createdAt: Time!
+}
+
+input ProductivityGoalInput {
+  """
+  The name of a ` + "`" + `ProductivityCategory` + "`" + `, like ` + "`" + `Very Distracting` + "`" + `.
+  """
+  category: String!
+
+  """
+  Either ` + "`" + `AT_MOST` + "`" + ` (a limit) or ` + "`" + `AT_LEAST` + "`" + ` (a target).
+  """
+  kind: String!
+
+  """
+  The duration of the limit or target, in seconds.
+  """
+  duration: Int!
+
+  """
+  The days on which the goal applies, like ` + "`" + `Monday` + "`" + `; if omitted, it applies
+  every day.
+  """
+  weekdays: [String!]
+}
+
+"""
+` + "`" + `ProductivityGoalProgress` + "`" + ` describes the progress made towards a
+` + "`" + `ProductivityGoal` + "`" + ` on a particular day.
+"""
+type ProductivityGoalProgress {
+  goal: ProductivityGoal!
+  date: Time!
+
+  """
+  Whether the goal applies on this day.
+  """
+  active: Boolean!
+
+  """
+  The time spent on activities of the goal's category, in seconds.
+  """
+  duration: Int!
+
+  """
+  ` + "`" + `duration` + "`" + ` as a percentage of the goal's duration.
+  """
+  percent: Float!
+
+  """
+  Whether the goal has been met (or, for a limit, not yet exceeded).
+  """
+  met: Boolean!
+
+  """
+  Whether a limit has been exceeded.
+  """
+  breached: Boolean!
+}
+
+"""
+A ` + "`" + `ProductivityFocusSession` + "`" + ` is an explicit period of focused work.
+"""
+type ProductivityFocusSession {
+  id: ID!
+  label: String
+  start: Time!
+
+  """
+  ` + "`" + `null` + "`" + ` if the session is ongoing.
+  """
+  end: Time
+  records: [ProductivityRecord!]!
+
+  """
+  Computed like ` + "`" + `Productivity.score` + "`" + `, over just this session; ` + "`" + `null` + "`" + ` if no time
+  was recorded.
+  """
+  score: Int
+}
+`},
+	&ast.Source{Name: "schema/root.graphql", Input: `type Query {
+  """
+  Get my basic personal information.
+  """
+  about(code: String): PartialAbout!
+
+  """
+  Get my current productivity information.
+  """
+  productivity: Productivity!
+
+  """
+  Get my productivity between two times, at a resolution of ` + "`" + `HOUR` + "`" + `, ` + "`" + `DAY` + "`" + `
+  (default), or ` + "`" + `WEEK` + "`" + `.
+
+  ` + "`" + `window` + "`" + ` is the number of intervals over which to compute moving averages
+  (at most 52).
+  """
+  productivityTrend(
+    from: Time!
+    to: Time!
+    resolution: String
+    window: Int
+  ): ProductivityTrend!
+
+  """
+  Get my current status.
+
+  Without a code, calendar event titles and location history are hidden. A
+  code with the ` + "`" + `scheduling.busy-all` + "`" + ` (or ` + "`" + `scheduling.event-details` + "`" + `)
+  permission can see the titles of my meetings, a code with the
+  ` + "`" + `location.history` + "`" + ` permission can see whether I'm commuting, and where I am,
+  and a code with the ` + "`" + `productivity.goals` + "`" + ` permission can see the labels of my
+  focus sessions.
+  """
+  status(code: String): Status!
+
+  """
+  Utility queries, used by personal assistants.
+  """
+  assist: AssistQuery!
+
+  git: GitQuery!
+  auth: AuthQuery!
+  music: MusicQuery!
+  location: LocationQuery!
+  scheduling: SchedulingQuery!
+}
+
+type Mutation {
+  music(code: String!): MusicMutation!
+
+  """
+  Manage my productivity goals and focus sessions.
+
+  Requires a code with the ` + "`" + `productivity.goals` + "`" + ` permission.
+  """
+  productivity(code: String!): ProductivityMutation!
+
+  """
+  Request for a track to be added to my play queue. Requests are rate-limited
+  per code, and must be approved before they take effect.
+  """
+  requestMusic(
+    code: String!
+    resource: MusicSelector!
+    requester: String
+  ): MusicRequest!
+
+  """
+  Book a tentative meeting with me in a free slot (see
+  ` + "`" + `SchedulingQuery.availability` + "`" + `).
+
+  Requires a code with the ` + "`" + `scheduling.book` + "`" + ` permission.
+  """
+  bookMeeting(
+    code: String!
+    booking: SchedulingBookingInput!
+  ): SchedulingBooking!
+}
+
+type Subscription {
+  music: CurrentlyPlayingMusic
+
+  """
+  Stream the currently sung line of my currently playing music. A ` + "`" + `null` + "`" + ` value
+  indicates that nothing is being sung.
+  """
+  musicLyricLine: MusicLyricLine
+
+  """
+  Stream the state of the music request queue, with the same visibility rules
+  as ` + "`" + `MusicQuery.requests` + "`" + `.
+  """
+  musicRequests(code: String): [MusicRequest!]!
+
+  """
+  Stream my Git commits as they are pushed (requires the GitHub webhook to be
+  configured).
+  """
+  gitCommits: GitCommit!
+
+  """
+  Stream the progress of my productivity goals as their limits are exceeded.
+  Each goal is reported at most once per day.
+
+  Requires a code with the ` + "`" + `productivity.goals` + "`" + ` permission.
+  """
+  productivityGoalBreached(code: String!): ProductivityGoalProgress!
+
+  """
+  Stream my status as it changes, with the same visibility rules as
+  ` + "`" + `Query.status` + "`" + `.
+  """
+  status(code: String): Status!
+}
+`},
+	&ast.Source{Name: "schema/scalars.graphql", Input: `"""
+A ` + "`" + `Time` + "`" + ` represents an instant in time.
+"""
+scalar Time
+`},
+	&ast.Source{Name: "schema/scheduling.graphql", Input: `"""
+A ` + "`" + `TimeSpan` + "`" + ` represents a span of time.
+"""
+type TimeSpan {
+  start: Time!
+  end: Time!
+}
+
+type SchedulingQuery {
+  """
+  Get my busy times for a given date (default: today), or between ` + "`" + `from` + "`" + ` and
+  ` + "`" + `to` + "`" + ` (at most 31 days apart).
+
+  Busy times within a range are merged where they overlap, and clipped to the
+  range. Dates (or ranges) beyond today require a code with the
+  ` + "`" + `scheduling.busy-all` + "`" + ` permission.
+  """
+  busyTimes(code: String, date: Time, from: Time, to: Time): [TimeSpan!]!
+
+  """
+  Get my calendar events for a given date (default: today).
+
+  Without a code, only the times of busy events are visible. A code with the
+  ` + "`" + `scheduling.busy-all` + "`" + ` permission can also see free events and event titles,
+  and a code with the ` + "`" + `scheduling.event-details` + "`" + ` permission can see all event
+  details. Dates beyond today require one of these permissions.
+  """
+  events(code: String, date: Time): [SchedulingEvent!]!
+
+  """
+  Get the time that I need to spend travelling to my calendar events on a given
+  date (default: today), from the previous event or (for the next upcoming
+  event) from my current position. Events without a physical location are
+  ignored.
+
+  The locations travelled between are only visible to a code with the
+  ` + "`" + `scheduling.event-details` + "`" + ` permission, and trips from my current position are
+  only planned for a code with the ` + "`" + `location.history` + "`" + ` permission. Dates beyond
+  today require the same permissions as ` + "`" + `events` + "`" + `.
+  """
+  travelBlocks(code: String, date: Time): [SchedulingTravelBlock!]!
+
+  """
+  Find free slots of at least ` + "`" + `duration` + "`" + ` seconds between ` + "`" + `from` + "`" + ` and ` + "`" + `to` + "`" + ` (at
+  most 14 days apart), from best to worst. Slots that begin sooner, and slots
+  with more room to spare, are ranked higher.
+
+  ` + "`" + `workingHours` + "`" + ` default to 09:00 to 17:00, Monday through Friday, in my current
+  time zone. ` + "`" + `buffer` + "`" + ` is the time (in seconds) to keep free before and after
+  each of my busy times, i.e. for travel.
+  """
+  availability(
+    from: Time!
+    to: Time!
+    duration: Int!
+    workingHours: SchedulingWorkingHoursInput
+    buffer: Int
+    limit: Int
+  ): [SchedulingSlot!]!
+}
+
+input SchedulingWorkingHoursInput {
+  """
+  The time of day at which working hours start, like ` + "`" + `09:00` + "`" + `.
+  """
+  start: String!
+
+  """
+  The time of day at which working hours end, like ` + "`" + `17:00` + "`" + `.
+  """
+  end: String!
+
+  """
+  The days on which working hours apply, like ` + "`" + `Monday` + "`" + `; if omitted, they apply
+  every day.
+  """
+  weekdays: [String!]
+}
+
+"""
+A ` + "`" + `SchedulingSlot` + "`" + ` is a span of free time, in which a meeting could be
+scheduled.
+"""
+type SchedulingSlot {
+  start: Time!
+  end: Time!
+
+  """
+  A score between 0 and 1, used to rank slots; higher is better.
+  """
+  score: Float!
+}
+
+"""
+A ` + "`" + `SchedulingEvent` + "`" + ` is an event on my calendar. Fields may be ` + "`" + `null` + "`" + ` if they
+are not visible to the viewer.
+"""
+type SchedulingEvent {
+  start: Time!
+  end: Time!
+  allDay: Boolean!
+
+  title: String
+  location: String
+
+  """
+  The number of people attending the event, including me.
+  """
+  attendees: Int
+
+  """
+  A link for joining the event remotely.
+  """
+  conferenceURL: String
+
+  """
+  Either ` + "`" + `OPAQUE` + "`" + ` or ` + "`" + `TRANSPARENT` + "`" + `; transparent events do not block time on my
+  calendar.
+  """
+  transparency: String!
+
+  """
+  Whether the event blocks time on my calendar.
+  """
+  busy: Boolean!
+}
+
+"""
+A ` + "`" + `SchedulingTravelBlock` + "`" + ` is a span of time that I need to spend travelling to
+an event. Fields may be ` + "`" + `null` + "`" + ` if they are not visible to the viewer.
+"""
+type SchedulingTravelBlock {
+  """
+  The latest time at which I can leave.
+  """
+  start: Time!
+
+  """
+  The start of the event.
+  """
+  end: Time!
+
+  """
+  The earliest time at which I can leave, i.e. the end of the previous event.
+  """
+  departure: Time!
+
+  """
+  The duration of the trip, in seconds.
+  """
+  duration: Int!
+
+  """
+  The length of the trip, in meters.
+  """
+  distance: Float!
+
+  """
+  The location of the previous event; ` + "`" + `null` + "`" + ` if I'm travelling from my current
+  position.
+  """
+  origin: String
+  destination: String
+
+  """
+  Whether the duration was estimated from the distance, rather than planned
+  using a road network.
+  """
+  estimated: Boolean!
+
+  """
+  The time to spare (in seconds) between ` + "`" + `departure` + "`" + ` and ` + "`" + `start` + "`" + `; negative if I
+  can't make it to the event on time.
+  """
+  slack: Int!
+  feasible: Boolean!
+
+  """
+  Describes why I can't make it to the event on time, if ` + "`" + `feasible` + "`" + ` is false.
+  """
+  warning: String
+}
+
+input SchedulingBookingInput {
+  start: Time!
+
+  """
+  The duration of the booking, in seconds.
+  """
+  duration: Int!
+
+  """
+  The title of the booking; defaults to ` + "`" + `Meeting with {name}` + "`" + `.
+  """
+  title: String
+  name: String!
+  email: String
+  notes: String
+
+  """
+  A unique key for this booking, so that the request can be safely retried.
+  Repeated requests with the same key return the original booking.
+  """
+  idempotencyKey: String!
+}
+
+"""
+A ` + "`" + `SchedulingBooking` + "`" + ` is a tentative hold on my calendar, placed by a visitor.
+"""
+type SchedulingBooking {
+  id: ID!
+  start: Time!
+  end: Time!
+  title: String!
+  name: String!
+  email: String
+  notes: String
+  createdAt: Time!
+
+  """
+  The booking as an iCalendar (` + "`" + `.ics` + "`" + `) object, to be attached or downloaded.
+  """
+  ics: String!
+}
+`},
+	&ast.Source{Name: "schema/status.graphql", Input: `"""
+` + "`" + `Status` + "`" + ` describes what I'm doing right now, as derived from my calendar,
+location history, music, and productivity.
+"""
+type Status {
+  """
+  One of ` + "`" + `MEETING` + "`" + `, ` + "`" + `COMMUTING` + "`" + `, ` + "`" + `FOCUSED` + "`" + `, ` + "`" + `LISTENING` + "`" + `, or ` + "`" + `IDLE` + "`" + `.
+  """
+  activity: String!
+
+  """
+  A number between 0 and 1, describing how likely it is that ` + "`" + `activity` + "`" + ` is
+  correct.
+  """
+  confidence: Float!
+
+  """
+  Describes the activity further (i.e. the title of a meeting, or the track
+  that I'm listening to), if it is visible with the given code.
+  """
+  detail: String
+
+  since: Time
+  until: Time
+  timestamp: Time!
+}
+`},
+	&ast.Source{Name: "schema/transit.graphql", Input: `type TransitQuery {
+  """
+  Find nearby transit departures.
+
+  Optionally specify a radius (in meters), and whether or not you want to
+  restrict results to a single set that is unique by Transport direction.
+  """
+  findDepartures(
+    route: String!
+    coords: CoordinatesInput!
+    radius: Int
+    singleSet: Boolean
+  ): [NearbyTransitDeparture!]!
+
+  nearbyTransports(coords: CoordinatesInput!, radius: Int, limit: Int): [Transport!]!
+}
+
+"""
+A ` + "`" + `NearbyTransitDeparture` + "`" + ` is a ` + "`" + `TransitDeparture` + "`" + ` that is happening nearby.
+"""
+type NearbyTransitDeparture {
+  departure: TransitDeparture!
+
+  """
+  The distance from the departure, in meters.
+  """
+  distance: Int!
+}
+
+"""
+A ` + "`" + `TransitDeparture` + "`" + ` contains information about the departure of a ` + "`" + `Transport` + "`" + `
+from a particular ` + "`" + `Station` + "`" + `.
+"""
+type TransitDeparture {
+  times: [Time!]!
+  transport: Transport!
+  station: TransitStation!
+
+  """
+  The relative departure times, in described text.
+  """
+  relativeTimes: [String!]!
+  realtime: Boolean!
+}
+
+"""
+A ` + "`" + `Transport` + "`" + ` is a vehicle travelling on a transit route.
+"""
+type Transport {
+  route: String!
+  direction: String!
+  category: String!
+  operator: TransitOperator!
+}
+
+"""
+An ` + "`" + `Operator` + "`" + ` represents a transit system operator.
+"""
+type TransitOperator {
+  code: String!
+  name: String!
+}
+
+"""
+A ` + "`" + `Station` + "`" + ` is a place where one can board a ` + "`" + `Transport` + "`" + `.
+"""
+type TransitStation {
+  id: ID!
+  name: String!
+  coordinates: Coordinates!
+}
+`},
+)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_AuthQuery_permissions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_GitQuery_activity_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["types"]; ok {
+		arg0, err = ec.unmarshalOString2ᚕstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["types"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_GitQuery_commits_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["repo"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["repo"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["since"]; ok {
+		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["since"] = arg1
+	var arg2 *time.Time
+	if tmp, ok := rawArgs["until"]; ok {
+		arg2, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["until"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["workOnly"]; ok {
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["workOnly"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_GitQuery_contributions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *time.Time
+	if tmp, ok := rawArgs["from"]; ok {
+		arg0, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["to"]; ok {
+		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_GitQuery_recentCommits_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_LocationQuery_history_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["date"]; ok {
+		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["date"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_MusicAlbum_tracks_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["offset"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_MusicArtist_albums_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["offset"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_MusicMutation_approveRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_MusicMutation_play_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *music.Selector
+	if tmp, ok := rawArgs["resource"]; ok {
+		arg0, err = ec.unmarshalOMusicSelector2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐSelector(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["resource"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_MusicMutation_rejectRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_MusicQuery_requests_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_bookMeeting_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	var arg1 schedgql.BookingInput
+	if tmp, ok := rawArgs["booking"]; ok {
+		arg1, err = ec.unmarshalNSchedulingBookingInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐBookingInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["booking"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_music_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_productivity_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_requestMusic_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	var arg1 music.Selector
+	if tmp, ok := rawArgs["resource"]; ok {
+		arg1, err = ec.unmarshalNMusicSelector2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐSelector(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["resource"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["requester"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["requester"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_ProductivityMutation_createGoal_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 prodgql.GoalInput
+	if tmp, ok := rawArgs["goal"]; ok {
+		arg0, err = ec.unmarshalNProductivityGoalInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐGoalInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["goal"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_ProductivityMutation_deleteGoal_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_ProductivityMutation_startFocusSession_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["label"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["label"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_ProductivityMutation_updateGoal_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 prodgql.GoalInput
+	if tmp, ok := rawArgs["goal"]; ok {
+		arg1, err = ec.unmarshalNProductivityGoalInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐGoalInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["goal"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Productivity_activities_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Productivity_focusSessions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Productivity_goals_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Productivity_topDistractions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_about_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_productivityTrend_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 time.Time
+	if tmp, ok := rawArgs["from"]; ok {
+		arg0, err = ec.unmarshalNTime2timeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 time.Time
+	if tmp, ok := rawArgs["to"]; ok {
+		arg1, err = ec.unmarshalNTime2timeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["resolution"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["resolution"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["window"]; ok {
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["window"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_status_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_SchedulingQuery_availability_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 time.Time
+	if tmp, ok := rawArgs["from"]; ok {
+		arg0, err = ec.unmarshalNTime2timeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 time.Time
+	if tmp, ok := rawArgs["to"]; ok {
+		arg1, err = ec.unmarshalNTime2timeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg1
+	var arg2 int
+	if tmp, ok := rawArgs["duration"]; ok {
+		arg2, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["duration"] = arg2
+	var arg3 *schedgql.WorkingHoursInput
+	if tmp, ok := rawArgs["workingHours"]; ok {
+		arg3, err = ec.unmarshalOSchedulingWorkingHoursInput2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐWorkingHoursInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["workingHours"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["buffer"]; ok {
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["buffer"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_SchedulingQuery_busyTimes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["date"]; ok {
+		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["date"] = arg1
+	var arg2 *time.Time
+	if tmp, ok := rawArgs["from"]; ok {
+		arg2, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg2
+	var arg3 *time.Time
+	if tmp, ok := rawArgs["to"]; ok {
+		arg3, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_SchedulingQuery_events_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["date"]; ok {
+		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["date"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_SchedulingQuery_travelBlocks_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	var arg1 *time.Time
+	if tmp, ok := rawArgs["date"]; ok {
+		arg1, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["date"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_musicRequests_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_productivityGoalBreached_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_status_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["code"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_TransitQuery_findDepartures_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["route"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["route"] = arg0
+	var arg1 locgql.CoordinatesInput
+	if tmp, ok := rawArgs["coords"]; ok {
+		arg1, err = ec.unmarshalNCoordinatesInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐCoordinatesInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["coords"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["radius"]; ok {
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["radius"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["singleSet"]; ok {
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["singleSet"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_TransitQuery_nearbyTransports_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 locgql.CoordinatesInput
+	if tmp, ok := rawArgs["coords"]; ok {
+		arg0, err = ec.unmarshalNCoordinatesInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐCoordinatesInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["coords"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["radius"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["radius"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _Address_label(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Label, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_country(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Country, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_state(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_county(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.County, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_city(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.City, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_district(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Address().District(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_postcode(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Postcode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_street(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Address().Street(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Address_number(ctx context.Context, field graphql.CollectedField, obj *location.Address) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Address",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Address().Number(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AssistQuery_transit(ctx context.Context, field graphql.CollectedField, obj *assistgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "AssistQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Transit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(transgql.Query)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTransitQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚋtransgqlᚐQuery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuthQuery_permissions(ctx context.Context, field graphql.CollectedField, obj *authgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "AuthQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_AuthQuery_permissions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Permissions(ctx, args["code"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Coordinates_x(ctx context.Context, field graphql.CollectedField, obj *location.Coordinates) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Coordinates",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.X, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Coordinates_y(ctx context.Context, field graphql.CollectedField, obj *location.Coordinates) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Coordinates",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Y, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Coordinates_z(ctx context.Context, field graphql.CollectedField, obj *location.Coordinates) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Coordinates",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Z, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CurrentlyPlayingMusic_timestamp(ctx context.Context, field graphql.CollectedField, obj *music.CurrentlyPlaying) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "CurrentlyPlayingMusic",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CurrentlyPlayingMusic_track(ctx context.Context, field graphql.CollectedField, obj *music.CurrentlyPlaying) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "CurrentlyPlayingMusic",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Track, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(music.Track)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicTrack2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CurrentlyPlayingMusic_playing(ctx context.Context, field graphql.CollectedField, obj *music.CurrentlyPlaying) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "CurrentlyPlayingMusic",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Playing, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CurrentlyPlayingMusic_progress(ctx context.Context, field graphql.CollectedField, obj *music.CurrentlyPlaying) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "CurrentlyPlayingMusic",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CurrentlyPlayingMusic().Progress(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FullAbout_name(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "FullAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FullAbout_email(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "FullAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Email, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FullAbout_type(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "FullAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FullAbout_birthday(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "FullAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FullAbout().Birthday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FullAbout_age(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "FullAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FullAbout().Age(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FullAbout_iq(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "FullAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IQ, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FullAbout_skills(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "FullAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Skills, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FullAbout_location(ctx context.Context, field graphql.CollectedField, obj *about.About) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "FullAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(location.Coordinates)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_sha(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SHA, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_author(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Author, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.CommitAuthor)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitCommitAuthor2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_committer(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Committer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*git.CommitAuthor)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOGitCommitAuthor2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_message(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_url(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_repo(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.Repo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_timestamp(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_parsedMessage(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ParsedMessage(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*git.Message)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitCommitMessage2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐMessage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommit_verification(ctx context.Context, field graphql.CollectedField, obj *git.Commit) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommit",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitCommit().Verification(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*git.Verification)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOGitCommitVerification2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐVerification(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitAuthor_name(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitAuthor",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitAuthor_email(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitAuthor",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Email, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitAuthor_login(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitAuthor",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Login, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitAuthor_date(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitAuthor",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Date, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitAuthor_isBot(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitAuthor",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsBot(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitMessage_subject(ctx context.Context, field graphql.CollectedField, obj *git.Message) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitMessage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Subject, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitMessage_body(ctx context.Context, field graphql.CollectedField, obj *git.Message) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitMessage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Body, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitMessage_type(ctx context.Context, field graphql.CollectedField, obj *git.Message) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitMessage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitMessage_scope(ctx context.Context, field graphql.CollectedField, obj *git.Message) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitMessage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Scope, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitMessage_breaking(ctx context.Context, field graphql.CollectedField, obj *git.Message) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitMessage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Breaking, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitMessage_coAuthors(ctx context.Context, field graphql.CollectedField, obj *git.Message) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitMessage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CoAuthors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]git.CommitAuthor)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitCommitAuthor2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitMessage_issueRefs(ctx context.Context, field graphql.CollectedField, obj *git.Message) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitMessage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IssueRefs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]git.IssueRef)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitIssueRef2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐIssueRef(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitMessage_isMerge(ctx context.Context, field graphql.CollectedField, obj *git.Message) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitMessage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsMerge(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitPage_commits(ctx context.Context, field graphql.CollectedField, obj *git.CommitPage) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitPage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Commits, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]git.Commit)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitCommit2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitPage_endCursor(ctx context.Context, field graphql.CollectedField, obj *git.CommitPage) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitPage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndCursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitPage_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *git.CommitPage) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitPage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitPage_byRepo(ctx context.Context, field graphql.CollectedField, obj *git.CommitPage) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitPage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ByRepo(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]git.RepoCommits)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitRepoCommits2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepoCommits(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitVerification_signed(ctx context.Context, field graphql.CollectedField, obj *git.Verification) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitVerification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitVerification_verified(ctx context.Context, field graphql.CollectedField, obj *git.Verification) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitVerification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Verified, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitVerification_format(ctx context.Context, field graphql.CollectedField, obj *git.Verification) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitVerification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitCommitVerification().Format(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitVerification_state(ctx context.Context, field graphql.CollectedField, obj *git.Verification) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitVerification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitCommitVerification_signer(ctx context.Context, field graphql.CollectedField, obj *git.Verification) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitCommitVerification",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributionDay_date(ctx context.Context, field graphql.CollectedField, obj *stats.Day) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributionDay",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Date, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributionDay_count(ctx context.Context, field graphql.CollectedField, obj *stats.Day) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributionDay",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_from(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_to(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_total(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_days(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Days, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]stats.Day)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitContributionDay2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐDay(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_longestStreak(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LongestStreak, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_currentStreak(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CurrentStreak, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_busiestWeekday(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitContributions().BusiestWeekday(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_busiestHour(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BusiestHour, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitContributions_languages(ctx context.Context, field graphql.CollectedField, obj *stats.Contributions) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitContributions",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Languages, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]stats.LanguageCount)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitLanguageCount2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐLanguageCount(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueActivity_action(ctx context.Context, field graphql.CollectedField, obj *git.IssueActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitIssueActivity().Action(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueActivity_number(ctx context.Context, field graphql.CollectedField, obj *git.IssueActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Number, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueActivity_title(ctx context.Context, field graphql.CollectedField, obj *git.IssueActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueActivity_url(ctx context.Context, field graphql.CollectedField, obj *git.IssueActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueActivity_repo(ctx context.Context, field graphql.CollectedField, obj *git.IssueActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.Repo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueActivity_timestamp(ctx context.Context, field graphql.CollectedField, obj *git.IssueActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueRef_repo(ctx context.Context, field graphql.CollectedField, obj *git.IssueRef) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueRef",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueRef_number(ctx context.Context, field graphql.CollectedField, obj *git.IssueRef) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueRef",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Number, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitIssueRef_closes(ctx context.Context, field graphql.CollectedField, obj *git.IssueRef) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitIssueRef",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Closes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitLanguageCount_language(ctx context.Context, field graphql.CollectedField, obj *stats.LanguageCount) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitLanguageCount",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Language, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitLanguageCount_count(ctx context.Context, field graphql.CollectedField, obj *stats.LanguageCount) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitLanguageCount",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitPullRequest_number(ctx context.Context, field graphql.CollectedField, obj *git.PullRequest) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitPullRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Number, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitPullRequest_title(ctx context.Context, field graphql.CollectedField, obj *git.PullRequest) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitPullRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitPullRequest_url(ctx context.Context, field graphql.CollectedField, obj *git.PullRequest) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitPullRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitPullRequestActivity_action(ctx context.Context, field graphql.CollectedField, obj *git.PullRequestActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitPullRequestActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitPullRequestActivity().Action(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitPullRequestActivity_pullRequest(ctx context.Context, field graphql.CollectedField, obj *git.PullRequestActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitPullRequestActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PullRequest, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.PullRequest)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitPullRequest2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐPullRequest(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitPullRequestActivity_repo(ctx context.Context, field graphql.CollectedField, obj *git.PullRequestActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitPullRequestActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.Repo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitPullRequestActivity_timestamp(ctx context.Context, field graphql.CollectedField, obj *git.PullRequestActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitPullRequestActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitQuery_recentCommits(ctx context.Context, field graphql.CollectedField, obj *gitgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_GitQuery_recentCommits_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecentCommits(ctx, args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]git.Commit)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitCommit2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitQuery_commits(ctx context.Context, field graphql.CollectedField, obj *gitgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_GitQuery_commits_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Commits(ctx, args["repo"].(*string), args["since"].(*time.Time), args["until"].(*time.Time), args["workOnly"].(*bool), args["first"].(*int), args["after"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*git.CommitPage)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitCommitPage2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitPage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitQuery_contributions(ctx context.Context, field graphql.CollectedField, obj *gitgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_GitQuery_contributions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Contributions(ctx, args["from"].(*time.Time), args["to"].(*time.Time))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*stats.Contributions)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitContributions2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐContributions(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitQuery_activity(ctx context.Context, field graphql.CollectedField, obj *gitgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_GitQuery_activity_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Activity(ctx, args["types"].([]string), args["first"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]git.Activity)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitActivity2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐActivity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReleaseActivity_tag(ctx context.Context, field graphql.CollectedField, obj *git.ReleaseActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReleaseActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tag, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReleaseActivity_name(ctx context.Context, field graphql.CollectedField, obj *git.ReleaseActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReleaseActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReleaseActivity_prerelease(ctx context.Context, field graphql.CollectedField, obj *git.ReleaseActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReleaseActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Prerelease, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReleaseActivity_url(ctx context.Context, field graphql.CollectedField, obj *git.ReleaseActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReleaseActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReleaseActivity_repo(ctx context.Context, field graphql.CollectedField, obj *git.ReleaseActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReleaseActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.Repo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReleaseActivity_timestamp(ctx context.Context, field graphql.CollectedField, obj *git.ReleaseActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReleaseActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_name(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_url(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_description(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitRepo().Description(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_primaryLanguage(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitRepo().PrimaryLanguage(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_languages(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitRepo().Languages(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]git.RepoLanguage)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOGitRepoLanguage2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepoLanguage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_stars(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitRepo().Stars(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_topics(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitRepo().Topics(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_pushedAt(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitRepo().PushedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_isFork(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitRepo().IsFork(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepo_isArchived(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitRepo().IsArchived(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepoCommits_repo(ctx context.Context, field graphql.CollectedField, obj *git.RepoCommits) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepoCommits",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.Repo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepoCommits_commits(ctx context.Context, field graphql.CollectedField, obj *git.RepoCommits) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepoCommits",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Commits, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]git.Commit)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitCommit2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepoLanguage_name(ctx context.Context, field graphql.CollectedField, obj *git.RepoLanguage) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepoLanguage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitRepoLanguage_bytes(ctx context.Context, field graphql.CollectedField, obj *git.RepoLanguage) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitRepoLanguage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bytes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReviewActivity_state(ctx context.Context, field graphql.CollectedField, obj *git.ReviewActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReviewActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.GitReviewActivity().State(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReviewActivity_url(ctx context.Context, field graphql.CollectedField, obj *git.ReviewActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReviewActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReviewActivity_pullRequest(ctx context.Context, field graphql.CollectedField, obj *git.ReviewActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReviewActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PullRequest, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.PullRequest)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitPullRequest2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐPullRequest(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReviewActivity_repo(ctx context.Context, field graphql.CollectedField, obj *git.ReviewActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReviewActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Repo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(git.Repo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GitReviewActivity_timestamp(ctx context.Context, field graphql.CollectedField, obj *git.ReviewActivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "GitReviewActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationHistorySegment_place(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationHistorySegment",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Place, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationHistorySegment_address(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationHistorySegment",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.LocationHistorySegment().Address(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationHistorySegment_description(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationHistorySegment",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationHistorySegment_category(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationHistorySegment",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Category, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationHistorySegment_distance(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationHistorySegment",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.LocationHistorySegment().Distance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationHistorySegment_timeSpan(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationHistorySegment",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimeSpan, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(scheduling.TimeSpan)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTimeSpan2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐTimeSpan(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationHistorySegment_coordinates(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationHistorySegment",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Coordinates, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]location.Coordinates)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNCoordinates2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationQuery_region(ctx context.Context, field graphql.CollectedField, obj *locgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Region(ctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*location.Place)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNPlace2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐPlace(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _LocationQuery_history(ctx context.Context, field graphql.CollectedField, obj *locgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "LocationQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_LocationQuery_history_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.History(ctx, args["code"].(string), args["date"].(*time.Time))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]location.HistorySegment)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNLocationHistorySegment2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐHistorySegment(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MaskedAbout_name(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MaskedAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MaskedAbout_email(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MaskedAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Email, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MaskedAbout_type(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MaskedAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MaskedAbout_approxAge(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MaskedAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ApproxAge, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MaskedAbout_iq(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MaskedAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IQ, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MaskedAbout_skills(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MaskedAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Skills, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MaskedAbout_whereabouts(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MaskedAbout",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Whereabouts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicAlbum_id(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicAlbum",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicAlbum_uri(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicAlbum",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URI, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicAlbum_name(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicAlbum",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicAlbum_externalURL(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicAlbum",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExternalURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicAlbum_images(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicAlbum",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Images, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]music.Image)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicImage2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐImage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicAlbum_artists(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicAlbum",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Artists, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]music.Artist)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicArtist2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicAlbum_tracks(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicAlbum",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_MusicAlbum_tracks_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicAlbum().Tracks(rctx, obj, args["limit"].(*int), args["offset"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]music.Track)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOMusicTrack2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicArtist_id(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicArtist",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicArtist_uri(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicArtist",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URI, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicArtist_name(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicArtist",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicArtist_externalURL(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicArtist",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExternalURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicArtist_albums(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicArtist",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_MusicArtist_albums_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicArtist().Albums(rctx, obj, args["limit"].(*int), args["offset"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]music.Album)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicAlbum2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicImage_height(ctx context.Context, field graphql.CollectedField, obj *music.Image) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicImage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Height, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicImage_width(ctx context.Context, field graphql.CollectedField, obj *music.Image) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicImage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Width, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicImage_url(ctx context.Context, field graphql.CollectedField, obj *music.Image) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicImage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicLyricLine_time(ctx context.Context, field graphql.CollectedField, obj *music.LyricLine) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicLyricLine",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicLyricLine().Time(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicLyricLine_text(ctx context.Context, field graphql.CollectedField, obj *music.LyricLine) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicLyricLine",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Text, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicLyrics_text(ctx context.Context, field graphql.CollectedField, obj *music.Lyrics) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicLyrics",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Text, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicLyrics_synced(ctx context.Context, field graphql.CollectedField, obj *music.Lyrics) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicLyrics",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Synced(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicLyrics_lines(ctx context.Context, field graphql.CollectedField, obj *music.Lyrics) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicLyrics",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Lines, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]music.LyricLine)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicLyricLine2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyricLine(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicMutation_play(ctx context.Context, field graphql.CollectedField, obj *musicgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_MusicMutation_play_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Play(ctx, args["resource"].(*music.Selector))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicMutation_pause(ctx context.Context, field graphql.CollectedField, obj *musicgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pause(ctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicMutation_approveRequest(ctx context.Context, field graphql.CollectedField, obj *musicgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_MusicMutation_approveRequest_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ApproveRequest(ctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*music.Request)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicRequest2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicMutation_rejectRequest(ctx context.Context, field graphql.CollectedField, obj *musicgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_MusicMutation_rejectRequest_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RejectRequest(ctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*music.Request)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicRequest2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicQuery_current(ctx context.Context, field graphql.CollectedField, obj *musicgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Current(ctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*music.CurrentlyPlaying)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOCurrentlyPlayingMusic2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐCurrentlyPlaying(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicQuery_requests(ctx context.Context, field graphql.CollectedField, obj *musicgql.Query) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicQuery",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_MusicQuery_requests_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Requests(ctx, args["code"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]music.Request)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicRequest2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequest_id(ctx context.Context, field graphql.CollectedField, obj *music.Request) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequest_resource(ctx context.Context, field graphql.CollectedField, obj *music.Request) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicRequest().Resource(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*musicgql.RequestedResource)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicRequestedResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐRequestedResource(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequest_requester(ctx context.Context, field graphql.CollectedField, obj *music.Request) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Requester, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequest_track(ctx context.Context, field graphql.CollectedField, obj *music.Request) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicRequest().Track(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*music.Track)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOMusicTrack2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequest_status(ctx context.Context, field graphql.CollectedField, obj *music.Request) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicRequest().Status(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequest_createdAt(ctx context.Context, field graphql.CollectedField, obj *music.Request) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequest_resolvedAt(ctx context.Context, field graphql.CollectedField, obj *music.Request) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequest",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResolvedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequestedResource_kind(ctx context.Context, field graphql.CollectedField, obj *musicgql.RequestedResource) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequestedResource",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequestedResource_id(ctx context.Context, field graphql.CollectedField, obj *musicgql.RequestedResource) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequestedResource",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicRequestedResource_uri(ctx context.Context, field graphql.CollectedField, obj *musicgql.RequestedResource) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicRequestedResource",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URI, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicTrack_id(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicTrack",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicTrack_uri(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicTrack",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URI, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicTrack_name(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicTrack",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicTrack_externalURL(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicTrack",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExternalURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicTrack_artists(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicTrack",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Artists, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]music.Artist)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicArtist2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicTrack_album(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicTrack",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicTrack().Album(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*music.Album)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicAlbum2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicTrack_duration(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicTrack",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicTrack().Duration(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _MusicTrack_lyrics(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "MusicTrack",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.MusicTrack().Lyrics(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*music.Lyrics)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOMusicLyrics2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyrics(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_music(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_music_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Music(rctx, args["code"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*musicgql.Mutation)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicMutation2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐMutation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_productivity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_productivity_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Productivity(rctx, args["code"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*prodgql.Mutation)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityMutation2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐMutation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_requestMusic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_requestMusic_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RequestMusic(rctx, args["code"].(string), args["resource"].(music.Selector), args["requester"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*music.Request)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicRequest2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_bookMeeting(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_bookMeeting_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().BookMeeting(rctx, args["code"].(string), args["booking"].(schedgql.BookingInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*scheduling.Booking)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSchedulingBooking2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐBooking(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NearbyTransitDeparture_departure(ctx context.Context, field graphql.CollectedField, obj *transit.NearbyDeparture) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "NearbyTransitDeparture",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Departure, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(transit.Departure)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTransitDeparture2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐDeparture(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NearbyTransitDeparture_distance(ctx context.Context, field graphql.CollectedField, obj *transit.NearbyDeparture) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "NearbyTransitDeparture",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Distance, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Place_id(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Place",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Place_level(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Place",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Level, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Place_type(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Place",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Place_position(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Place",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Position, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(location.Coordinates)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Place_timeZone(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Place",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Place().TimeZone(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*locgql.TimeZone)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOTimeZone2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐTimeZone(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Place_address(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Place",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Address, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(location.Address)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNAddress2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐAddress(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Place_shape(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Place",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Shape, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]location.Coordinates)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOCoordinates2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Productivity_records(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Productivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Records, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]productivity.Record)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityRecord2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Productivity_score(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Productivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Productivity().Score(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Productivity_scoreModel(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Productivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ScoreModel, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Productivity_activities(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Productivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Productivity_activities_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Productivity().Activities(rctx, obj, args["code"].(string), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]productivity.Activity)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityActivity2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐActivity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Productivity_topDistractions(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Productivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Productivity_topDistractions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Productivity().TopDistractions(rctx, obj, args["code"].(string), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]productivity.Activity)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityActivity2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐActivity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Productivity_goals(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Productivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Productivity_goals_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Productivity().Goals(rctx, obj, args["code"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]productivity.GoalProgress)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityGoalProgress2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoalProgress(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Productivity_focusSessions(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Productivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Productivity_focusSessions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Productivity().FocusSessions(rctx, obj, args["code"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]productivity.FocusSession)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityFocusSession2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐFocusSession(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityActivity_name(ctx context.Context, field graphql.CollectedField, obj *productivity.Activity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityActivity_group(ctx context.Context, field graphql.CollectedField, obj *productivity.Activity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Group, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityActivity_category(ctx context.Context, field graphql.CollectedField, obj *productivity.Activity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityActivity().Category(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*prodgql.Category)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityCategory2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐCategory(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityActivity_duration(ctx context.Context, field graphql.CollectedField, obj *productivity.Activity) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityActivity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityActivity().Duration(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityCategory_id(ctx context.Context, field graphql.CollectedField, obj *prodgql.Category) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityCategory",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityCategory_name(ctx context.Context, field graphql.CollectedField, obj *prodgql.Category) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityCategory",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityCategory_weight(ctx context.Context, field graphql.CollectedField, obj *prodgql.Category) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityCategory",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Weight, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityFocusSession_id(ctx context.Context, field graphql.CollectedField, obj *productivity.FocusSession) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityFocusSession",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityFocusSession_label(ctx context.Context, field graphql.CollectedField, obj *productivity.FocusSession) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityFocusSession",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Label, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityFocusSession_start(ctx context.Context, field graphql.CollectedField, obj *productivity.FocusSession) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityFocusSession",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Start, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityFocusSession_end(ctx context.Context, field graphql.CollectedField, obj *productivity.FocusSession) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityFocusSession",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.End, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityFocusSession_records(ctx context.Context, field graphql.CollectedField, obj *productivity.FocusSession) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityFocusSession",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Records, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]productivity.Record)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityRecord2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityFocusSession_score(ctx context.Context, field graphql.CollectedField, obj *productivity.FocusSession) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityFocusSession",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityFocusSession().Score(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoal_id(ctx context.Context, field graphql.CollectedField, obj *productivity.Goal) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoal",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoal_category(ctx context.Context, field graphql.CollectedField, obj *productivity.Goal) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoal",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityGoal().Category(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*prodgql.Category)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityCategory2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐCategory(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoal_kind(ctx context.Context, field graphql.CollectedField, obj *productivity.Goal) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoal",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityGoal().Kind(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoal_duration(ctx context.Context, field graphql.CollectedField, obj *productivity.Goal) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoal",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityGoal().Duration(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoal_weekdays(ctx context.Context, field graphql.CollectedField, obj *productivity.Goal) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoal",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityGoal().Weekdays(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoal_createdAt(ctx context.Context, field graphql.CollectedField, obj *productivity.Goal) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoal",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoalProgress_goal(ctx context.Context, field graphql.CollectedField, obj *productivity.GoalProgress) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoalProgress",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Goal, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(productivity.Goal)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityGoal2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoalProgress_date(ctx context.Context, field graphql.CollectedField, obj *productivity.GoalProgress) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoalProgress",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Date, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoalProgress_active(ctx context.Context, field graphql.CollectedField, obj *productivity.GoalProgress) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoalProgress",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Active, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoalProgress_duration(ctx context.Context, field graphql.CollectedField, obj *productivity.GoalProgress) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoalProgress",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityGoalProgress().Duration(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoalProgress_percent(ctx context.Context, field graphql.CollectedField, obj *productivity.GoalProgress) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoalProgress",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Percent, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoalProgress_met(ctx context.Context, field graphql.CollectedField, obj *productivity.GoalProgress) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoalProgress",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Met, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityGoalProgress_breached(ctx context.Context, field graphql.CollectedField, obj *productivity.GoalProgress) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityGoalProgress",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Breached, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityMutation_createGoal(ctx context.Context, field graphql.CollectedField, obj *prodgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_ProductivityMutation_createGoal_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreateGoal(ctx, args["goal"].(prodgql.GoalInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*productivity.Goal)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityGoal2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityMutation_updateGoal(ctx context.Context, field graphql.CollectedField, obj *prodgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_ProductivityMutation_updateGoal_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdateGoal(ctx, args["id"].(string), args["goal"].(prodgql.GoalInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*productivity.Goal)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityGoal2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityMutation_deleteGoal(ctx context.Context, field graphql.CollectedField, obj *prodgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_ProductivityMutation_deleteGoal_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeleteGoal(ctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*productivity.Goal)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityGoal2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoal(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityMutation_startFocusSession(ctx context.Context, field graphql.CollectedField, obj *prodgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_ProductivityMutation_startFocusSession_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartFocusSession(ctx, args["label"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*productivity.FocusSession)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityFocusSession2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐFocusSession(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityMutation_stopFocusSession(ctx context.Context, field graphql.CollectedField, obj *prodgql.Mutation) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityMutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StopFocusSession(ctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*productivity.FocusSession)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityFocusSession2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐFocusSession(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityRecord_category(ctx context.Context, field graphql.CollectedField, obj *productivity.Record) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityRecord",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityRecord().Category(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*prodgql.Category)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityCategory2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐCategory(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityRecord_duration(ctx context.Context, field graphql.CollectedField, obj *productivity.Record) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityRecord",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityRecord().Duration(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrend_from(ctx context.Context, field graphql.CollectedField, obj *productivity.Trend) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrend",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrend_to(ctx context.Context, field graphql.CollectedField, obj *productivity.Trend) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrend",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrend_resolution(ctx context.Context, field graphql.CollectedField, obj *productivity.Trend) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrend",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityTrend().Resolution(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrend_points(ctx context.Context, field graphql.CollectedField, obj *productivity.Trend) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrend",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Points, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]productivity.TrendPoint)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityTrendPoint2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐTrendPoint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrendPoint_start(ctx context.Context, field graphql.CollectedField, obj *productivity.TrendPoint) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrendPoint",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Start, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrendPoint_end(ctx context.Context, field graphql.CollectedField, obj *productivity.TrendPoint) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrendPoint",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.End, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrendPoint_records(ctx context.Context, field graphql.CollectedField, obj *productivity.TrendPoint) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrendPoint",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Records, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]productivity.Record)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityRecord2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrendPoint_score(ctx context.Context, field graphql.CollectedField, obj *productivity.TrendPoint) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrendPoint",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProductivityTrendPoint().Score(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrendPoint_movingAverage(ctx context.Context, field graphql.CollectedField, obj *productivity.TrendPoint) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrendPoint",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MovingAverage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProductivityTrendPoint_weekOverWeek(ctx context.Context, field graphql.CollectedField, obj *productivity.TrendPoint) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "ProductivityTrendPoint",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.WeekOverWeek, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_about(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_about_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().About(rctx, args["code"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(about.ContactInfo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNPartialAbout2goᚗstevenxieᚗmeᚋapiᚋv2ᚋaboutᚐContactInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_productivity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Productivity(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*productivity.Productivity)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivity2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐProductivity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_productivityTrend(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_productivityTrend_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProductivityTrend(rctx, args["from"].(time.Time), args["to"].(time.Time), args["resolution"].(*string), args["window"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*productivity.Trend)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNProductivityTrend2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐTrend(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_status(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_status_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Status(rctx, args["code"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*status.Status)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNStatus2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋstatusᚐStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_assist(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Assist(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*assistgql.Query)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNAssistQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋassistgqlᚐQuery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_git(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Git(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gitgql.Query)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNGitQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋgitgqlᚐQuery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_auth(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Auth(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*authgql.Query)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNAuthQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋauthᚋauthgqlᚐQuery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_music(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Music(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*musicgql.Query)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNMusicQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐQuery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_location(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Location(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*locgql.Query)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNLocationQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐQuery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_scheduling(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Scheduling(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*schedgql.Query)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNSchedulingQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐQuery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingBooking_id(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingBooking",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingBooking_start(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingBooking",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Start, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingBooking_end(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingBooking",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.End, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingBooking_title(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingBooking",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingBooking_name(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingBooking",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingBooking_email(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingBooking",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
@@ -3441,7 +14930,7 @@ func (ec *executionContext) _GitCommitAuthor_email(ctx context.Context, field gr
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GitCommitAuthor_login(ctx context.Context, field graphql.CollectedField, obj *git.CommitAuthor) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingBooking_notes(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3451,7 +14940,7 @@ func (ec *executionContext) _GitCommitAuthor_login(ctx context.Context, field gr
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "GitCommitAuthor",
+		Object:   "SchedulingBooking",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3460,7 +14949,7 @@ func (ec *executionContext) _GitCommitAuthor_login(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Login, nil
+		return obj.Notes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3475,7 +14964,7 @@ func (ec *executionContext) _GitCommitAuthor_login(ctx context.Context, field gr
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GitQuery_recentCommits(ctx context.Context, field graphql.CollectedField, obj *gitgql.Query) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingBooking_createdAt(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3485,23 +14974,90 @@ func (ec *executionContext) _GitQuery_recentCommits(ctx context.Context, field g
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "GitQuery",
+		Object:   "SchedulingBooking",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingBooking_ics(ctx context.Context, field graphql.CollectedField, obj *scheduling.Booking) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingBooking",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_GitQuery_recentCommits_args(ctx, rawArgs)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SchedulingBooking().Ics(rctx, obj)
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	rctx.Args = args
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingEvent_start(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingEvent",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RecentCommits(ctx, args["limit"].(*int))
+		return obj.Start, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3513,13 +15069,13 @@ func (ec *executionContext) _GitQuery_recentCommits(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]git.Commit)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNGitCommit2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GitRepo_name(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingEvent_end(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3529,7 +15085,7 @@ func (ec *executionContext) _GitRepo_name(ctx context.Context, field graphql.Col
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "GitRepo",
+		Object:   "SchedulingEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3538,7 +15094,7 @@ func (ec *executionContext) _GitRepo_name(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.End, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3550,13 +15106,13 @@ func (ec *executionContext) _GitRepo_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _GitRepo_url(ctx context.Context, field graphql.CollectedField, obj *git.Repo) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingEvent_allDay(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3566,7 +15122,7 @@ func (ec *executionContext) _GitRepo_url(ctx context.Context, field graphql.Coll
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "GitRepo",
+		Object:   "SchedulingEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3575,7 +15131,7 @@ func (ec *executionContext) _GitRepo_url(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.AllDay, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3587,13 +15143,13 @@ func (ec *executionContext) _GitRepo_url(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationHistorySegment_place(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingEvent_title(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3603,7 +15159,7 @@ func (ec *executionContext) _LocationHistorySegment_place(ctx context.Context, f
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationHistorySegment",
+		Object:   "SchedulingEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3612,7 +15168,143 @@ func (ec *executionContext) _LocationHistorySegment_place(ctx context.Context, f
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Place, nil
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingEvent_location(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingEvent",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingEvent_attendees(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingEvent",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attendees, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingEvent_conferenceURL(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingEvent",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConferenceURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingEvent_transparency(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingEvent",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SchedulingEvent().Transparency(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3630,7 +15322,44 @@ func (ec *executionContext) _LocationHistorySegment_place(ctx context.Context, f
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationHistorySegment_address(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingEvent_busy(ctx context.Context, field graphql.CollectedField, obj *scheduling.Event) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "SchedulingEvent",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Busy(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SchedulingQuery_busyTimes(ctx context.Context, field graphql.CollectedField, obj *schedgql.Query) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3640,31 +15369,41 @@ func (ec *executionContext) _LocationHistorySegment_address(ctx context.Context,
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationHistorySegment",
+		Object:   "SchedulingQuery",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_SchedulingQuery_busyTimes_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LocationHistorySegment().Address(rctx, obj)
+		return obj.BusyTimes(ctx, args["code"].(*string), args["date"].(*time.Time), args["from"].(*time.Time), args["to"].(*time.Time))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]scheduling.TimeSpan)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTimeSpan2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐTimeSpan(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationHistorySegment_description(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingQuery_events(ctx context.Context, field graphql.CollectedField, obj *schedgql.Query) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3674,16 +15413,23 @@ func (ec *executionContext) _LocationHistorySegment_description(ctx context.Cont
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationHistorySegment",
+		Object:   "SchedulingQuery",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_SchedulingQuery_events_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Events(ctx, args["code"].(*string), args["date"].(*time.Time))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3695,13 +15441,13 @@ func (ec *executionContext) _LocationHistorySegment_description(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]scheduling.Event)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSchedulingEvent2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐEvent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationHistorySegment_category(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingQuery_travelBlocks(ctx context.Context, field graphql.CollectedField, obj *schedgql.Query) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3711,16 +15457,23 @@ func (ec *executionContext) _LocationHistorySegment_category(ctx context.Context
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationHistorySegment",
+		Object:   "SchedulingQuery",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_SchedulingQuery_travelBlocks_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Category, nil
+		return obj.TravelBlocks(ctx, args["code"].(*string), args["date"].(*time.Time))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3732,13 +15485,13 @@ func (ec *executionContext) _LocationHistorySegment_category(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]scheduling.TravelBlock)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSchedulingTravelBlock2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐTravelBlock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationHistorySegment_distance(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingQuery_availability(ctx context.Context, field graphql.CollectedField, obj *schedgql.Query) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3748,31 +15501,41 @@ func (ec *executionContext) _LocationHistorySegment_distance(ctx context.Context
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationHistorySegment",
+		Object:   "SchedulingQuery",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_SchedulingQuery_availability_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LocationHistorySegment().Distance(rctx, obj)
+		return obj.Availability(ctx, args["from"].(time.Time), args["to"].(time.Time), args["duration"].(int), args["workingHours"].(*schedgql.WorkingHoursInput), args["buffer"].(*int), args["limit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.([]scheduling.Slot)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNSchedulingSlot2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐSlot(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationHistorySegment_timeSpan(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingSlot_start(ctx context.Context, field graphql.CollectedField, obj *scheduling.Slot) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3782,7 +15545,7 @@ func (ec *executionContext) _LocationHistorySegment_timeSpan(ctx context.Context
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationHistorySegment",
+		Object:   "SchedulingSlot",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3791,7 +15554,7 @@ func (ec *executionContext) _LocationHistorySegment_timeSpan(ctx context.Context
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TimeSpan, nil
+		return obj.Start, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3803,13 +15566,13 @@ func (ec *executionContext) _LocationHistorySegment_timeSpan(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(scheduling.TimeSpan)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTimeSpan2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐTimeSpan(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationHistorySegment_coordinates(ctx context.Context, field graphql.CollectedField, obj *location.HistorySegment) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingSlot_end(ctx context.Context, field graphql.CollectedField, obj *scheduling.Slot) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3819,7 +15582,7 @@ func (ec *executionContext) _LocationHistorySegment_coordinates(ctx context.Cont
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationHistorySegment",
+		Object:   "SchedulingSlot",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3828,7 +15591,7 @@ func (ec *executionContext) _LocationHistorySegment_coordinates(ctx context.Cont
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Coordinates, nil
+		return obj.End, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3840,13 +15603,13 @@ func (ec *executionContext) _LocationHistorySegment_coordinates(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]location.Coordinates)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNCoordinates2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationQuery_region(ctx context.Context, field graphql.CollectedField, obj *locgql.Query) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingSlot_score(ctx context.Context, field graphql.CollectedField, obj *scheduling.Slot) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3856,16 +15619,16 @@ func (ec *executionContext) _LocationQuery_region(ctx context.Context, field gra
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationQuery",
+		Object:   "SchedulingSlot",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Region(ctx)
+		return obj.Score, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3877,13 +15640,13 @@ func (ec *executionContext) _LocationQuery_region(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*location.Place)
+	res := resTmp.(float64)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNPlace2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐPlace(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LocationQuery_history(ctx context.Context, field graphql.CollectedField, obj *locgql.Query) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_start(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3893,23 +15656,16 @@ func (ec *executionContext) _LocationQuery_history(ctx context.Context, field gr
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "LocationQuery",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_LocationQuery_history_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.History(ctx, args["code"].(string), args["date"].(*time.Time))
+		return obj.Start, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3921,13 +15677,13 @@ func (ec *executionContext) _LocationQuery_history(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]location.HistorySegment)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLocationHistorySegment2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐHistorySegment(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MaskedAbout_name(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_end(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3937,7 +15693,7 @@ func (ec *executionContext) _MaskedAbout_name(ctx context.Context, field graphql
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MaskedAbout",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3946,7 +15702,7 @@ func (ec *executionContext) _MaskedAbout_name(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.End, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3958,13 +15714,13 @@ func (ec *executionContext) _MaskedAbout_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MaskedAbout_email(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_departure(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -3974,7 +15730,7 @@ func (ec *executionContext) _MaskedAbout_email(ctx context.Context, field graphq
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MaskedAbout",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3983,7 +15739,7 @@ func (ec *executionContext) _MaskedAbout_email(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
+		return obj.Departure, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3995,13 +15751,13 @@ func (ec *executionContext) _MaskedAbout_email(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MaskedAbout_type(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_duration(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4011,16 +15767,16 @@ func (ec *executionContext) _MaskedAbout_type(ctx context.Context, field graphql
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MaskedAbout",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.SchedulingTravelBlock().Duration(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4032,13 +15788,13 @@ func (ec *executionContext) _MaskedAbout_type(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MaskedAbout_approxAge(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_distance(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4048,7 +15804,7 @@ func (ec *executionContext) _MaskedAbout_approxAge(ctx context.Context, field gr
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MaskedAbout",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4057,7 +15813,7 @@ func (ec *executionContext) _MaskedAbout_approxAge(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ApproxAge, nil
+		return obj.Distance, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4069,13 +15825,13 @@ func (ec *executionContext) _MaskedAbout_approxAge(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MaskedAbout_iq(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_origin(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4085,7 +15841,7 @@ func (ec *executionContext) _MaskedAbout_iq(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MaskedAbout",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4094,25 +15850,22 @@ func (ec *executionContext) _MaskedAbout_iq(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IQ, nil
+		return obj.Origin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MaskedAbout_skills(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_destination(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4122,7 +15875,7 @@ func (ec *executionContext) _MaskedAbout_skills(ctx context.Context, field graph
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MaskedAbout",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4131,25 +15884,22 @@ func (ec *executionContext) _MaskedAbout_skills(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Skills, nil
+		return obj.Destination, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MaskedAbout_whereabouts(ctx context.Context, field graphql.CollectedField, obj *about.Masked) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_estimated(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4159,7 +15909,7 @@ func (ec *executionContext) _MaskedAbout_whereabouts(ctx context.Context, field
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MaskedAbout",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4168,7 +15918,7 @@ func (ec *executionContext) _MaskedAbout_whereabouts(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Whereabouts, nil
+		return obj.Estimated, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4180,13 +15930,13 @@ func (ec *executionContext) _MaskedAbout_whereabouts(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicAlbum_id(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_slack(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4196,16 +15946,16 @@ func (ec *executionContext) _MusicAlbum_id(ctx context.Context, field graphql.Co
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicAlbum",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.SchedulingTravelBlock().Slack(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4217,13 +15967,13 @@ func (ec *executionContext) _MusicAlbum_id(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicAlbum_uri(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_feasible(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4233,16 +15983,16 @@ func (ec *executionContext) _MusicAlbum_uri(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicAlbum",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URI, nil
+		return obj.Feasible(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4254,13 +16004,13 @@ func (ec *executionContext) _MusicAlbum_uri(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicAlbum_name(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+func (ec *executionContext) _SchedulingTravelBlock_warning(ctx context.Context, field graphql.CollectedField, obj *scheduling.TravelBlock) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4270,34 +16020,31 @@ func (ec *executionContext) _MusicAlbum_name(ctx context.Context, field graphql.
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicAlbum",
+		Object:   "SchedulingTravelBlock",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Warning(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicAlbum_externalURL(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_activity(ctx context.Context, field graphql.CollectedField, obj *status.Status) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4307,16 +16054,16 @@ func (ec *executionContext) _MusicAlbum_externalURL(ctx context.Context, field g
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicAlbum",
+		Object:   "Status",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExternalURL, nil
+		return ec.resolvers.Status().Activity(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4334,7 +16081,7 @@ func (ec *executionContext) _MusicAlbum_externalURL(ctx context.Context, field g
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicAlbum_images(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_confidence(ctx context.Context, field graphql.CollectedField, obj *status.Status) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4344,7 +16091,7 @@ func (ec *executionContext) _MusicAlbum_images(ctx context.Context, field graphq
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicAlbum",
+		Object:   "Status",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4353,7 +16100,7 @@ func (ec *executionContext) _MusicAlbum_images(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Images, nil
+		return obj.Confidence, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4365,13 +16112,13 @@ func (ec *executionContext) _MusicAlbum_images(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]music.Image)
+	res := resTmp.(float64)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNMusicImage2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐImage(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicAlbum_artists(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_detail(ctx context.Context, field graphql.CollectedField, obj *status.Status) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4381,7 +16128,7 @@ func (ec *executionContext) _MusicAlbum_artists(ctx context.Context, field graph
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicAlbum",
+		Object:   "Status",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4390,25 +16137,22 @@ func (ec *executionContext) _MusicAlbum_artists(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Artists, nil
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]music.Artist)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNMusicArtist2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicAlbum_tracks(ctx context.Context, field graphql.CollectedField, obj *music.Album) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_since(ctx context.Context, field graphql.CollectedField, obj *status.Status) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4418,23 +16162,16 @@ func (ec *executionContext) _MusicAlbum_tracks(ctx context.Context, field graphq
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicAlbum",
+		Object:   "Status",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_MusicAlbum_tracks_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MusicAlbum().Tracks(rctx, obj, args["limit"].(*int), args["offset"].(*int))
+		return obj.Since, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4443,13 +16180,13 @@ func (ec *executionContext) _MusicAlbum_tracks(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]music.Track)
+	res := resTmp.(*time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOMusicTrack2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicArtist_id(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_until(ctx context.Context, field graphql.CollectedField, obj *status.Status) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4459,7 +16196,7 @@ func (ec *executionContext) _MusicArtist_id(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicArtist",
+		Object:   "Status",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4468,25 +16205,22 @@ func (ec *executionContext) _MusicArtist_id(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Until, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicArtist_uri(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+func (ec *executionContext) _Status_timestamp(ctx context.Context, field graphql.CollectedField, obj *status.Status) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4496,7 +16230,7 @@ func (ec *executionContext) _MusicArtist_uri(ctx context.Context, field graphql.
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicArtist",
+		Object:   "Status",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4505,7 +16239,7 @@ func (ec *executionContext) _MusicArtist_uri(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URI, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4517,242 +16251,304 @@ func (ec *executionContext) _MusicArtist_uri(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicArtist_name(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_music(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicArtist",
+		Object:   "Subscription",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Subscription().Music(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *music.CurrentlyPlaying)
+		if !ok {
+			return nil
 		}
-		return graphql.Null
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalOCurrentlyPlayingMusic2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐCurrentlyPlaying(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicArtist_externalURL(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_musicLyricLine(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicArtist",
+		Object:   "Subscription",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExternalURL, nil
+		return ec.resolvers.Subscription().MusicLyricLine(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *music.LyricLine)
+		if !ok {
+			return nil
 		}
-		return graphql.Null
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalOMusicLyricLine2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyricLine(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicArtist_albums(ctx context.Context, field graphql.CollectedField, obj *music.Artist) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_musicRequests(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicArtist",
+		Object:   "Subscription",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_MusicArtist_albums_args(ctx, rawArgs)
+	args, err := ec.field_Subscription_musicRequests_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MusicArtist().Albums(rctx, obj, args["limit"].(*int), args["offset"].(*int))
+		return ec.resolvers.Subscription().MusicRequests(rctx, args["code"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan []music.Request)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNMusicRequest2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.([]music.Album)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNMusicAlbum2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicImage_height(ctx context.Context, field graphql.CollectedField, obj *music.Image) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_gitCommits(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicImage",
+		Object:   "Subscription",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return ec.resolvers.Subscription().GitCommits(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *git.Commit)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNGitCommit2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(int)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicImage_width(ctx context.Context, field graphql.CollectedField, obj *music.Image) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_productivityGoalBreached(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicImage",
+		Object:   "Subscription",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_productivityGoalBreached_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Width, nil
+		return ec.resolvers.Subscription().ProductivityGoalBreached(rctx, args["code"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *productivity.GoalProgress)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNProductivityGoalProgress2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoalProgress(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(int)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicImage_url(ctx context.Context, field graphql.CollectedField, obj *music.Image) (ret graphql.Marshaler) {
+func (ec *executionContext) _Subscription_status(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicImage",
+		Object:   "Subscription",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_status_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return ec.resolvers.Subscription().Status(rctx, args["code"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *status.Status)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNStatus2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋstatusᚐStatus(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicMutation_play(ctx context.Context, field graphql.CollectedField, obj *musicgql.Mutation) (ret graphql.Marshaler) {
+func (ec *executionContext) _TimeSpan_start(ctx context.Context, field graphql.CollectedField, obj *scheduling.TimeSpan) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4762,23 +16558,16 @@ func (ec *executionContext) _MusicMutation_play(ctx context.Context, field graph
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicMutation",
+		Object:   "TimeSpan",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_MusicMutation_play_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Play(ctx, args["resource"].(*music.Selector))
+		return obj.Start, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4790,13 +16579,13 @@ func (ec *executionContext) _MusicMutation_play(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicMutation_pause(ctx context.Context, field graphql.CollectedField, obj *musicgql.Mutation) (ret graphql.Marshaler) {
+func (ec *executionContext) _TimeSpan_end(ctx context.Context, field graphql.CollectedField, obj *scheduling.TimeSpan) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4806,16 +16595,16 @@ func (ec *executionContext) _MusicMutation_pause(ctx context.Context, field grap
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicMutation",
+		Object:   "TimeSpan",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pause(ctx)
+		return obj.End, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4827,13 +16616,13 @@ func (ec *executionContext) _MusicMutation_pause(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicQuery_current(ctx context.Context, field graphql.CollectedField, obj *musicgql.Query) (ret graphql.Marshaler) {
+func (ec *executionContext) _TimeZone_name(ctx context.Context, field graphql.CollectedField, obj *locgql.TimeZone) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4843,31 +16632,34 @@ func (ec *executionContext) _MusicQuery_current(ctx context.Context, field graph
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicQuery",
+		Object:   "TimeZone",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Current(ctx)
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*music.CurrentlyPlaying)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOCurrentlyPlayingMusic2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐCurrentlyPlaying(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicTrack_id(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+func (ec *executionContext) _TimeZone_offset(ctx context.Context, field graphql.CollectedField, obj *locgql.TimeZone) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4877,7 +16669,7 @@ func (ec *executionContext) _MusicTrack_id(ctx context.Context, field graphql.Co
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicTrack",
+		Object:   "TimeZone",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4886,7 +16678,7 @@ func (ec *executionContext) _MusicTrack_id(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Offset, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4898,13 +16690,13 @@ func (ec *executionContext) _MusicTrack_id(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicTrack_uri(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitDeparture_times(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4914,7 +16706,7 @@ func (ec *executionContext) _MusicTrack_uri(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicTrack",
+		Object:   "TransitDeparture",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4923,7 +16715,7 @@ func (ec *executionContext) _MusicTrack_uri(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URI, nil
+		return obj.Times, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4935,13 +16727,13 @@ func (ec *executionContext) _MusicTrack_uri(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]time.Time)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2ᚕtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicTrack_name(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitDeparture_transport(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4951,7 +16743,7 @@ func (ec *executionContext) _MusicTrack_name(ctx context.Context, field graphql.
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicTrack",
+		Object:   "TransitDeparture",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4960,7 +16752,7 @@ func (ec *executionContext) _MusicTrack_name(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Transport, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4972,13 +16764,13 @@ func (ec *executionContext) _MusicTrack_name(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*transit.Transport)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTransport2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐTransport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicTrack_externalURL(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitDeparture_station(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4988,7 +16780,7 @@ func (ec *executionContext) _MusicTrack_externalURL(ctx context.Context, field g
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicTrack",
+		Object:   "TransitDeparture",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4997,7 +16789,7 @@ func (ec *executionContext) _MusicTrack_externalURL(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExternalURL, nil
+		return obj.Station, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5009,13 +16801,13 @@ func (ec *executionContext) _MusicTrack_externalURL(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*transit.Station)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTransitStation2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐStation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicTrack_artists(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitDeparture_relativeTimes(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5025,16 +16817,16 @@ func (ec *executionContext) _MusicTrack_artists(ctx context.Context, field graph
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicTrack",
+		Object:   "TransitDeparture",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Artists, nil
+		return ec.resolvers.TransitDeparture().RelativeTimes(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5046,13 +16838,13 @@ func (ec *executionContext) _MusicTrack_artists(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]music.Artist)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNMusicArtist2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicTrack_album(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitDeparture_realtime(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5062,16 +16854,16 @@ func (ec *executionContext) _MusicTrack_album(ctx context.Context, field graphql
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicTrack",
+		Object:   "TransitDeparture",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MusicTrack().Album(rctx, obj)
+		return obj.Realtime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5083,13 +16875,13 @@ func (ec *executionContext) _MusicTrack_album(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*music.Album)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNMusicAlbum2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MusicTrack_duration(ctx context.Context, field graphql.CollectedField, obj *music.Track) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitOperator_code(ctx context.Context, field graphql.CollectedField, obj *transit.Operator) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5099,16 +16891,16 @@ func (ec *executionContext) _MusicTrack_duration(ctx context.Context, field grap
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "MusicTrack",
+		Object:   "TransitOperator",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MusicTrack().Duration(rctx, obj)
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5120,13 +16912,13 @@ func (ec *executionContext) _MusicTrack_duration(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_music(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitOperator_name(ctx context.Context, field graphql.CollectedField, obj *transit.Operator) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5136,23 +16928,16 @@ func (ec *executionContext) _Mutation_music(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Mutation",
+		Object:   "TransitOperator",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_music_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Music(rctx, args["code"].(string))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5164,13 +16949,13 @@ func (ec *executionContext) _Mutation_music(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*musicgql.Mutation)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNMusicMutation2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐMutation(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NearbyTransitDeparture_departure(ctx context.Context, field graphql.CollectedField, obj *transit.NearbyDeparture) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitQuery_findDepartures(ctx context.Context, field graphql.CollectedField, obj *transgql.Query) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5180,16 +16965,23 @@ func (ec *executionContext) _NearbyTransitDeparture_departure(ctx context.Contex
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "NearbyTransitDeparture",
+		Object:   "TransitQuery",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_TransitQuery_findDepartures_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Departure, nil
+		return obj.FindDepartures(ctx, args["route"].(string), args["coords"].(locgql.CoordinatesInput), args["radius"].(*int), args["singleSet"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5201,13 +16993,13 @@ func (ec *executionContext) _NearbyTransitDeparture_departure(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(transit.Departure)
+	res := resTmp.([]transit.NearbyDeparture)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTransitDeparture2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐDeparture(ctx, field.Selections, res)
+	return ec.marshalNNearbyTransitDeparture2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐNearbyDeparture(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _NearbyTransitDeparture_distance(ctx context.Context, field graphql.CollectedField, obj *transit.NearbyDeparture) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitQuery_nearbyTransports(ctx context.Context, field graphql.CollectedField, obj *transgql.Query) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5217,16 +17009,23 @@ func (ec *executionContext) _NearbyTransitDeparture_distance(ctx context.Context
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "NearbyTransitDeparture",
+		Object:   "TransitQuery",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_TransitQuery_nearbyTransports_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Distance, nil
+		return obj.NearbyTransports(ctx, args["coords"].(locgql.CoordinatesInput), args["radius"].(*int), args["limit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5238,13 +17037,13 @@ func (ec *executionContext) _NearbyTransitDeparture_distance(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]transit.Transport)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTransport2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐTransport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_id(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitStation_id(ctx context.Context, field graphql.CollectedField, obj *transit.Station) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5254,7 +17053,7 @@ func (ec *executionContext) _Place_id(ctx context.Context, field graphql.Collect
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Place",
+		Object:   "TransitStation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5281,7 +17080,7 @@ func (ec *executionContext) _Place_id(ctx context.Context, field graphql.Collect
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_level(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitStation_name(ctx context.Context, field graphql.CollectedField, obj *transit.Station) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5291,7 +17090,7 @@ func (ec *executionContext) _Place_level(ctx context.Context, field graphql.Coll
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Place",
+		Object:   "TransitStation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5300,7 +17099,7 @@ func (ec *executionContext) _Place_level(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Level, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5318,7 +17117,7 @@ func (ec *executionContext) _Place_level(ctx context.Context, field graphql.Coll
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_type(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransitStation_coordinates(ctx context.Context, field graphql.CollectedField, obj *transit.Station) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5328,7 +17127,7 @@ func (ec *executionContext) _Place_type(ctx context.Context, field graphql.Colle
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Place",
+		Object:   "TransitStation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5337,7 +17136,7 @@ func (ec *executionContext) _Place_type(ctx context.Context, field graphql.Colle
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Coordinates, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5349,13 +17148,13 @@ func (ec *executionContext) _Place_type(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(location.Coordinates)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_position(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transport_route(ctx context.Context, field graphql.CollectedField, obj *transit.Transport) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5365,7 +17164,7 @@ func (ec *executionContext) _Place_position(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Place",
+		Object:   "Transport",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5374,7 +17173,7 @@ func (ec *executionContext) _Place_position(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Position, nil
+		return obj.Route, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5386,13 +17185,13 @@ func (ec *executionContext) _Place_position(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(location.Coordinates)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_timeZone(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transport_direction(ctx context.Context, field graphql.CollectedField, obj *transit.Transport) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5402,31 +17201,34 @@ func (ec *executionContext) _Place_timeZone(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Place",
+		Object:   "Transport",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Place().TimeZone(rctx, obj)
+		return obj.Direction, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*locgql.TimeZone)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOTimeZone2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐTimeZone(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_address(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transport_category(ctx context.Context, field graphql.CollectedField, obj *transit.Transport) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5436,7 +17238,7 @@ func (ec *executionContext) _Place_address(ctx context.Context, field graphql.Co
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Place",
+		Object:   "Transport",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5445,7 +17247,7 @@ func (ec *executionContext) _Place_address(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.Category, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5457,13 +17259,13 @@ func (ec *executionContext) _Place_address(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(location.Address)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNAddress2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐAddress(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Place_shape(ctx context.Context, field graphql.CollectedField, obj *location.Place) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transport_operator(ctx context.Context, field graphql.CollectedField, obj *transit.Transport) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5473,7 +17275,7 @@ func (ec *executionContext) _Place_shape(ctx context.Context, field graphql.Coll
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Place",
+		Object:   "Transport",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5482,22 +17284,25 @@ func (ec *executionContext) _Place_shape(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Shape, nil
+		return obj.Operator, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]location.Coordinates)
+	res := resTmp.(*transit.Operator)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOCoordinates2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
+	return ec.marshalNTransitOperator2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐOperator(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Productivity_records(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5507,7 +17312,7 @@ func (ec *executionContext) _Productivity_records(ctx context.Context, field gra
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Productivity",
+		Object:   "__Directive",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5516,7 +17321,7 @@ func (ec *executionContext) _Productivity_records(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Records, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5528,13 +17333,13 @@ func (ec *executionContext) _Productivity_records(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]productivity.Record)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNProductivityRecord2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Productivity_score(ctx context.Context, field graphql.CollectedField, obj *productivity.Productivity) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5544,16 +17349,16 @@ func (ec *executionContext) _Productivity_score(ctx context.Context, field graph
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Productivity",
+		Object:   "__Directive",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Productivity().Score(rctx, obj)
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5562,13 +17367,13 @@ func (ec *executionContext) _Productivity_score(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProductivityCategory_id(ctx context.Context, field graphql.CollectedField, obj *prodgql.Category) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5578,7 +17383,7 @@ func (ec *executionContext) _ProductivityCategory_id(ctx context.Context, field
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "ProductivityCategory",
+		Object:   "__Directive",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5587,7 +17392,7 @@ func (ec *executionContext) _ProductivityCategory_id(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Locations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5599,13 +17404,13 @@ func (ec *executionContext) _ProductivityCategory_id(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalN__DirectiveLocation2ᚕstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProductivityCategory_name(ctx context.Context, field graphql.CollectedField, obj *prodgql.Category) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5615,7 +17420,7 @@ func (ec *executionContext) _ProductivityCategory_name(ctx context.Context, fiel
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "ProductivityCategory",
+		Object:   "__Directive",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5624,7 +17429,7 @@ func (ec *executionContext) _ProductivityCategory_name(ctx context.Context, fiel
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Args, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5636,13 +17441,13 @@ func (ec *executionContext) _ProductivityCategory_name(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]introspection.InputValue)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProductivityCategory_weight(ctx context.Context, field graphql.CollectedField, obj *prodgql.Category) (ret graphql.Marshaler) {
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5652,7 +17457,7 @@ func (ec *executionContext) _ProductivityCategory_weight(ctx context.Context, fi
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "ProductivityCategory",
+		Object:   "__EnumValue",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5661,7 +17466,7 @@ func (ec *executionContext) _ProductivityCategory_weight(ctx context.Context, fi
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Weight, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5673,13 +17478,13 @@ func (ec *executionContext) _ProductivityCategory_weight(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProductivityRecord_category(ctx context.Context, field graphql.CollectedField, obj *productivity.Record) (ret graphql.Marshaler) {
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5689,34 +17494,31 @@ func (ec *executionContext) _ProductivityRecord_category(ctx context.Context, fi
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "ProductivityRecord",
+		Object:   "__EnumValue",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProductivityRecord().Category(rctx, obj)
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*prodgql.Category)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNProductivityCategory2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐCategory(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ProductivityRecord_duration(ctx context.Context, field graphql.CollectedField, obj *productivity.Record) (ret graphql.Marshaler) {
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5726,7 +17528,7 @@ func (ec *executionContext) _ProductivityRecord_duration(ctx context.Context, fi
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "ProductivityRecord",
+		Object:   "__EnumValue",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -5735,7 +17537,7 @@ func (ec *executionContext) _ProductivityRecord_duration(ctx context.Context, fi
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProductivityRecord().Duration(rctx, obj)
+		return obj.IsDeprecated(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5747,13 +17549,13 @@ func (ec *executionContext) _ProductivityRecord_duration(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_about(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5763,41 +17565,31 @@ func (ec *executionContext) _Query_about(ctx context.Context, field graphql.Coll
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_about_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
-	rctx.Args = args
+	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().About(rctx, args["code"].(*string))
+		return obj.DeprecationReason(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(about.ContactInfo)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNPartialAbout2goᚗstevenxieᚗmeᚋapiᚋv2ᚋaboutᚐContactInfo(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_productivity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5807,16 +17599,16 @@ func (ec *executionContext) _Query_productivity(ctx context.Context, field graph
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__Field",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Productivity(rctx)
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5828,13 +17620,13 @@ func (ec *executionContext) _Query_productivity(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*productivity.Productivity)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNProductivity2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐProductivity(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_assist(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5844,34 +17636,31 @@ func (ec *executionContext) _Query_assist(ctx context.Context, field graphql.Col
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__Field",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Assist(rctx)
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*assistgql.Query)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNAssistQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋassistgqlᚐQuery(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_git(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5881,16 +17670,16 @@ func (ec *executionContext) _Query_git(ctx context.Context, field graphql.Collec
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__Field",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Git(rctx)
+		return obj.Args, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5902,13 +17691,13 @@ func (ec *executionContext) _Query_git(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gitgql.Query)
+	res := resTmp.([]introspection.InputValue)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNGitQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋgitgqlᚐQuery(ctx, field.Selections, res)
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_auth(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5918,16 +17707,16 @@ func (ec *executionContext) _Query_auth(ctx context.Context, field graphql.Colle
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__Field",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Auth(rctx)
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5939,13 +17728,13 @@ func (ec *executionContext) _Query_auth(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*authgql.Query)
+	res := resTmp.(*introspection.Type)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNAuthQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋauthᚋauthgqlᚐQuery(ctx, field.Selections, res)
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_music(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5955,7 +17744,7 @@ func (ec *executionContext) _Query_music(ctx context.Context, field graphql.Coll
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__Field",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -5964,7 +17753,7 @@ func (ec *executionContext) _Query_music(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Music(rctx)
+		return obj.IsDeprecated(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5976,13 +17765,13 @@ func (ec *executionContext) _Query_music(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*musicgql.Query)
+	res := resTmp.(bool)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNMusicQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐQuery(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_location(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -5992,7 +17781,7 @@ func (ec *executionContext) _Query_location(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__Field",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -6001,25 +17790,22 @@ func (ec *executionContext) _Query_location(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Location(rctx)
+		return obj.DeprecationReason(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*locgql.Query)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNLocationQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐQuery(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_scheduling(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6029,16 +17815,16 @@ func (ec *executionContext) _Query_scheduling(ctx context.Context, field graphql
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__InputValue",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Scheduling(rctx)
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6050,13 +17836,13 @@ func (ec *executionContext) _Query_scheduling(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*schedgql.Query)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNSchedulingQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐQuery(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6066,23 +17852,16 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__InputValue",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6091,13 +17870,13 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6107,31 +17886,34 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Query",
+		Object:   "__InputValue",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*introspection.Type)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SchedulingQuery_busyTimes(ctx context.Context, field graphql.CollectedField, obj *schedgql.Query) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6141,51 +17923,41 @@ func (ec *executionContext) _SchedulingQuery_busyTimes(ctx context.Context, fiel
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "SchedulingQuery",
+		Object:   "__InputValue",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_SchedulingQuery_busyTimes_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BusyTimes(ctx, args["code"].(*string), args["date"].(*time.Time))
+		return obj.DefaultValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]scheduling.TimeSpan)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTimeSpan2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐTimeSpan(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Subscription_music(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "Subscription",
+		Object:   "__Schema",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -6194,31 +17966,25 @@ func (ec *executionContext) _Subscription_music(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().Music(rctx)
+		return obj.Types(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func() graphql.Marshaler {
-		res, ok := <-resTmp.(<-chan *music.CurrentlyPlaying)
-		if !ok {
-			return nil
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.WriterFunc(func(w io.Writer) {
-			w.Write([]byte{'{'})
-			graphql.MarshalString(field.Alias).MarshalGQL(w)
-			w.Write([]byte{':'})
-			ec.marshalOCurrentlyPlayingMusic2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐCurrentlyPlaying(ctx, field.Selections, res).MarshalGQL(w)
-			w.Write([]byte{'}'})
-		})
+		return graphql.Null
 	}
+	res := resTmp.([]introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TimeSpan_start(ctx context.Context, field graphql.CollectedField, obj *scheduling.TimeSpan) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6228,16 +17994,16 @@ func (ec *executionContext) _TimeSpan_start(ctx context.Context, field graphql.C
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TimeSpan",
+		Object:   "__Schema",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Start, nil
+		return obj.QueryType(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6249,13 +18015,13 @@ func (ec *executionContext) _TimeSpan_start(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*introspection.Type)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TimeSpan_end(ctx context.Context, field graphql.CollectedField, obj *scheduling.TimeSpan) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6265,34 +18031,31 @@ func (ec *executionContext) _TimeSpan_end(ctx context.Context, field graphql.Col
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TimeSpan",
+		Object:   "__Schema",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.End, nil
+		return obj.MutationType(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*introspection.Type)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TimeZone_name(ctx context.Context, field graphql.CollectedField, obj *locgql.TimeZone) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6302,34 +18065,31 @@ func (ec *executionContext) _TimeZone_name(ctx context.Context, field graphql.Co
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TimeZone",
+		Object:   "__Schema",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.SubscriptionType(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*introspection.Type)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TimeZone_offset(ctx context.Context, field graphql.CollectedField, obj *locgql.TimeZone) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6339,16 +18099,16 @@ func (ec *executionContext) _TimeZone_offset(ctx context.Context, field graphql.
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TimeZone",
+		Object:   "__Schema",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Offset, nil
+		return obj.Directives(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6360,13 +18120,13 @@ func (ec *executionContext) _TimeZone_offset(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]introspection.Directive)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitDeparture_times(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6376,16 +18136,16 @@ func (ec *executionContext) _TransitDeparture_times(ctx context.Context, field g
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitDeparture",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Times, nil
+		return obj.Kind(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6397,13 +18157,13 @@ func (ec *executionContext) _TransitDeparture_times(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]time.Time)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTime2ᚕtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitDeparture_transport(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6413,34 +18173,31 @@ func (ec *executionContext) _TransitDeparture_transport(ctx context.Context, fie
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitDeparture",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Transport, nil
+		return obj.Name(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*transit.Transport)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTransport2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐTransport(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitDeparture_station(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6450,34 +18207,31 @@ func (ec *executionContext) _TransitDeparture_station(ctx context.Context, field
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitDeparture",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Station, nil
+		return obj.Description(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*transit.Station)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTransitStation2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐStation(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitDeparture_relativeTimes(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6487,34 +18241,38 @@ func (ec *executionContext) _TransitDeparture_relativeTimes(ctx context.Context,
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitDeparture",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TransitDeparture().RelativeTimes(rctx, obj)
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]introspection.Field)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitDeparture_realtime(ctx context.Context, field graphql.CollectedField, obj *transit.Departure) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6524,34 +18282,31 @@ func (ec *executionContext) _TransitDeparture_realtime(ctx context.Context, fiel
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitDeparture",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Realtime, nil
+		return obj.Interfaces(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]introspection.Type)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitOperator_code(ctx context.Context, field graphql.CollectedField, obj *transit.Operator) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6561,34 +18316,31 @@ func (ec *executionContext) _TransitOperator_code(ctx context.Context, field gra
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitOperator",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.PossibleTypes(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]introspection.Type)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitOperator_name(ctx context.Context, field graphql.CollectedField, obj *transit.Operator) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6598,34 +18350,38 @@ func (ec *executionContext) _TransitOperator_name(ctx context.Context, field gra
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitOperator",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]introspection.EnumValue)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitQuery_findDepartures(ctx context.Context, field graphql.CollectedField, obj *transgql.Query) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6635,41 +18391,31 @@ func (ec *executionContext) _TransitQuery_findDepartures(ctx context.Context, fi
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitQuery",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_TransitQuery_findDepartures_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FindDepartures(ctx, args["route"].(string), args["coords"].(locgql.CoordinatesInput), args["radius"].(*int), args["singleSet"].(*bool))
+		return obj.InputFields(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]transit.NearbyDeparture)
+	res := resTmp.([]introspection.InputValue)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNNearbyTransitDeparture2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐNearbyDeparture(ctx, field.Selections, res)
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitQuery_nearbyTransports(ctx context.Context, field graphql.CollectedField, obj *transgql.Query) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
 		if r := recover(); r != nil {
@@ -6679,1598 +18425,3322 @@ func (ec *executionContext) _TransitQuery_nearbyTransports(ctx context.Context,
 		ec.Tracer.EndFieldExecution(ctx)
 	}()
 	rctx := &graphql.ResolverContext{
-		Object:   "TransitQuery",
+		Object:   "__Type",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_TransitQuery_nearbyTransports_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NearbyTransports(ctx, args["coords"].(locgql.CoordinatesInput), args["radius"].(*int), args["limit"].(*int))
+		return obj.OfType(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputCoordinatesInput(ctx context.Context, obj interface{}) (locgql.CoordinatesInput, error) {
+	var it locgql.CoordinatesInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "x":
+			var err error
+			it.X, err = ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "y":
+			var err error
+			it.Y, err = ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "z":
+			var err error
+			it.Z, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputMusicResource(ctx context.Context, obj interface{}) (music.Resource, error) {
+	var it music.Resource
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+			it.ID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputMusicSelector(ctx context.Context, obj interface{}) (music.Selector, error) {
+	var it music.Selector
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "uri":
+			var err error
+			it.URI, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "track":
+			var err error
+			it.Track, err = ec.unmarshalOMusicResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "album":
+			var err error
+			it.Album, err = ec.unmarshalOMusicResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "artist":
+			var err error
+			it.Artist, err = ec.unmarshalOMusicResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "playlist":
+			var err error
+			it.Playlist, err = ec.unmarshalOMusicResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputProductivityGoalInput(ctx context.Context, obj interface{}) (prodgql.GoalInput, error) {
+	var it prodgql.GoalInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "category":
+			var err error
+			it.Category, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "kind":
+			var err error
+			it.Kind, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "duration":
+			var err error
+			it.Duration, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "weekdays":
+			var err error
+			it.Weekdays, err = ec.unmarshalOString2ᚕstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]transit.Transport)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTransport2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐTransport(ctx, field.Selections, res)
+
+	return it, nil
 }
 
-func (ec *executionContext) _TransitStation_id(ctx context.Context, field graphql.CollectedField, obj *transit.Station) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "TransitStation",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) unmarshalInputSchedulingBookingInput(ctx context.Context, obj interface{}) (schedgql.BookingInput, error) {
+	var it schedgql.BookingInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "start":
+			var err error
+			it.Start, err = ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "duration":
+			var err error
+			it.Duration, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "title":
+			var err error
+			it.Title, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "email":
+			var err error
+			it.Email, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "notes":
+			var err error
+			it.Notes, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "idempotencyKey":
+			var err error
+			it.IdempotencyKey, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNID2string(ctx, field.Selections, res)
+
+	return it, nil
 }
 
-func (ec *executionContext) _TransitStation_name(ctx context.Context, field graphql.CollectedField, obj *transit.Station) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) unmarshalInputSchedulingWorkingHoursInput(ctx context.Context, obj interface{}) (schedgql.WorkingHoursInput, error) {
+	var it schedgql.WorkingHoursInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "start":
+			var err error
+			it.Start, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "end":
+			var err error
+			it.End, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "weekdays":
+			var err error
+			it.Weekdays, err = ec.unmarshalOString2ᚕstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "TransitStation",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _GitActivity(ctx context.Context, sel ast.SelectionSet, obj git.Activity) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
 		return graphql.Null
+	case *git.PullRequestActivity:
+		return ec._GitPullRequestActivity(ctx, sel, obj)
+	case *git.ReviewActivity:
+		return ec._GitReviewActivity(ctx, sel, obj)
+	case *git.IssueActivity:
+		return ec._GitIssueActivity(ctx, sel, obj)
+	case *git.ReleaseActivity:
+		return ec._GitReleaseActivity(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TransitStation_coordinates(ctx context.Context, field graphql.CollectedField, obj *transit.Station) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "TransitStation",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Coordinates, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+func (ec *executionContext) _PartialAbout(ctx context.Context, sel ast.SelectionSet, obj about.ContactInfo) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
 		return graphql.Null
+	case *about.About:
+		return ec._FullAbout(ctx, sel, obj)
+	case *about.Masked:
+		return ec._MaskedAbout(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var addressImplementors = []string{"Address"}
+
+func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *location.Address) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, addressImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Address")
+		case "label":
+			out.Values[i] = ec._Address_label(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "country":
+			out.Values[i] = ec._Address_country(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "state":
+			out.Values[i] = ec._Address_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "county":
+			out.Values[i] = ec._Address_county(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "city":
+			out.Values[i] = ec._Address_city(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "district":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Address_district(ctx, field, obj)
+				return res
+			})
+		case "postcode":
+			out.Values[i] = ec._Address_postcode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "street":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Address_street(ctx, field, obj)
+				return res
+			})
+		case "number":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Address_number(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(location.Coordinates)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _Transport_route(ctx context.Context, field graphql.CollectedField, obj *transit.Transport) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var assistQueryImplementors = []string{"AssistQuery"}
+
+func (ec *executionContext) _AssistQuery(ctx context.Context, sel ast.SelectionSet, obj *assistgql.Query) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, assistQueryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AssistQuery")
+		case "transit":
+			out.Values[i] = ec._AssistQuery_transit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Transport",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Route, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var authQueryImplementors = []string{"AuthQuery"}
+
+func (ec *executionContext) _AuthQuery(ctx context.Context, sel ast.SelectionSet, obj *authgql.Query) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, authQueryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuthQuery")
+		case "permissions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AuthQuery_permissions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _Transport_direction(ctx context.Context, field graphql.CollectedField, obj *transit.Transport) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var coordinatesImplementors = []string{"Coordinates"}
+
+func (ec *executionContext) _Coordinates(ctx context.Context, sel ast.SelectionSet, obj *location.Coordinates) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, coordinatesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Coordinates")
+		case "x":
+			out.Values[i] = ec._Coordinates_x(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "y":
+			out.Values[i] = ec._Coordinates_y(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "z":
+			out.Values[i] = ec._Coordinates_z(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Transport",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Direction, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var currentlyPlayingMusicImplementors = []string{"CurrentlyPlayingMusic"}
+
+func (ec *executionContext) _CurrentlyPlayingMusic(ctx context.Context, sel ast.SelectionSet, obj *music.CurrentlyPlaying) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, currentlyPlayingMusicImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CurrentlyPlayingMusic")
+		case "timestamp":
+			out.Values[i] = ec._CurrentlyPlayingMusic_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "track":
+			out.Values[i] = ec._CurrentlyPlayingMusic_track(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "playing":
+			out.Values[i] = ec._CurrentlyPlayingMusic_playing(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "progress":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CurrentlyPlayingMusic_progress(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _Transport_category(ctx context.Context, field graphql.CollectedField, obj *transit.Transport) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var fullAboutImplementors = []string{"FullAbout", "PartialAbout"}
+
+func (ec *executionContext) _FullAbout(ctx context.Context, sel ast.SelectionSet, obj *about.About) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, fullAboutImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FullAbout")
+		case "name":
+			out.Values[i] = ec._FullAbout_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "email":
+			out.Values[i] = ec._FullAbout_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "type":
+			out.Values[i] = ec._FullAbout_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "birthday":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FullAbout_birthday(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "age":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FullAbout_age(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "iq":
+			out.Values[i] = ec._FullAbout_iq(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "skills":
+			out.Values[i] = ec._FullAbout_skills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "location":
+			out.Values[i] = ec._FullAbout_location(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Transport",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Category, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _Transport_operator(ctx context.Context, field graphql.CollectedField, obj *transit.Transport) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitCommitImplementors = []string{"GitCommit"}
+
+func (ec *executionContext) _GitCommit(ctx context.Context, sel ast.SelectionSet, obj *git.Commit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitCommitImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitCommit")
+		case "sha":
+			out.Values[i] = ec._GitCommit_sha(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "author":
+			out.Values[i] = ec._GitCommit_author(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "committer":
+			out.Values[i] = ec._GitCommit_committer(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._GitCommit_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+			out.Values[i] = ec._GitCommit_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "repo":
+			out.Values[i] = ec._GitCommit_repo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "timestamp":
+			out.Values[i] = ec._GitCommit_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "parsedMessage":
+			out.Values[i] = ec._GitCommit_parsedMessage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "verification":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitCommit_verification(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "Transport",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Operator, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*transit.Operator)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNTransitOperator2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐOperator(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitCommitAuthorImplementors = []string{"GitCommitAuthor"}
+
+func (ec *executionContext) _GitCommitAuthor(ctx context.Context, sel ast.SelectionSet, obj *git.CommitAuthor) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitCommitAuthorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitCommitAuthor")
+		case "name":
+			out.Values[i] = ec._GitCommitAuthor_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "email":
+			out.Values[i] = ec._GitCommitAuthor_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "login":
+			out.Values[i] = ec._GitCommitAuthor_login(ctx, field, obj)
+		case "date":
+			out.Values[i] = ec._GitCommitAuthor_date(ctx, field, obj)
+		case "isBot":
+			out.Values[i] = ec._GitCommitAuthor_isBot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Directive",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitCommitMessageImplementors = []string{"GitCommitMessage"}
+
+func (ec *executionContext) _GitCommitMessage(ctx context.Context, sel ast.SelectionSet, obj *git.Message) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitCommitMessageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitCommitMessage")
+		case "subject":
+			out.Values[i] = ec._GitCommitMessage_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "body":
+			out.Values[i] = ec._GitCommitMessage_body(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+			out.Values[i] = ec._GitCommitMessage_type(ctx, field, obj)
+		case "scope":
+			out.Values[i] = ec._GitCommitMessage_scope(ctx, field, obj)
+		case "breaking":
+			out.Values[i] = ec._GitCommitMessage_breaking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "coAuthors":
+			out.Values[i] = ec._GitCommitMessage_coAuthors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "issueRefs":
+			out.Values[i] = ec._GitCommitMessage_issueRefs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isMerge":
+			out.Values[i] = ec._GitCommitMessage_isMerge(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Directive",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitCommitPageImplementors = []string{"GitCommitPage"}
+
+func (ec *executionContext) _GitCommitPage(ctx context.Context, sel ast.SelectionSet, obj *git.CommitPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitCommitPageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitCommitPage")
+		case "commits":
+			out.Values[i] = ec._GitCommitPage_commits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endCursor":
+			out.Values[i] = ec._GitCommitPage_endCursor(ctx, field, obj)
+		case "hasNextPage":
+			out.Values[i] = ec._GitCommitPage_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "byRepo":
+			out.Values[i] = ec._GitCommitPage_byRepo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Directive",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__DirectiveLocation2ᚕstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitCommitVerificationImplementors = []string{"GitCommitVerification"}
+
+func (ec *executionContext) _GitCommitVerification(ctx context.Context, sel ast.SelectionSet, obj *git.Verification) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitCommitVerificationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitCommitVerification")
+		case "signed":
+			out.Values[i] = ec._GitCommitVerification_signed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "verified":
+			out.Values[i] = ec._GitCommitVerification_verified(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "format":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitCommitVerification_format(ctx, field, obj)
+				return res
+			})
+		case "state":
+			out.Values[i] = ec._GitCommitVerification_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "signer":
+			out.Values[i] = ec._GitCommitVerification_signer(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Directive",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitContributionDayImplementors = []string{"GitContributionDay"}
+
+func (ec *executionContext) _GitContributionDay(ctx context.Context, sel ast.SelectionSet, obj *stats.Day) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitContributionDayImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitContributionDay")
+		case "date":
+			out.Values[i] = ec._GitContributionDay_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "count":
+			out.Values[i] = ec._GitContributionDay_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__EnumValue",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var gitContributionsImplementors = []string{"GitContributions"}
+
+func (ec *executionContext) _GitContributions(ctx context.Context, sel ast.SelectionSet, obj *stats.Contributions) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitContributionsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitContributions")
+		case "from":
+			out.Values[i] = ec._GitContributions_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "to":
+			out.Values[i] = ec._GitContributions_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "total":
+			out.Values[i] = ec._GitContributions_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "days":
+			out.Values[i] = ec._GitContributions_days(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "longestStreak":
+			out.Values[i] = ec._GitContributions_longestStreak(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "currentStreak":
+			out.Values[i] = ec._GitContributions_currentStreak(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "busiestWeekday":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitContributions_busiestWeekday(ctx, field, obj)
+				return res
+			})
+		case "busiestHour":
+			out.Values[i] = ec._GitContributions_busiestHour(ctx, field, obj)
+		case "languages":
+			out.Values[i] = ec._GitContributions_languages(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitIssueActivityImplementors = []string{"GitIssueActivity", "GitActivity"}
+
+func (ec *executionContext) _GitIssueActivity(ctx context.Context, sel ast.SelectionSet, obj *git.IssueActivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitIssueActivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitIssueActivity")
+		case "action":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitIssueActivity_action(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "number":
+			out.Values[i] = ec._GitIssueActivity_number(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "title":
+			out.Values[i] = ec._GitIssueActivity_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+			out.Values[i] = ec._GitIssueActivity_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "repo":
+			out.Values[i] = ec._GitIssueActivity_repo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "timestamp":
+			out.Values[i] = ec._GitIssueActivity_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__EnumValue",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitIssueRefImplementors = []string{"GitIssueRef"}
+
+func (ec *executionContext) _GitIssueRef(ctx context.Context, sel ast.SelectionSet, obj *git.IssueRef) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitIssueRefImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitIssueRef")
+		case "repo":
+			out.Values[i] = ec._GitIssueRef_repo(ctx, field, obj)
+		case "number":
+			out.Values[i] = ec._GitIssueRef_number(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "closes":
+			out.Values[i] = ec._GitIssueRef_closes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__EnumValue",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var gitLanguageCountImplementors = []string{"GitLanguageCount"}
+
+func (ec *executionContext) _GitLanguageCount(ctx context.Context, sel ast.SelectionSet, obj *stats.LanguageCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitLanguageCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitLanguageCount")
+		case "language":
+			out.Values[i] = ec._GitLanguageCount_language(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "count":
+			out.Values[i] = ec._GitLanguageCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(bool)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitPullRequestImplementors = []string{"GitPullRequest"}
+
+func (ec *executionContext) _GitPullRequest(ctx context.Context, sel ast.SelectionSet, obj *git.PullRequest) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitPullRequestImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitPullRequest")
+		case "number":
+			out.Values[i] = ec._GitPullRequest_number(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "title":
+			out.Values[i] = ec._GitPullRequest_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "url":
+			out.Values[i] = ec._GitPullRequest_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__EnumValue",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitPullRequestActivityImplementors = []string{"GitPullRequestActivity", "GitActivity"}
+
+func (ec *executionContext) _GitPullRequestActivity(ctx context.Context, sel ast.SelectionSet, obj *git.PullRequestActivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitPullRequestActivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitPullRequestActivity")
+		case "action":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitPullRequestActivity_action(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "pullRequest":
+			out.Values[i] = ec._GitPullRequestActivity_pullRequest(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "repo":
+			out.Values[i] = ec._GitPullRequestActivity_repo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "timestamp":
+			out.Values[i] = ec._GitPullRequestActivity_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var gitQueryImplementors = []string{"GitQuery"}
+
+func (ec *executionContext) _GitQuery(ctx context.Context, sel ast.SelectionSet, obj *gitgql.Query) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitQueryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitQuery")
+		case "recentCommits":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitQuery_recentCommits(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "commits":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitQuery_commits(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "contributions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitQuery_contributions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "activity":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitQuery_activity(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitReleaseActivityImplementors = []string{"GitReleaseActivity", "GitActivity"}
+
+func (ec *executionContext) _GitReleaseActivity(ctx context.Context, sel ast.SelectionSet, obj *git.ReleaseActivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitReleaseActivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitReleaseActivity")
+		case "tag":
+			out.Values[i] = ec._GitReleaseActivity_tag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._GitReleaseActivity_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "prerelease":
+			out.Values[i] = ec._GitReleaseActivity_prerelease(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "url":
+			out.Values[i] = ec._GitReleaseActivity_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "repo":
+			out.Values[i] = ec._GitReleaseActivity_repo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._GitReleaseActivity_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitRepoImplementors = []string{"GitRepo"}
+
+func (ec *executionContext) _GitRepo(ctx context.Context, sel ast.SelectionSet, obj *git.Repo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitRepoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitRepo")
+		case "name":
+			out.Values[i] = ec._GitRepo_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+			out.Values[i] = ec._GitRepo_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "description":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitRepo_description(ctx, field, obj)
+				return res
+			})
+		case "primaryLanguage":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitRepo_primaryLanguage(ctx, field, obj)
+				return res
+			})
+		case "languages":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitRepo_languages(ctx, field, obj)
+				return res
+			})
+		case "stars":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitRepo_stars(ctx, field, obj)
+				return res
+			})
+		case "topics":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitRepo_topics(ctx, field, obj)
+				return res
+			})
+		case "pushedAt":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitRepo_pushedAt(ctx, field, obj)
+				return res
+			})
+		case "isFork":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitRepo_isFork(ctx, field, obj)
+				return res
+			})
+		case "isArchived":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitRepo_isArchived(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var gitRepoCommitsImplementors = []string{"GitRepoCommits"}
+
+func (ec *executionContext) _GitRepoCommits(ctx context.Context, sel ast.SelectionSet, obj *git.RepoCommits) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitRepoCommitsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitRepoCommits")
+		case "repo":
+			out.Values[i] = ec._GitRepoCommits_repo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "commits":
+			out.Values[i] = ec._GitRepoCommits_commits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var gitRepoLanguageImplementors = []string{"GitRepoLanguage"}
+
+func (ec *executionContext) _GitRepoLanguage(ctx context.Context, sel ast.SelectionSet, obj *git.RepoLanguage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitRepoLanguageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitRepoLanguage")
+		case "name":
+			out.Values[i] = ec._GitRepoLanguage_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bytes":
+			out.Values[i] = ec._GitRepoLanguage_bytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var gitReviewActivityImplementors = []string{"GitReviewActivity", "GitActivity"}
+
+func (ec *executionContext) _GitReviewActivity(ctx context.Context, sel ast.SelectionSet, obj *git.ReviewActivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, gitReviewActivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GitReviewActivity")
+		case "state":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GitReviewActivity_state(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "url":
+			out.Values[i] = ec._GitReviewActivity_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "pullRequest":
+			out.Values[i] = ec._GitReviewActivity_pullRequest(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "repo":
+			out.Values[i] = ec._GitReviewActivity_repo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "timestamp":
+			out.Values[i] = ec._GitReviewActivity_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var locationHistorySegmentImplementors = []string{"LocationHistorySegment"}
+
+func (ec *executionContext) _LocationHistorySegment(ctx context.Context, sel ast.SelectionSet, obj *location.HistorySegment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, locationHistorySegmentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LocationHistorySegment")
+		case "place":
+			out.Values[i] = ec._LocationHistorySegment_place(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "address":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LocationHistorySegment_address(ctx, field, obj)
+				return res
+			})
+		case "description":
+			out.Values[i] = ec._LocationHistorySegment_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "category":
+			out.Values[i] = ec._LocationHistorySegment_category(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "distance":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LocationHistorySegment_distance(ctx, field, obj)
+				return res
+			})
+		case "timeSpan":
+			out.Values[i] = ec._LocationHistorySegment_timeSpan(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "coordinates":
+			out.Values[i] = ec._LocationHistorySegment_coordinates(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var locationQueryImplementors = []string{"LocationQuery"}
+
+func (ec *executionContext) _LocationQuery(ctx context.Context, sel ast.SelectionSet, obj *locgql.Query) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, locationQueryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LocationQuery")
+		case "region":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LocationQuery_region(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "history":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LocationQuery_history(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(bool)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var maskedAboutImplementors = []string{"MaskedAbout", "PartialAbout"}
+
+func (ec *executionContext) _MaskedAbout(ctx context.Context, sel ast.SelectionSet, obj *about.Masked) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, maskedAboutImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MaskedAbout")
+		case "name":
+			out.Values[i] = ec._MaskedAbout_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "email":
+			out.Values[i] = ec._MaskedAbout_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+			out.Values[i] = ec._MaskedAbout_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "approxAge":
+			out.Values[i] = ec._MaskedAbout_approxAge(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "iq":
+			out.Values[i] = ec._MaskedAbout_iq(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "skills":
+			out.Values[i] = ec._MaskedAbout_skills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "whereabouts":
+			out.Values[i] = ec._MaskedAbout_whereabouts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Field",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var musicAlbumImplementors = []string{"MusicAlbum"}
+
+func (ec *executionContext) _MusicAlbum(ctx context.Context, sel ast.SelectionSet, obj *music.Album) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicAlbumImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicAlbum")
+		case "id":
+			out.Values[i] = ec._MusicAlbum_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "uri":
+			out.Values[i] = ec._MusicAlbum_uri(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._MusicAlbum_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "externalURL":
+			out.Values[i] = ec._MusicAlbum_externalURL(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "images":
+			out.Values[i] = ec._MusicAlbum_images(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "artists":
+			out.Values[i] = ec._MusicAlbum_artists(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "tracks":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicAlbum_tracks(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__InputValue",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var musicArtistImplementors = []string{"MusicArtist"}
+
+func (ec *executionContext) _MusicArtist(ctx context.Context, sel ast.SelectionSet, obj *music.Artist) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicArtistImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicArtist")
+		case "id":
+			out.Values[i] = ec._MusicArtist_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "uri":
+			out.Values[i] = ec._MusicArtist_uri(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._MusicArtist_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "externalURL":
+			out.Values[i] = ec._MusicArtist_externalURL(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "albums":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicArtist_albums(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var musicImageImplementors = []string{"MusicImage"}
+
+func (ec *executionContext) _MusicImage(ctx context.Context, sel ast.SelectionSet, obj *music.Image) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicImageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicImage")
+		case "height":
+			out.Values[i] = ec._MusicImage_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "width":
+			out.Values[i] = ec._MusicImage_width(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "url":
+			out.Values[i] = ec._MusicImage_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__InputValue",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var musicLyricLineImplementors = []string{"MusicLyricLine"}
+
+func (ec *executionContext) _MusicLyricLine(ctx context.Context, sel ast.SelectionSet, obj *music.LyricLine) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicLyricLineImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicLyricLine")
+		case "time":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicLyricLine_time(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "text":
+			out.Values[i] = ec._MusicLyricLine_text(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__InputValue",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var musicLyricsImplementors = []string{"MusicLyrics"}
+
+func (ec *executionContext) _MusicLyrics(ctx context.Context, sel ast.SelectionSet, obj *music.Lyrics) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicLyricsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicLyrics")
+		case "text":
+			out.Values[i] = ec._MusicLyrics_text(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "synced":
+			out.Values[i] = ec._MusicLyrics_synced(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lines":
+			out.Values[i] = ec._MusicLyrics_lines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var musicMutationImplementors = []string{"MusicMutation"}
+
+func (ec *executionContext) _MusicMutation(ctx context.Context, sel ast.SelectionSet, obj *musicgql.Mutation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicMutationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicMutation")
+		case "play":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicMutation_play(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "pause":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicMutation_pause(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "approveRequest":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicMutation_approveRequest(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "rejectRequest":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicMutation_rejectRequest(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__InputValue",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var musicQueryImplementors = []string{"MusicQuery"}
+
+func (ec *executionContext) _MusicQuery(ctx context.Context, sel ast.SelectionSet, obj *musicgql.Query) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicQueryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicQuery")
+		case "current":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicQuery_current(ctx, field, obj)
+				return res
+			})
+		case "requests":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicQuery_requests(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var musicRequestImplementors = []string{"MusicRequest"}
+
+func (ec *executionContext) _MusicRequest(ctx context.Context, sel ast.SelectionSet, obj *music.Request) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicRequestImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicRequest")
+		case "id":
+			out.Values[i] = ec._MusicRequest_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "resource":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicRequest_resource(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "requester":
+			out.Values[i] = ec._MusicRequest_requester(ctx, field, obj)
+		case "track":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicRequest_track(ctx, field, obj)
+				return res
+			})
+		case "status":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicRequest_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "createdAt":
+			out.Values[i] = ec._MusicRequest_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "resolvedAt":
+			out.Values[i] = ec._MusicRequest_resolvedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var musicRequestedResourceImplementors = []string{"MusicRequestedResource"}
+
+func (ec *executionContext) _MusicRequestedResource(ctx context.Context, sel ast.SelectionSet, obj *musicgql.RequestedResource) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicRequestedResourceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicRequestedResource")
+		case "kind":
+			out.Values[i] = ec._MusicRequestedResource_kind(ctx, field, obj)
+		case "id":
+			out.Values[i] = ec._MusicRequestedResource_id(ctx, field, obj)
+		case "uri":
+			out.Values[i] = ec._MusicRequestedResource_uri(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var musicTrackImplementors = []string{"MusicTrack"}
+
+func (ec *executionContext) _MusicTrack(ctx context.Context, sel ast.SelectionSet, obj *music.Track) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, musicTrackImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MusicTrack")
+		case "id":
+			out.Values[i] = ec._MusicTrack_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "uri":
+			out.Values[i] = ec._MusicTrack_uri(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._MusicTrack_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "externalURL":
+			out.Values[i] = ec._MusicTrack_externalURL(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "artists":
+			out.Values[i] = ec._MusicTrack_artists(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "album":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicTrack_album(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "duration":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicTrack_duration(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "lyrics":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._MusicTrack_lyrics(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, mutationImplementors)
+
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Mutation",
 	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "music":
+			out.Values[i] = ec._Mutation_music(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "productivity":
+			out.Values[i] = ec._Mutation_productivity(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "requestMusic":
+			out.Values[i] = ec._Mutation_requestMusic(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bookMeeting":
+			out.Values[i] = ec._Mutation_bookMeeting(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var nearbyTransitDepartureImplementors = []string{"NearbyTransitDeparture"}
+
+func (ec *executionContext) _NearbyTransitDeparture(ctx context.Context, sel ast.SelectionSet, obj *transit.NearbyDeparture) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, nearbyTransitDepartureImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NearbyTransitDeparture")
+		case "departure":
+			out.Values[i] = ec._NearbyTransitDeparture_departure(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "distance":
+			out.Values[i] = ec._NearbyTransitDeparture_distance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Schema",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var placeImplementors = []string{"Place"}
+
+func (ec *executionContext) _Place(ctx context.Context, sel ast.SelectionSet, obj *location.Place) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, placeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Place")
+		case "id":
+			out.Values[i] = ec._Place_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "level":
+			out.Values[i] = ec._Place_level(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "type":
+			out.Values[i] = ec._Place_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "position":
+			out.Values[i] = ec._Place_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "timeZone":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Place_timeZone(ctx, field, obj)
+				return res
+			})
+		case "address":
+			out.Values[i] = ec._Place_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "shape":
+			out.Values[i] = ec._Place_shape(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var productivityImplementors = []string{"Productivity"}
+
+func (ec *executionContext) _Productivity(ctx context.Context, sel ast.SelectionSet, obj *productivity.Productivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Productivity")
+		case "records":
+			out.Values[i] = ec._Productivity_records(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "score":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Productivity_score(ctx, field, obj)
+				return res
+			})
+		case "scoreModel":
+			out.Values[i] = ec._Productivity_scoreModel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "activities":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Productivity_activities(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "topDistractions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Productivity_topDistractions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "goals":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Productivity_goals(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "focusSessions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Productivity_focusSessions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var productivityActivityImplementors = []string{"ProductivityActivity"}
+
+func (ec *executionContext) _ProductivityActivity(ctx context.Context, sel ast.SelectionSet, obj *productivity.Activity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityActivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityActivity")
+		case "name":
+			out.Values[i] = ec._ProductivityActivity_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "group":
+			out.Values[i] = ec._ProductivityActivity_group(ctx, field, obj)
+		case "category":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityActivity_category(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "duration":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityActivity_duration(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var productivityCategoryImplementors = []string{"ProductivityCategory"}
+
+func (ec *executionContext) _ProductivityCategory(ctx context.Context, sel ast.SelectionSet, obj *prodgql.Category) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityCategoryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityCategory")
+		case "id":
+			out.Values[i] = ec._ProductivityCategory_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._ProductivityCategory_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "weight":
+			out.Values[i] = ec._ProductivityCategory_weight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	rctx.Args = args
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var productivityFocusSessionImplementors = []string{"ProductivityFocusSession"}
+
+func (ec *executionContext) _ProductivityFocusSession(ctx context.Context, sel ast.SelectionSet, obj *productivity.FocusSession) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityFocusSessionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityFocusSession")
+		case "id":
+			out.Values[i] = ec._ProductivityFocusSession_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "label":
+			out.Values[i] = ec._ProductivityFocusSession_label(ctx, field, obj)
+		case "start":
+			out.Values[i] = ec._ProductivityFocusSession_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "end":
+			out.Values[i] = ec._ProductivityFocusSession_end(ctx, field, obj)
+		case "records":
+			out.Values[i] = ec._ProductivityFocusSession_records(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "score":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityFocusSession_score(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+var productivityGoalImplementors = []string{"ProductivityGoal"}
+
+func (ec *executionContext) _ProductivityGoal(ctx context.Context, sel ast.SelectionSet, obj *productivity.Goal) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityGoalImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityGoal")
+		case "id":
+			out.Values[i] = ec._ProductivityGoal_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "category":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityGoal_category(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "kind":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityGoal_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "duration":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityGoal_duration(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "weekdays":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityGoal_weekdays(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "createdAt":
+			out.Values[i] = ec._ProductivityGoal_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var productivityGoalProgressImplementors = []string{"ProductivityGoalProgress"}
+
+func (ec *executionContext) _ProductivityGoalProgress(ctx context.Context, sel ast.SelectionSet, obj *productivity.GoalProgress) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityGoalProgressImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityGoalProgress")
+		case "goal":
+			out.Values[i] = ec._ProductivityGoalProgress_goal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "date":
+			out.Values[i] = ec._ProductivityGoalProgress_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "active":
+			out.Values[i] = ec._ProductivityGoalProgress_active(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "duration":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityGoalProgress_duration(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "percent":
+			out.Values[i] = ec._ProductivityGoalProgress_percent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "met":
+			out.Values[i] = ec._ProductivityGoalProgress_met(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "breached":
+			out.Values[i] = ec._ProductivityGoalProgress_breached(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	rctx.Args = args
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	return out
+}
+
+var productivityMutationImplementors = []string{"ProductivityMutation"}
+
+func (ec *executionContext) _ProductivityMutation(ctx context.Context, sel ast.SelectionSet, obj *prodgql.Mutation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityMutationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityMutation")
+		case "createGoal":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityMutation_createGoal(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "updateGoal":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityMutation_updateGoal(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "deleteGoal":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityMutation_deleteGoal(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "startFocusSession":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityMutation_startFocusSession(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "stopFocusSession":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityMutation_stopFocusSession(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var productivityRecordImplementors = []string{"ProductivityRecord"}
+
+func (ec *executionContext) _ProductivityRecord(ctx context.Context, sel ast.SelectionSet, obj *productivity.Record) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityRecordImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityRecord")
+		case "category":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityRecord_category(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "duration":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityRecord_duration(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	ctx = ec.Tracer.StartFieldExecution(ctx, field)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var productivityTrendImplementors = []string{"ProductivityTrend"}
+
+func (ec *executionContext) _ProductivityTrend(ctx context.Context, sel ast.SelectionSet, obj *productivity.Trend) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityTrendImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityTrend")
+		case "from":
+			out.Values[i] = ec._ProductivityTrend_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "to":
+			out.Values[i] = ec._ProductivityTrend_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "resolution":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityTrend_resolution(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "points":
+			out.Values[i] = ec._ProductivityTrend_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		ec.Tracer.EndFieldExecution(ctx)
-	}()
-	rctx := &graphql.ResolverContext{
-		Object:   "__Type",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-	ctx = graphql.WithResolverContext(ctx, rctx)
-	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	rctx.Result = res
-	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return out
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
+var productivityTrendPointImplementors = []string{"ProductivityTrendPoint"}
 
-func (ec *executionContext) unmarshalInputCoordinatesInput(ctx context.Context, obj interface{}) (locgql.CoordinatesInput, error) {
-	var it locgql.CoordinatesInput
-	var asMap = obj.(map[string]interface{})
+func (ec *executionContext) _ProductivityTrendPoint(ctx context.Context, sel ast.SelectionSet, obj *productivity.TrendPoint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, productivityTrendPointImplementors)
 
-	for k, v := range asMap {
-		switch k {
-		case "x":
-			var err error
-			it.X, err = ec.unmarshalNFloat2float64(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductivityTrendPoint")
+		case "start":
+			out.Values[i] = ec._ProductivityTrendPoint_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "y":
-			var err error
-			it.Y, err = ec.unmarshalNFloat2float64(ctx, v)
-			if err != nil {
-				return it, err
+		case "end":
+			out.Values[i] = ec._ProductivityTrendPoint_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "z":
-			var err error
-			it.Z, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
-			if err != nil {
-				return it, err
+		case "records":
+			out.Values[i] = ec._ProductivityTrendPoint_records(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
+		case "score":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProductivityTrendPoint_score(ctx, field, obj)
+				return res
+			})
+		case "movingAverage":
+			out.Values[i] = ec._ProductivityTrendPoint_movingAverage(ctx, field, obj)
+		case "weekOverWeek":
+			out.Values[i] = ec._ProductivityTrendPoint_weekOverWeek(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) unmarshalInputMusicResource(ctx context.Context, obj interface{}) (music.Resource, error) {
-	var it music.Resource
-	var asMap = obj.(map[string]interface{})
+var queryImplementors = []string{"Query"}
 
-	for k, v := range asMap {
-		switch k {
-		case "id":
-			var err error
-			it.ID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, queryImplementors)
+
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "about":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_about(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "productivity":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_productivity(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "productivityTrend":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_productivityTrend(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "status":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_status(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "assist":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_assist(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "git":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_git(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "auth":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_auth(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "music":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_music(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "location":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_location(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "scheduling":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_scheduling(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) unmarshalInputMusicSelector(ctx context.Context, obj interface{}) (music.Selector, error) {
-	var it music.Selector
-	var asMap = obj.(map[string]interface{})
+var schedulingBookingImplementors = []string{"SchedulingBooking"}
 
-	for k, v := range asMap {
-		switch k {
-		case "uri":
-			var err error
-			it.URI, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _SchedulingBooking(ctx context.Context, sel ast.SelectionSet, obj *scheduling.Booking) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, schedulingBookingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SchedulingBooking")
+		case "id":
+			out.Values[i] = ec._SchedulingBooking_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "track":
-			var err error
-			it.Track, err = ec.unmarshalOMusicResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx, v)
-			if err != nil {
-				return it, err
+		case "start":
+			out.Values[i] = ec._SchedulingBooking_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "album":
-			var err error
-			it.Album, err = ec.unmarshalOMusicResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx, v)
-			if err != nil {
-				return it, err
+		case "end":
+			out.Values[i] = ec._SchedulingBooking_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "artist":
-			var err error
-			it.Artist, err = ec.unmarshalOMusicResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx, v)
-			if err != nil {
-				return it, err
+		case "title":
+			out.Values[i] = ec._SchedulingBooking_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "playlist":
-			var err error
-			it.Playlist, err = ec.unmarshalOMusicResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx, v)
-			if err != nil {
-				return it, err
+		case "name":
+			out.Values[i] = ec._SchedulingBooking_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
+		case "email":
+			out.Values[i] = ec._SchedulingBooking_email(ctx, field, obj)
+		case "notes":
+			out.Values[i] = ec._SchedulingBooking_notes(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._SchedulingBooking_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "ics":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SchedulingBooking_ics(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
-
-func (ec *executionContext) _PartialAbout(ctx context.Context, sel ast.SelectionSet, obj about.ContactInfo) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
-	case *about.About:
-		return ec._FullAbout(ctx, sel, obj)
-	case *about.Masked:
-		return ec._MaskedAbout(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	return out
 }
 
-// endregion ************************** interface.gotpl ***************************
-
-// region    **************************** object.gotpl ****************************
-
-var addressImplementors = []string{"Address"}
+var schedulingEventImplementors = []string{"SchedulingEvent"}
 
-func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *location.Address) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, addressImplementors)
+func (ec *executionContext) _SchedulingEvent(ctx context.Context, sel ast.SelectionSet, obj *scheduling.Event) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, schedulingEventImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Address")
-		case "label":
-			out.Values[i] = ec._Address_label(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "country":
-			out.Values[i] = ec._Address_country(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "state":
-			out.Values[i] = ec._Address_state(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SchedulingEvent")
+		case "start":
+			out.Values[i] = ec._SchedulingEvent_start(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "county":
-			out.Values[i] = ec._Address_county(ctx, field, obj)
+		case "end":
+			out.Values[i] = ec._SchedulingEvent_end(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "city":
-			out.Values[i] = ec._Address_city(ctx, field, obj)
+		case "allDay":
+			out.Values[i] = ec._SchedulingEvent_allDay(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "district":
+		case "title":
+			out.Values[i] = ec._SchedulingEvent_title(ctx, field, obj)
+		case "location":
+			out.Values[i] = ec._SchedulingEvent_location(ctx, field, obj)
+		case "attendees":
+			out.Values[i] = ec._SchedulingEvent_attendees(ctx, field, obj)
+		case "conferenceURL":
+			out.Values[i] = ec._SchedulingEvent_conferenceURL(ctx, field, obj)
+		case "transparency":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8278,15 +21748,40 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Address_district(ctx, field, obj)
+				res = ec._SchedulingEvent_transparency(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "postcode":
-			out.Values[i] = ec._Address_postcode(ctx, field, obj)
+		case "busy":
+			out.Values[i] = ec._SchedulingEvent_busy(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "street":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var schedulingQueryImplementors = []string{"SchedulingQuery"}
+
+func (ec *executionContext) _SchedulingQuery(ctx context.Context, sel ast.SelectionSet, obj *schedgql.Query) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, schedulingQueryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SchedulingQuery")
+		case "busyTimes":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8294,10 +21789,13 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Address_street(ctx, field, obj)
+				res = ec._SchedulingQuery_busyTimes(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "number":
+		case "events":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8305,7 +21803,38 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Address_number(ctx, field, obj)
+				res = ec._SchedulingQuery_events(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "travelBlocks":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SchedulingQuery_travelBlocks(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "availability":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SchedulingQuery_availability(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
 		default:
@@ -8319,22 +21848,118 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var assistQueryImplementors = []string{"AssistQuery"}
+var schedulingSlotImplementors = []string{"SchedulingSlot"}
 
-func (ec *executionContext) _AssistQuery(ctx context.Context, sel ast.SelectionSet, obj *assistgql.Query) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, assistQueryImplementors)
+func (ec *executionContext) _SchedulingSlot(ctx context.Context, sel ast.SelectionSet, obj *scheduling.Slot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, schedulingSlotImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AssistQuery")
-		case "transit":
-			out.Values[i] = ec._AssistQuery_transit(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SchedulingSlot")
+		case "start":
+			out.Values[i] = ec._SchedulingSlot_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "end":
+			out.Values[i] = ec._SchedulingSlot_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "score":
+			out.Values[i] = ec._SchedulingSlot_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var schedulingTravelBlockImplementors = []string{"SchedulingTravelBlock"}
+
+func (ec *executionContext) _SchedulingTravelBlock(ctx context.Context, sel ast.SelectionSet, obj *scheduling.TravelBlock) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, schedulingTravelBlockImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SchedulingTravelBlock")
+		case "start":
+			out.Values[i] = ec._SchedulingTravelBlock_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "end":
+			out.Values[i] = ec._SchedulingTravelBlock_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "departure":
+			out.Values[i] = ec._SchedulingTravelBlock_departure(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "duration":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SchedulingTravelBlock_duration(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "distance":
+			out.Values[i] = ec._SchedulingTravelBlock_distance(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "origin":
+			out.Values[i] = ec._SchedulingTravelBlock_origin(ctx, field, obj)
+		case "destination":
+			out.Values[i] = ec._SchedulingTravelBlock_destination(ctx, field, obj)
+		case "estimated":
+			out.Values[i] = ec._SchedulingTravelBlock_estimated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "slack":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SchedulingTravelBlock_slack(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "feasible":
+			out.Values[i] = ec._SchedulingTravelBlock_feasible(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
+		case "warning":
+			out.Values[i] = ec._SchedulingTravelBlock_warning(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8346,18 +21971,18 @@ func (ec *executionContext) _AssistQuery(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var authQueryImplementors = []string{"AuthQuery"}
+var statusImplementors = []string{"Status"}
 
-func (ec *executionContext) _AuthQuery(ctx context.Context, sel ast.SelectionSet, obj *authgql.Query) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, authQueryImplementors)
+func (ec *executionContext) _Status(ctx context.Context, sel ast.SelectionSet, obj *status.Status) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, statusImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AuthQuery")
-		case "permissions":
+			out.Values[i] = graphql.MarshalString("Status")
+		case "activity":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8365,12 +21990,28 @@ func (ec *executionContext) _AuthQuery(ctx context.Context, sel ast.SelectionSet
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AuthQuery_permissions(ctx, field, obj)
+				res = ec._Status_activity(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
+		case "confidence":
+			out.Values[i] = ec._Status_confidence(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "detail":
+			out.Values[i] = ec._Status_detail(ctx, field, obj)
+		case "since":
+			out.Values[i] = ec._Status_since(ctx, field, obj)
+		case "until":
+			out.Values[i] = ec._Status_until(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._Status_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8382,29 +22023,54 @@ func (ec *executionContext) _AuthQuery(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var coordinatesImplementors = []string{"Coordinates"}
+var subscriptionImplementors = []string{"Subscription"}
 
-func (ec *executionContext) _Coordinates(ctx context.Context, sel ast.SelectionSet, obj *location.Coordinates) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, coordinatesImplementors)
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, subscriptionImplementors)
+	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "music":
+		return ec._Subscription_music(ctx, fields[0])
+	case "musicLyricLine":
+		return ec._Subscription_musicLyricLine(ctx, fields[0])
+	case "musicRequests":
+		return ec._Subscription_musicRequests(ctx, fields[0])
+	case "gitCommits":
+		return ec._Subscription_gitCommits(ctx, fields[0])
+	case "productivityGoalBreached":
+		return ec._Subscription_productivityGoalBreached(ctx, fields[0])
+	case "status":
+		return ec._Subscription_status(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var timeSpanImplementors = []string{"TimeSpan"}
+
+func (ec *executionContext) _TimeSpan(ctx context.Context, sel ast.SelectionSet, obj *scheduling.TimeSpan) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, timeSpanImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Coordinates")
-		case "x":
-			out.Values[i] = ec._Coordinates_x(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "y":
-			out.Values[i] = ec._Coordinates_y(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TimeSpan")
+		case "start":
+			out.Values[i] = ec._TimeSpan_start(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "z":
-			out.Values[i] = ec._Coordinates_z(ctx, field, obj)
+		case "end":
+			out.Values[i] = ec._TimeSpan_end(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8419,46 +22085,27 @@ func (ec *executionContext) _Coordinates(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var currentlyPlayingMusicImplementors = []string{"CurrentlyPlayingMusic"}
+var timeZoneImplementors = []string{"TimeZone"}
 
-func (ec *executionContext) _CurrentlyPlayingMusic(ctx context.Context, sel ast.SelectionSet, obj *music.CurrentlyPlaying) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, currentlyPlayingMusicImplementors)
+func (ec *executionContext) _TimeZone(ctx context.Context, sel ast.SelectionSet, obj *locgql.TimeZone) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, timeZoneImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CurrentlyPlayingMusic")
-		case "timestamp":
-			out.Values[i] = ec._CurrentlyPlayingMusic_timestamp(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "track":
-			out.Values[i] = ec._CurrentlyPlayingMusic_track(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TimeZone")
+		case "name":
+			out.Values[i] = ec._TimeZone_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "playing":
-			out.Values[i] = ec._CurrentlyPlayingMusic_playing(ctx, field, obj)
+		case "offset":
+			out.Values[i] = ec._TimeZone_offset(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "progress":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._CurrentlyPlayingMusic_progress(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8470,47 +22117,33 @@ func (ec *executionContext) _CurrentlyPlayingMusic(ctx context.Context, sel ast.
 	return out
 }
 
-var fullAboutImplementors = []string{"FullAbout", "PartialAbout"}
+var transitDepartureImplementors = []string{"TransitDeparture"}
 
-func (ec *executionContext) _FullAbout(ctx context.Context, sel ast.SelectionSet, obj *about.About) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, fullAboutImplementors)
+func (ec *executionContext) _TransitDeparture(ctx context.Context, sel ast.SelectionSet, obj *transit.Departure) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, transitDepartureImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("FullAbout")
-		case "name":
-			out.Values[i] = ec._FullAbout_name(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TransitDeparture")
+		case "times":
+			out.Values[i] = ec._TransitDeparture_times(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "email":
-			out.Values[i] = ec._FullAbout_email(ctx, field, obj)
+		case "transport":
+			out.Values[i] = ec._TransitDeparture_transport(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "type":
-			out.Values[i] = ec._FullAbout_type(ctx, field, obj)
+		case "station":
+			out.Values[i] = ec._TransitDeparture_station(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "birthday":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._FullAbout_birthday(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "age":
+		case "relativeTimes":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8518,24 +22151,14 @@ func (ec *executionContext) _FullAbout(ctx context.Context, sel ast.SelectionSet
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._FullAbout_age(ctx, field, obj)
+				res = ec._TransitDeparture_relativeTimes(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "iq":
-			out.Values[i] = ec._FullAbout_iq(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "skills":
-			out.Values[i] = ec._FullAbout_skills(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "location":
-			out.Values[i] = ec._FullAbout_location(ctx, field, obj)
+		case "realtime":
+			out.Values[i] = ec._TransitDeparture_realtime(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
@@ -8550,46 +22173,24 @@ func (ec *executionContext) _FullAbout(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var gitCommitImplementors = []string{"GitCommit"}
+var transitOperatorImplementors = []string{"TransitOperator"}
 
-func (ec *executionContext) _GitCommit(ctx context.Context, sel ast.SelectionSet, obj *git.Commit) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, gitCommitImplementors)
+func (ec *executionContext) _TransitOperator(ctx context.Context, sel ast.SelectionSet, obj *transit.Operator) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, transitOperatorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GitCommit")
-		case "sha":
-			out.Values[i] = ec._GitCommit_sha(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "author":
-			out.Values[i] = ec._GitCommit_author(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "committer":
-			out.Values[i] = ec._GitCommit_committer(ctx, field, obj)
-		case "message":
-			out.Values[i] = ec._GitCommit_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "url":
-			out.Values[i] = ec._GitCommit_url(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "repo":
-			out.Values[i] = ec._GitCommit_repo(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TransitOperator")
+		case "code":
+			out.Values[i] = ec._TransitOperator_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "timestamp":
-			out.Values[i] = ec._GitCommit_timestamp(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._TransitOperator_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8604,48 +22205,32 @@ func (ec *executionContext) _GitCommit(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var gitCommitAuthorImplementors = []string{"GitCommitAuthor"}
-
-func (ec *executionContext) _GitCommitAuthor(ctx context.Context, sel ast.SelectionSet, obj *git.CommitAuthor) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, gitCommitAuthorImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("GitCommitAuthor")
-		case "date":
-			out.Values[i] = ec._GitCommitAuthor_date(ctx, field, obj)
-		case "name":
-			out.Values[i] = ec._GitCommitAuthor_name(ctx, field, obj)
-		case "email":
-			out.Values[i] = ec._GitCommitAuthor_email(ctx, field, obj)
-		case "login":
-			out.Values[i] = ec._GitCommitAuthor_login(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var gitQueryImplementors = []string{"GitQuery"}
+var transitQueryImplementors = []string{"TransitQuery"}
 
-func (ec *executionContext) _GitQuery(ctx context.Context, sel ast.SelectionSet, obj *gitgql.Query) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, gitQueryImplementors)
+func (ec *executionContext) _TransitQuery(ctx context.Context, sel ast.SelectionSet, obj *transgql.Query) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, transitQueryImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GitQuery")
-		case "recentCommits":
+			out.Values[i] = graphql.MarshalString("TransitQuery")
+		case "findDepartures":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TransitQuery_findDepartures(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "nearbyTransports":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8653,7 +22238,7 @@ func (ec *executionContext) _GitQuery(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._GitQuery_recentCommits(ctx, field, obj)
+				res = ec._TransitQuery_nearbyTransports(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -8670,24 +22255,29 @@ func (ec *executionContext) _GitQuery(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var gitRepoImplementors = []string{"GitRepo"}
+var transitStationImplementors = []string{"TransitStation"}
 
-func (ec *executionContext) _GitRepo(ctx context.Context, sel ast.SelectionSet, obj *git.Repo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, gitRepoImplementors)
+func (ec *executionContext) _TransitStation(ctx context.Context, sel ast.SelectionSet, obj *transit.Station) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, transitStationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GitRepo")
+			out.Values[i] = graphql.MarshalString("TransitStation")
+		case "id":
+			out.Values[i] = ec._TransitStation_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "name":
-			out.Values[i] = ec._GitRepo_name(ctx, field, obj)
+			out.Values[i] = ec._TransitStation_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "url":
-			out.Values[i] = ec._GitRepo_url(ctx, field, obj)
+		case "coordinates":
+			out.Values[i] = ec._TransitStation_coordinates(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8702,63 +22292,36 @@ func (ec *executionContext) _GitRepo(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var locationHistorySegmentImplementors = []string{"LocationHistorySegment"}
+var transportImplementors = []string{"Transport"}
 
-func (ec *executionContext) _LocationHistorySegment(ctx context.Context, sel ast.SelectionSet, obj *location.HistorySegment) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, locationHistorySegmentImplementors)
+func (ec *executionContext) _Transport(ctx context.Context, sel ast.SelectionSet, obj *transit.Transport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, transportImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LocationHistorySegment")
-		case "place":
-			out.Values[i] = ec._LocationHistorySegment_place(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Transport")
+		case "route":
+			out.Values[i] = ec._Transport_route(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "address":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._LocationHistorySegment_address(ctx, field, obj)
-				return res
-			})
-		case "description":
-			out.Values[i] = ec._LocationHistorySegment_description(ctx, field, obj)
+		case "direction":
+			out.Values[i] = ec._Transport_direction(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
 		case "category":
-			out.Values[i] = ec._LocationHistorySegment_category(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "distance":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._LocationHistorySegment_distance(ctx, field, obj)
-				return res
-			})
-		case "timeSpan":
-			out.Values[i] = ec._LocationHistorySegment_timeSpan(ctx, field, obj)
+			out.Values[i] = ec._Transport_category(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "coordinates":
-			out.Values[i] = ec._LocationHistorySegment_coordinates(ctx, field, obj)
+		case "operator":
+			out.Values[i] = ec._Transport_operator(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -8771,45 +22334,34 @@ func (ec *executionContext) _LocationHistorySegment(ctx context.Context, sel ast
 	return out
 }
 
-var locationQueryImplementors = []string{"LocationQuery"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _LocationQuery(ctx context.Context, sel ast.SelectionSet, obj *locgql.Query) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, locationQueryImplementors)
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LocationQuery")
-		case "region":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._LocationQuery_region(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "history":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._LocationQuery_history(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8821,52 +22373,31 @@ func (ec *executionContext) _LocationQuery(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var maskedAboutImplementors = []string{"MaskedAbout", "PartialAbout"}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-func (ec *executionContext) _MaskedAbout(ctx context.Context, sel ast.SelectionSet, obj *about.Masked) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, maskedAboutImplementors)
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, __EnumValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MaskedAbout")
+			out.Values[i] = graphql.MarshalString("__EnumValue")
 		case "name":
-			out.Values[i] = ec._MaskedAbout_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "email":
-			out.Values[i] = ec._MaskedAbout_email(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "type":
-			out.Values[i] = ec._MaskedAbout_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "approxAge":
-			out.Values[i] = ec._MaskedAbout_approxAge(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "iq":
-			out.Values[i] = ec._MaskedAbout_iq(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "skills":
-			out.Values[i] = ec._MaskedAbout_skills(ctx, field, obj)
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "whereabouts":
-			out.Values[i] = ec._MaskedAbout_whereabouts(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8878,58 +22409,41 @@ func (ec *executionContext) _MaskedAbout(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var musicAlbumImplementors = []string{"MusicAlbum"}
+var __FieldImplementors = []string{"__Field"}
 
-func (ec *executionContext) _MusicAlbum(ctx context.Context, sel ast.SelectionSet, obj *music.Album) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, musicAlbumImplementors)
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, __FieldImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MusicAlbum")
-		case "id":
-			out.Values[i] = ec._MusicAlbum_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "uri":
-			out.Values[i] = ec._MusicAlbum_uri(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
+			out.Values[i] = graphql.MarshalString("__Field")
 		case "name":
-			out.Values[i] = ec._MusicAlbum_name(ctx, field, obj)
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "externalURL":
-			out.Values[i] = ec._MusicAlbum_externalURL(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "images":
-			out.Values[i] = ec._MusicAlbum_images(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "artists":
-			out.Values[i] = ec._MusicAlbum_artists(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "tracks":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._MusicAlbum_tracks(ctx, field, obj)
-				return res
-			})
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8941,51 +22455,31 @@ func (ec *executionContext) _MusicAlbum(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var musicArtistImplementors = []string{"MusicArtist"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _MusicArtist(ctx context.Context, sel ast.SelectionSet, obj *music.Artist) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, musicArtistImplementors)
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MusicArtist")
-		case "id":
-			out.Values[i] = ec._MusicArtist_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "uri":
-			out.Values[i] = ec._MusicArtist_uri(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
+			out.Values[i] = graphql.MarshalString("__InputValue")
 		case "name":
-			out.Values[i] = ec._MusicArtist_name(ctx, field, obj)
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "externalURL":
-			out.Values[i] = ec._MusicArtist_externalURL(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "albums":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._MusicArtist_albums(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8997,29 +22491,33 @@ func (ec *executionContext) _MusicArtist(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var musicImageImplementors = []string{"MusicImage"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _MusicImage(ctx context.Context, sel ast.SelectionSet, obj *music.Image) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, musicImageImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MusicImage")
-		case "height":
-			out.Values[i] = ec._MusicImage_height(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "width":
-			out.Values[i] = ec._MusicImage_width(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "url":
-			out.Values[i] = ec._MusicImage_url(ctx, field, obj)
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -9034,45 +22532,38 @@ func (ec *executionContext) _MusicImage(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var musicMutationImplementors = []string{"MusicMutation"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _MusicMutation(ctx context.Context, sel ast.SelectionSet, obj *musicgql.Mutation) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, musicMutationImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MusicMutation")
-		case "play":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._MusicMutation_play(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "pause":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._MusicMutation_pause(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9084,1137 +22575,863 @@ func (ec *executionContext) _MusicMutation(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var musicQueryImplementors = []string{"MusicQuery"}
+// endregion **************************** object.gotpl ****************************
 
-func (ec *executionContext) _MusicQuery(ctx context.Context, sel ast.SelectionSet, obj *musicgql.Query) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, musicQueryImplementors)
+// region    ***************************** type.gotpl *****************************
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("MusicQuery")
-		case "current":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._MusicQuery_current(ctx, field, obj)
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAddress2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐAddress(ctx context.Context, sel ast.SelectionSet, v location.Address) graphql.Marshaler {
+	return ec._Address(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAssistQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋassistgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v assistgql.Query) graphql.Marshaler {
+	return ec._AssistQuery(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAssistQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋassistgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *assistgql.Query) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	return ec._AssistQuery(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAuthQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋauthᚋauthgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v authgql.Query) graphql.Marshaler {
+	return ec._AuthQuery(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAuthQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋauthᚋauthgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *authgql.Query) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._AuthQuery(ctx, sel, v)
 }
 
-var musicTrackImplementors = []string{"MusicTrack"}
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	return graphql.UnmarshalBoolean(v)
+}
 
-func (ec *executionContext) _MusicTrack(ctx context.Context, sel ast.SelectionSet, obj *music.Track) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, musicTrackImplementors)
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("MusicTrack")
-		case "id":
-			out.Values[i] = ec._MusicTrack_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "uri":
-			out.Values[i] = ec._MusicTrack_uri(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._MusicTrack_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "externalURL":
-			out.Values[i] = ec._MusicTrack_externalURL(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "artists":
-			out.Values[i] = ec._MusicTrack_artists(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "album":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._MusicTrack_album(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "duration":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._MusicTrack_duration(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx context.Context, sel ast.SelectionSet, v location.Coordinates) graphql.Marshaler {
+	return ec._Coordinates(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCoordinates2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx context.Context, sel ast.SelectionSet, v []location.Coordinates) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, mutationImplementors)
+func (ec *executionContext) unmarshalNCoordinatesInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐCoordinatesInput(ctx context.Context, v interface{}) (locgql.CoordinatesInput, error) {
+	return ec.unmarshalInputCoordinatesInput(ctx, v)
+}
 
-	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	return graphql.UnmarshalFloat(v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "music":
-			out.Values[i] = ec._Mutation_music(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	return res
+}
+
+func (ec *executionContext) marshalNGitActivity2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐActivity(ctx context.Context, sel ast.SelectionSet, v git.Activity) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._GitActivity(ctx, sel, v)
 }
 
-var nearbyTransitDepartureImplementors = []string{"NearbyTransitDeparture"}
-
-func (ec *executionContext) _NearbyTransitDeparture(ctx context.Context, sel ast.SelectionSet, obj *transit.NearbyDeparture) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, nearbyTransitDepartureImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("NearbyTransitDeparture")
-		case "departure":
-			out.Values[i] = ec._NearbyTransitDeparture_departure(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "distance":
-			out.Values[i] = ec._NearbyTransitDeparture_distance(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNGitActivity2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐActivity(ctx context.Context, sel ast.SelectionSet, v []git.Activity) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNGitActivity2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐActivity(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var placeImplementors = []string{"Place"}
-
-func (ec *executionContext) _Place(ctx context.Context, sel ast.SelectionSet, obj *location.Place) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, placeImplementors)
+func (ec *executionContext) marshalNGitCommit2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx context.Context, sel ast.SelectionSet, v git.Commit) graphql.Marshaler {
+	return ec._GitCommit(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Place")
-		case "id":
-			out.Values[i] = ec._Place_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "level":
-			out.Values[i] = ec._Place_level(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "type":
-			out.Values[i] = ec._Place_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "position":
-			out.Values[i] = ec._Place_position(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "timeZone":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Place_timeZone(ctx, field, obj)
-				return res
-			})
-		case "address":
-			out.Values[i] = ec._Place_address(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNGitCommit2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx context.Context, sel ast.SelectionSet, v []git.Commit) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "shape":
-			out.Values[i] = ec._Place_shape(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNGitCommit2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNGitCommit2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx context.Context, sel ast.SelectionSet, v *git.Commit) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._GitCommit(ctx, sel, v)
 }
 
-var productivityImplementors = []string{"Productivity"}
-
-func (ec *executionContext) _Productivity(ctx context.Context, sel ast.SelectionSet, obj *productivity.Productivity) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, productivityImplementors)
+func (ec *executionContext) marshalNGitCommitAuthor2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx context.Context, sel ast.SelectionSet, v git.CommitAuthor) graphql.Marshaler {
+	return ec._GitCommitAuthor(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Productivity")
-		case "records":
-			out.Values[i] = ec._Productivity_records(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "score":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Productivity_score(ctx, field, obj)
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNGitCommitAuthor2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx context.Context, sel ast.SelectionSet, v []git.CommitAuthor) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNGitCommitAuthor2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var productivityCategoryImplementors = []string{"ProductivityCategory"}
-
-func (ec *executionContext) _ProductivityCategory(ctx context.Context, sel ast.SelectionSet, obj *prodgql.Category) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, productivityCategoryImplementors)
+func (ec *executionContext) marshalNGitCommitMessage2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐMessage(ctx context.Context, sel ast.SelectionSet, v git.Message) graphql.Marshaler {
+	return ec._GitCommitMessage(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ProductivityCategory")
-		case "id":
-			out.Values[i] = ec._ProductivityCategory_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._ProductivityCategory_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "weight":
-			out.Values[i] = ec._ProductivityCategory_weight(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNGitCommitMessage2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐMessage(ctx context.Context, sel ast.SelectionSet, v *git.Message) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._GitCommitMessage(ctx, sel, v)
 }
 
-var productivityRecordImplementors = []string{"ProductivityRecord"}
-
-func (ec *executionContext) _ProductivityRecord(ctx context.Context, sel ast.SelectionSet, obj *productivity.Record) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, productivityRecordImplementors)
+func (ec *executionContext) marshalNGitCommitPage2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitPage(ctx context.Context, sel ast.SelectionSet, v git.CommitPage) graphql.Marshaler {
+	return ec._GitCommitPage(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ProductivityRecord")
-		case "category":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._ProductivityRecord_category(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "duration":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._ProductivityRecord_duration(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNGitCommitPage2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitPage(ctx context.Context, sel ast.SelectionSet, v *git.CommitPage) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._GitCommitPage(ctx, sel, v)
 }
 
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, queryImplementors)
-
-	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
-		Object: "Query",
-	})
+func (ec *executionContext) marshalNGitContributionDay2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐDay(ctx context.Context, sel ast.SelectionSet, v stats.Day) graphql.Marshaler {
+	return ec._GitContributionDay(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "about":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_about(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "productivity":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_productivity(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "assist":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_assist(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "git":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_git(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "auth":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_auth(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "music":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_music(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "location":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_location(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "scheduling":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_scheduling(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNGitContributionDay2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐDay(ctx context.Context, sel ast.SelectionSet, v []stats.Day) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNGitContributionDay2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐDay(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var schedulingQueryImplementors = []string{"SchedulingQuery"}
-
-func (ec *executionContext) _SchedulingQuery(ctx context.Context, sel ast.SelectionSet, obj *schedgql.Query) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, schedulingQueryImplementors)
+func (ec *executionContext) marshalNGitContributions2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐContributions(ctx context.Context, sel ast.SelectionSet, v stats.Contributions) graphql.Marshaler {
+	return ec._GitContributions(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("SchedulingQuery")
-		case "busyTimes":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._SchedulingQuery_busyTimes(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNGitContributions2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐContributions(ctx context.Context, sel ast.SelectionSet, v *stats.Contributions) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._GitContributions(ctx, sel, v)
 }
 
-var subscriptionImplementors = []string{"Subscription"}
+func (ec *executionContext) marshalNGitIssueRef2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐIssueRef(ctx context.Context, sel ast.SelectionSet, v git.IssueRef) graphql.Marshaler {
+	return ec._GitIssueRef(ctx, sel, &v)
+}
 
-func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, subscriptionImplementors)
-	ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
-		Object: "Subscription",
-	})
-	if len(fields) != 1 {
-		ec.Errorf(ctx, "must subscribe to exactly one stream")
-		return nil
+func (ec *executionContext) marshalNGitIssueRef2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐIssueRef(ctx context.Context, sel ast.SelectionSet, v []git.IssueRef) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNGitIssueRef2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐIssueRef(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	switch fields[0].Name {
-	case "music":
-		return ec._Subscription_music(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
+	wg.Wait()
+	return ret
 }
 
-var timeSpanImplementors = []string{"TimeSpan"}
-
-func (ec *executionContext) _TimeSpan(ctx context.Context, sel ast.SelectionSet, obj *scheduling.TimeSpan) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, timeSpanImplementors)
+func (ec *executionContext) marshalNGitLanguageCount2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐLanguageCount(ctx context.Context, sel ast.SelectionSet, v stats.LanguageCount) graphql.Marshaler {
+	return ec._GitLanguageCount(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TimeSpan")
-		case "start":
-			out.Values[i] = ec._TimeSpan_start(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "end":
-			out.Values[i] = ec._TimeSpan_end(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNGitLanguageCount2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐLanguageCount(ctx context.Context, sel ast.SelectionSet, v []stats.LanguageCount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNGitLanguageCount2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋstatsᚐLanguageCount(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var timeZoneImplementors = []string{"TimeZone"}
+func (ec *executionContext) marshalNGitPullRequest2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐPullRequest(ctx context.Context, sel ast.SelectionSet, v git.PullRequest) graphql.Marshaler {
+	return ec._GitPullRequest(ctx, sel, &v)
+}
 
-func (ec *executionContext) _TimeZone(ctx context.Context, sel ast.SelectionSet, obj *locgql.TimeZone) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, timeZoneImplementors)
+func (ec *executionContext) marshalNGitQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋgitgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v gitgql.Query) graphql.Marshaler {
+	return ec._GitQuery(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TimeZone")
-		case "name":
-			out.Values[i] = ec._TimeZone_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "offset":
-			out.Values[i] = ec._TimeZone_offset(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNGitQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋgitgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *gitgql.Query) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._GitQuery(ctx, sel, v)
 }
 
-var transitDepartureImplementors = []string{"TransitDeparture"}
+func (ec *executionContext) marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx context.Context, sel ast.SelectionSet, v git.Repo) graphql.Marshaler {
+	return ec._GitRepo(ctx, sel, &v)
+}
 
-func (ec *executionContext) _TransitDeparture(ctx context.Context, sel ast.SelectionSet, obj *transit.Departure) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, transitDepartureImplementors)
+func (ec *executionContext) marshalNGitRepoCommits2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepoCommits(ctx context.Context, sel ast.SelectionSet, v git.RepoCommits) graphql.Marshaler {
+	return ec._GitRepoCommits(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TransitDeparture")
-		case "times":
-			out.Values[i] = ec._TransitDeparture_times(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "transport":
-			out.Values[i] = ec._TransitDeparture_transport(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "station":
-			out.Values[i] = ec._TransitDeparture_station(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "relativeTimes":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._TransitDeparture_relativeTimes(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNGitRepoCommits2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepoCommits(ctx context.Context, sel ast.SelectionSet, v []git.RepoCommits) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		case "realtime":
-			out.Values[i] = ec._TransitDeparture_realtime(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNGitRepoCommits2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepoCommits(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var transitOperatorImplementors = []string{"TransitOperator"}
+func (ec *executionContext) marshalNGitRepoLanguage2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepoLanguage(ctx context.Context, sel ast.SelectionSet, v git.RepoLanguage) graphql.Marshaler {
+	return ec._GitRepoLanguage(ctx, sel, &v)
+}
 
-func (ec *executionContext) _TransitOperator(ctx context.Context, sel ast.SelectionSet, obj *transit.Operator) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, transitOperatorImplementors)
+func (ec *executionContext) unmarshalNID2int(ctx context.Context, v interface{}) (int, error) {
+	return graphql.UnmarshalIntID(v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TransitOperator")
-		case "code":
-			out.Values[i] = ec._TransitOperator_code(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._TransitOperator_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNID2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalIntID(v)
+	if res == graphql.Null {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var transitQueryImplementors = []string{"TransitQuery"}
-
-func (ec *executionContext) _TransitQuery(ctx context.Context, sel ast.SelectionSet, obj *transgql.Query) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, transitQueryImplementors)
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	return graphql.UnmarshalID(v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TransitQuery")
-		case "findDepartures":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._TransitQuery_findDepartures(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "nearbyTransports":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._TransitQuery_nearbyTransports(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var transitStationImplementors = []string{"TransitStation"}
-
-func (ec *executionContext) _TransitStation(ctx context.Context, sel ast.SelectionSet, obj *transit.Station) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, transitStationImplementors)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	return graphql.UnmarshalInt(v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TransitStation")
-		case "id":
-			out.Values[i] = ec._TransitStation_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._TransitStation_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "coordinates":
-			out.Values[i] = ec._TransitStation_coordinates(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var transportImplementors = []string{"Transport"}
-
-func (ec *executionContext) _Transport(ctx context.Context, sel ast.SelectionSet, obj *transit.Transport) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, transportImplementors)
+func (ec *executionContext) marshalNLocationHistorySegment2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐHistorySegment(ctx context.Context, sel ast.SelectionSet, v location.HistorySegment) graphql.Marshaler {
+	return ec._LocationHistorySegment(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Transport")
-		case "route":
-			out.Values[i] = ec._Transport_route(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "direction":
-			out.Values[i] = ec._Transport_direction(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "category":
-			out.Values[i] = ec._Transport_category(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "operator":
-			out.Values[i] = ec._Transport_operator(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNLocationHistorySegment2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐHistorySegment(ctx context.Context, sel ast.SelectionSet, v []location.HistorySegment) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNLocationHistorySegment2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐHistorySegment(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNLocationQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v locgql.Query) graphql.Marshaler {
+	return ec._LocationQuery(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLocationQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *locgql.Query) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._LocationQuery(ctx, sel, v)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
-
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNMusicAlbum2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx context.Context, sel ast.SelectionSet, v music.Album) graphql.Marshaler {
+	return ec._MusicAlbum(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNMusicAlbum2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx context.Context, sel ast.SelectionSet, v []music.Album) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNMusicAlbum2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
-
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, __EnumValueImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNMusicAlbum2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx context.Context, sel ast.SelectionSet, v *music.Album) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._MusicAlbum(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
-
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNMusicArtist2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx context.Context, sel ast.SelectionSet, v music.Artist) graphql.Marshaler {
+	return ec._MusicArtist(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNMusicArtist2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx context.Context, sel ast.SelectionSet, v []music.Artist) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNMusicArtist2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, __InputValueImplementors)
+func (ec *executionContext) marshalNMusicImage2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐImage(ctx context.Context, sel ast.SelectionSet, v music.Image) graphql.Marshaler {
+	return ec._MusicImage(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNMusicImage2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐImage(ctx context.Context, sel ast.SelectionSet, v []music.Image) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNMusicImage2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐImage(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalNMusicLyricLine2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyricLine(ctx context.Context, sel ast.SelectionSet, v music.LyricLine) graphql.Marshaler {
+	return ec._MusicLyricLine(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNMusicLyricLine2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyricLine(ctx context.Context, sel ast.SelectionSet, v []music.LyricLine) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNMusicLyricLine2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyricLine(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var __TypeImplementors = []string{"__Type"}
-
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.RequestContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalNMusicMutation2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐMutation(ctx context.Context, sel ast.SelectionSet, v musicgql.Mutation) graphql.Marshaler {
+	return ec._MusicMutation(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNMusicMutation2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐMutation(ctx context.Context, sel ast.SelectionSet, v *musicgql.Mutation) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
-}
-
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
-
-func (ec *executionContext) marshalNAddress2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐAddress(ctx context.Context, sel ast.SelectionSet, v location.Address) graphql.Marshaler {
-	return ec._Address(ctx, sel, &v)
+	return ec._MusicMutation(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAssistQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋassistgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v assistgql.Query) graphql.Marshaler {
-	return ec._AssistQuery(ctx, sel, &v)
+func (ec *executionContext) marshalNMusicQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v musicgql.Query) graphql.Marshaler {
+	return ec._MusicQuery(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAssistQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋassistgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *assistgql.Query) graphql.Marshaler {
+func (ec *executionContext) marshalNMusicQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *musicgql.Query) graphql.Marshaler {
 	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._AssistQuery(ctx, sel, v)
+	return ec._MusicQuery(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAuthQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋauthᚋauthgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v authgql.Query) graphql.Marshaler {
-	return ec._AuthQuery(ctx, sel, &v)
+func (ec *executionContext) marshalNMusicRequest2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx context.Context, sel ast.SelectionSet, v music.Request) graphql.Marshaler {
+	return ec._MusicRequest(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAuthQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋauthᚋauthgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *authgql.Query) graphql.Marshaler {
+func (ec *executionContext) marshalNMusicRequest2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx context.Context, sel ast.SelectionSet, v []music.Request) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMusicRequest2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNMusicRequest2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐRequest(ctx context.Context, sel ast.SelectionSet, v *music.Request) graphql.Marshaler {
 	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._AuthQuery(ctx, sel, v)
+	return ec._MusicRequest(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	return graphql.UnmarshalBoolean(v)
+func (ec *executionContext) marshalNMusicRequestedResource2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐRequestedResource(ctx context.Context, sel ast.SelectionSet, v musicgql.RequestedResource) graphql.Marshaler {
+	return ec._MusicRequestedResource(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNMusicRequestedResource2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐRequestedResource(ctx context.Context, sel ast.SelectionSet, v *musicgql.RequestedResource) graphql.Marshaler {
+	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._MusicRequestedResource(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx context.Context, sel ast.SelectionSet, v location.Coordinates) graphql.Marshaler {
-	return ec._Coordinates(ctx, sel, &v)
+func (ec *executionContext) unmarshalNMusicSelector2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐSelector(ctx context.Context, v interface{}) (music.Selector, error) {
+	return ec.unmarshalInputMusicSelector(ctx, v)
 }
 
-func (ec *executionContext) marshalNCoordinates2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx context.Context, sel ast.SelectionSet, v []location.Coordinates) graphql.Marshaler {
+func (ec *executionContext) marshalNMusicTrack2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx context.Context, sel ast.SelectionSet, v music.Track) graphql.Marshaler {
+	return ec._MusicTrack(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNNearbyTransitDeparture2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐNearbyDeparture(ctx context.Context, sel ast.SelectionSet, v transit.NearbyDeparture) graphql.Marshaler {
+	return ec._NearbyTransitDeparture(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNNearbyTransitDeparture2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐNearbyDeparture(ctx context.Context, sel ast.SelectionSet, v []transit.NearbyDeparture) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -10238,7 +23455,7 @@ func (ec *executionContext) marshalNCoordinates2ᚕgoᚗstevenxieᚗmeᚋapiᚋv
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCoordinates2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐCoordinates(ctx, sel, v[i])
+			ret[i] = ec.marshalNNearbyTransitDeparture2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐNearbyDeparture(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10251,29 +23468,49 @@ func (ec *executionContext) marshalNCoordinates2ᚕgoᚗstevenxieᚗmeᚋapiᚋv
 	return ret
 }
 
-func (ec *executionContext) unmarshalNCoordinatesInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐCoordinatesInput(ctx context.Context, v interface{}) (locgql.CoordinatesInput, error) {
-	return ec.unmarshalInputCoordinatesInput(ctx, v)
+func (ec *executionContext) marshalNPartialAbout2goᚗstevenxieᚗmeᚋapiᚋv2ᚋaboutᚐContactInfo(ctx context.Context, sel ast.SelectionSet, v about.ContactInfo) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PartialAbout(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
-	return graphql.UnmarshalFloat(v)
+func (ec *executionContext) marshalNPlace2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐPlace(ctx context.Context, sel ast.SelectionSet, v location.Place) graphql.Marshaler {
+	return ec._Place(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	res := graphql.MarshalFloat(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNPlace2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐPlace(ctx context.Context, sel ast.SelectionSet, v *location.Place) graphql.Marshaler {
+	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._Place(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNGitCommit2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx context.Context, sel ast.SelectionSet, v git.Commit) graphql.Marshaler {
-	return ec._GitCommit(ctx, sel, &v)
+func (ec *executionContext) marshalNProductivity2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐProductivity(ctx context.Context, sel ast.SelectionSet, v productivity.Productivity) graphql.Marshaler {
+	return ec._Productivity(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNGitCommit2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx context.Context, sel ast.SelectionSet, v []git.Commit) graphql.Marshaler {
+func (ec *executionContext) marshalNProductivity2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐProductivity(ctx context.Context, sel ast.SelectionSet, v *productivity.Productivity) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Productivity(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNProductivityActivity2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐActivity(ctx context.Context, sel ast.SelectionSet, v productivity.Activity) graphql.Marshaler {
+	return ec._ProductivityActivity(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProductivityActivity2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐActivity(ctx context.Context, sel ast.SelectionSet, v []productivity.Activity) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -10297,7 +23534,7 @@ func (ec *executionContext) marshalNGitCommit2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNGitCommit2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommit(ctx, sel, v[i])
+			ret[i] = ec.marshalNProductivityActivity2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐActivity(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10310,75 +23547,94 @@ func (ec *executionContext) marshalNGitCommit2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2
 	return ret
 }
 
-func (ec *executionContext) marshalNGitCommitAuthor2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐCommitAuthor(ctx context.Context, sel ast.SelectionSet, v git.CommitAuthor) graphql.Marshaler {
-	return ec._GitCommitAuthor(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNGitQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋgitgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v gitgql.Query) graphql.Marshaler {
-	return ec._GitQuery(ctx, sel, &v)
+func (ec *executionContext) marshalNProductivityCategory2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐCategory(ctx context.Context, sel ast.SelectionSet, v prodgql.Category) graphql.Marshaler {
+	return ec._ProductivityCategory(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNGitQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚋgitgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *gitgql.Query) graphql.Marshaler {
+func (ec *executionContext) marshalNProductivityCategory2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐCategory(ctx context.Context, sel ast.SelectionSet, v *prodgql.Category) graphql.Marshaler {
 	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._GitQuery(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNGitRepo2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepo(ctx context.Context, sel ast.SelectionSet, v git.Repo) graphql.Marshaler {
-	return ec._GitRepo(ctx, sel, &v)
+	return ec._ProductivityCategory(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2int(ctx context.Context, v interface{}) (int, error) {
-	return graphql.UnmarshalIntID(v)
+func (ec *executionContext) marshalNProductivityFocusSession2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐFocusSession(ctx context.Context, sel ast.SelectionSet, v productivity.FocusSession) graphql.Marshaler {
+	return ec._ProductivityFocusSession(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNID2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalIntID(v)
-	if res == graphql.Null {
-		if !ec.HasError(graphql.GetResolverContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
+func (ec *executionContext) marshalNProductivityFocusSession2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐFocusSession(ctx context.Context, sel ast.SelectionSet, v []productivity.FocusSession) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return res
-}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProductivityFocusSession2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐFocusSession(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	return graphql.UnmarshalID(v)
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNProductivityFocusSession2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐFocusSession(ctx context.Context, sel ast.SelectionSet, v *productivity.FocusSession) graphql.Marshaler {
+	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._ProductivityFocusSession(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	return graphql.UnmarshalInt(v)
+func (ec *executionContext) marshalNProductivityGoal2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoal(ctx context.Context, sel ast.SelectionSet, v productivity.Goal) graphql.Marshaler {
+	return ec._ProductivityGoal(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNProductivityGoal2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoal(ctx context.Context, sel ast.SelectionSet, v *productivity.Goal) graphql.Marshaler {
+	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._ProductivityGoal(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNLocationHistorySegment2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐHistorySegment(ctx context.Context, sel ast.SelectionSet, v location.HistorySegment) graphql.Marshaler {
-	return ec._LocationHistorySegment(ctx, sel, &v)
+func (ec *executionContext) unmarshalNProductivityGoalInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐGoalInput(ctx context.Context, v interface{}) (prodgql.GoalInput, error) {
+	return ec.unmarshalInputProductivityGoalInput(ctx, v)
 }
 
-func (ec *executionContext) marshalNLocationHistorySegment2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐHistorySegment(ctx context.Context, sel ast.SelectionSet, v []location.HistorySegment) graphql.Marshaler {
+func (ec *executionContext) marshalNProductivityGoalProgress2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoalProgress(ctx context.Context, sel ast.SelectionSet, v productivity.GoalProgress) graphql.Marshaler {
+	return ec._ProductivityGoalProgress(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProductivityGoalProgress2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoalProgress(ctx context.Context, sel ast.SelectionSet, v []productivity.GoalProgress) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -10402,7 +23658,7 @@ func (ec *executionContext) marshalNLocationHistorySegment2ᚕgoᚗstevenxieᚗm
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNLocationHistorySegment2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐHistorySegment(ctx, sel, v[i])
+			ret[i] = ec.marshalNProductivityGoalProgress2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoalProgress(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10415,25 +23671,35 @@ func (ec *executionContext) marshalNLocationHistorySegment2ᚕgoᚗstevenxieᚗm
 	return ret
 }
 
-func (ec *executionContext) marshalNLocationQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v locgql.Query) graphql.Marshaler {
-	return ec._LocationQuery(ctx, sel, &v)
+func (ec *executionContext) marshalNProductivityGoalProgress2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐGoalProgress(ctx context.Context, sel ast.SelectionSet, v *productivity.GoalProgress) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ProductivityGoalProgress(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNLocationQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚋlocgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *locgql.Query) graphql.Marshaler {
+func (ec *executionContext) marshalNProductivityMutation2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐMutation(ctx context.Context, sel ast.SelectionSet, v prodgql.Mutation) graphql.Marshaler {
+	return ec._ProductivityMutation(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProductivityMutation2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐMutation(ctx context.Context, sel ast.SelectionSet, v *prodgql.Mutation) graphql.Marshaler {
 	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._LocationQuery(ctx, sel, v)
+	return ec._ProductivityMutation(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNMusicAlbum2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx context.Context, sel ast.SelectionSet, v music.Album) graphql.Marshaler {
-	return ec._MusicAlbum(ctx, sel, &v)
+func (ec *executionContext) marshalNProductivityRecord2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx context.Context, sel ast.SelectionSet, v productivity.Record) graphql.Marshaler {
+	return ec._ProductivityRecord(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNMusicAlbum2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx context.Context, sel ast.SelectionSet, v []music.Album) graphql.Marshaler {
+func (ec *executionContext) marshalNProductivityRecord2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx context.Context, sel ast.SelectionSet, v []productivity.Record) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -10457,7 +23723,7 @@ func (ec *executionContext) marshalNMusicAlbum2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNMusicAlbum2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx, sel, v[i])
+			ret[i] = ec.marshalNProductivityRecord2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10470,21 +23736,25 @@ func (ec *executionContext) marshalNMusicAlbum2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2
 	return ret
 }
 
-func (ec *executionContext) marshalNMusicAlbum2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐAlbum(ctx context.Context, sel ast.SelectionSet, v *music.Album) graphql.Marshaler {
+func (ec *executionContext) marshalNProductivityTrend2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐTrend(ctx context.Context, sel ast.SelectionSet, v productivity.Trend) graphql.Marshaler {
+	return ec._ProductivityTrend(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProductivityTrend2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐTrend(ctx context.Context, sel ast.SelectionSet, v *productivity.Trend) graphql.Marshaler {
 	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._MusicAlbum(ctx, sel, v)
+	return ec._ProductivityTrend(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNMusicArtist2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx context.Context, sel ast.SelectionSet, v music.Artist) graphql.Marshaler {
-	return ec._MusicArtist(ctx, sel, &v)
+func (ec *executionContext) marshalNProductivityTrendPoint2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐTrendPoint(ctx context.Context, sel ast.SelectionSet, v productivity.TrendPoint) graphql.Marshaler {
+	return ec._ProductivityTrendPoint(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNMusicArtist2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx context.Context, sel ast.SelectionSet, v []music.Artist) graphql.Marshaler {
+func (ec *executionContext) marshalNProductivityTrendPoint2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐTrendPoint(ctx context.Context, sel ast.SelectionSet, v []productivity.TrendPoint) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -10508,7 +23778,7 @@ func (ec *executionContext) marshalNMusicArtist2ᚕgoᚗstevenxieᚗmeᚋapiᚋv
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNMusicArtist2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐArtist(ctx, sel, v[i])
+			ret[i] = ec.marshalNProductivityTrendPoint2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐTrendPoint(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10521,11 +23791,29 @@ func (ec *executionContext) marshalNMusicArtist2ᚕgoᚗstevenxieᚗmeᚋapiᚋv
 	return ret
 }
 
-func (ec *executionContext) marshalNMusicImage2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐImage(ctx context.Context, sel ast.SelectionSet, v music.Image) graphql.Marshaler {
-	return ec._MusicImage(ctx, sel, &v)
+func (ec *executionContext) marshalNSchedulingBooking2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐBooking(ctx context.Context, sel ast.SelectionSet, v scheduling.Booking) graphql.Marshaler {
+	return ec._SchedulingBooking(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNMusicImage2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐImage(ctx context.Context, sel ast.SelectionSet, v []music.Image) graphql.Marshaler {
+func (ec *executionContext) marshalNSchedulingBooking2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐBooking(ctx context.Context, sel ast.SelectionSet, v *scheduling.Booking) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._SchedulingBooking(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSchedulingBookingInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐBookingInput(ctx context.Context, v interface{}) (schedgql.BookingInput, error) {
+	return ec.unmarshalInputSchedulingBookingInput(ctx, v)
+}
+
+func (ec *executionContext) marshalNSchedulingEvent2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐEvent(ctx context.Context, sel ast.SelectionSet, v scheduling.Event) graphql.Marshaler {
+	return ec._SchedulingEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSchedulingEvent2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐEvent(ctx context.Context, sel ast.SelectionSet, v []scheduling.Event) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -10549,7 +23837,7 @@ func (ec *executionContext) marshalNMusicImage2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNMusicImage2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐImage(ctx, sel, v[i])
+			ret[i] = ec.marshalNSchedulingEvent2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐEvent(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10562,43 +23850,25 @@ func (ec *executionContext) marshalNMusicImage2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2
 	return ret
 }
 
-func (ec *executionContext) marshalNMusicMutation2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐMutation(ctx context.Context, sel ast.SelectionSet, v musicgql.Mutation) graphql.Marshaler {
-	return ec._MusicMutation(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNMusicMutation2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐMutation(ctx context.Context, sel ast.SelectionSet, v *musicgql.Mutation) graphql.Marshaler {
-	if v == nil {
-		if !ec.HasError(graphql.GetResolverContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._MusicMutation(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNMusicQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v musicgql.Query) graphql.Marshaler {
-	return ec._MusicQuery(ctx, sel, &v)
+func (ec *executionContext) marshalNSchedulingQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v schedgql.Query) graphql.Marshaler {
+	return ec._SchedulingQuery(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNMusicQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚋmusicgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *musicgql.Query) graphql.Marshaler {
+func (ec *executionContext) marshalNSchedulingQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *schedgql.Query) graphql.Marshaler {
 	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._MusicQuery(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNMusicTrack2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx context.Context, sel ast.SelectionSet, v music.Track) graphql.Marshaler {
-	return ec._MusicTrack(ctx, sel, &v)
+	return ec._SchedulingQuery(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNNearbyTransitDeparture2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐNearbyDeparture(ctx context.Context, sel ast.SelectionSet, v transit.NearbyDeparture) graphql.Marshaler {
-	return ec._NearbyTransitDeparture(ctx, sel, &v)
+func (ec *executionContext) marshalNSchedulingSlot2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐSlot(ctx context.Context, sel ast.SelectionSet, v scheduling.Slot) graphql.Marshaler {
+	return ec._SchedulingSlot(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNNearbyTransitDeparture2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐNearbyDeparture(ctx context.Context, sel ast.SelectionSet, v []transit.NearbyDeparture) graphql.Marshaler {
+func (ec *executionContext) marshalNSchedulingSlot2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐSlot(ctx context.Context, sel ast.SelectionSet, v []scheduling.Slot) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -10622,7 +23892,7 @@ func (ec *executionContext) marshalNNearbyTransitDeparture2ᚕgoᚗstevenxieᚗm
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNNearbyTransitDeparture2goᚗstevenxieᚗmeᚋapiᚋv2ᚋassistᚋtransitᚐNearbyDeparture(ctx, sel, v[i])
+			ret[i] = ec.marshalNSchedulingSlot2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐSlot(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10635,63 +23905,11 @@ func (ec *executionContext) marshalNNearbyTransitDeparture2ᚕgoᚗstevenxieᚗm
 	return ret
 }
 
-func (ec *executionContext) marshalNPartialAbout2goᚗstevenxieᚗmeᚋapiᚋv2ᚋaboutᚐContactInfo(ctx context.Context, sel ast.SelectionSet, v about.ContactInfo) graphql.Marshaler {
-	if v == nil {
-		if !ec.HasError(graphql.GetResolverContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._PartialAbout(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNPlace2goᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐPlace(ctx context.Context, sel ast.SelectionSet, v location.Place) graphql.Marshaler {
-	return ec._Place(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNPlace2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋlocationᚐPlace(ctx context.Context, sel ast.SelectionSet, v *location.Place) graphql.Marshaler {
-	if v == nil {
-		if !ec.HasError(graphql.GetResolverContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Place(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNProductivity2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐProductivity(ctx context.Context, sel ast.SelectionSet, v productivity.Productivity) graphql.Marshaler {
-	return ec._Productivity(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNProductivity2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐProductivity(ctx context.Context, sel ast.SelectionSet, v *productivity.Productivity) graphql.Marshaler {
-	if v == nil {
-		if !ec.HasError(graphql.GetResolverContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Productivity(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNProductivityCategory2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐCategory(ctx context.Context, sel ast.SelectionSet, v prodgql.Category) graphql.Marshaler {
-	return ec._ProductivityCategory(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNProductivityCategory2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚋprodgqlᚐCategory(ctx context.Context, sel ast.SelectionSet, v *prodgql.Category) graphql.Marshaler {
-	if v == nil {
-		if !ec.HasError(graphql.GetResolverContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._ProductivityCategory(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNProductivityRecord2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx context.Context, sel ast.SelectionSet, v productivity.Record) graphql.Marshaler {
-	return ec._ProductivityRecord(ctx, sel, &v)
+func (ec *executionContext) marshalNSchedulingTravelBlock2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐTravelBlock(ctx context.Context, sel ast.SelectionSet, v scheduling.TravelBlock) graphql.Marshaler {
+	return ec._SchedulingTravelBlock(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNProductivityRecord2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx context.Context, sel ast.SelectionSet, v []productivity.Record) graphql.Marshaler {
+func (ec *executionContext) marshalNSchedulingTravelBlock2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐTravelBlock(ctx context.Context, sel ast.SelectionSet, v []scheduling.TravelBlock) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -10715,7 +23933,7 @@ func (ec *executionContext) marshalNProductivityRecord2ᚕgoᚗstevenxieᚗmeᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNProductivityRecord2goᚗstevenxieᚗmeᚋapiᚋv2ᚋproductivityᚐRecord(ctx, sel, v[i])
+			ret[i] = ec.marshalNSchedulingTravelBlock2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚐTravelBlock(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10728,18 +23946,18 @@ func (ec *executionContext) marshalNProductivityRecord2ᚕgoᚗstevenxieᚗmeᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNSchedulingQuery2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v schedgql.Query) graphql.Marshaler {
-	return ec._SchedulingQuery(ctx, sel, &v)
+func (ec *executionContext) marshalNStatus2goᚗstevenxieᚗmeᚋapiᚋv2ᚋstatusᚐStatus(ctx context.Context, sel ast.SelectionSet, v status.Status) graphql.Marshaler {
+	return ec._Status(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNSchedulingQuery2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐQuery(ctx context.Context, sel ast.SelectionSet, v *schedgql.Query) graphql.Marshaler {
+func (ec *executionContext) marshalNStatus2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋstatusᚐStatus(ctx context.Context, sel ast.SelectionSet, v *status.Status) graphql.Marshaler {
 	if v == nil {
 		if !ec.HasError(graphql.GetResolverContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._SchedulingQuery(ctx, sel, v)
+	return ec._Status(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
@@ -11290,6 +24508,80 @@ func (ec *executionContext) marshalOGitCommitAuthor2ᚖgoᚗstevenxieᚗmeᚋapi
 	return ec._GitCommitAuthor(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOGitCommitVerification2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐVerification(ctx context.Context, sel ast.SelectionSet, v git.Verification) graphql.Marshaler {
+	return ec._GitCommitVerification(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOGitCommitVerification2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐVerification(ctx context.Context, sel ast.SelectionSet, v *git.Verification) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._GitCommitVerification(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOGitRepoLanguage2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepoLanguage(ctx context.Context, sel ast.SelectionSet, v []git.RepoLanguage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNGitRepoLanguage2goᚗstevenxieᚗmeᚋapiᚋv2ᚋgitᚐRepoLanguage(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOID2string(ctx context.Context, v interface{}) (string, error) {
+	return graphql.UnmarshalID(v)
+}
+
+func (ec *executionContext) marshalOID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	return graphql.MarshalID(v)
+}
+
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOID2string(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.marshalOID2string(ctx, sel, *v)
+}
+
 func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v interface{}) (int, error) {
 	return graphql.UnmarshalInt(v)
 }
@@ -11313,6 +24605,28 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return ec.marshalOInt2int(ctx, sel, *v)
 }
 
+func (ec *executionContext) marshalOMusicLyricLine2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyricLine(ctx context.Context, sel ast.SelectionSet, v music.LyricLine) graphql.Marshaler {
+	return ec._MusicLyricLine(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOMusicLyricLine2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyricLine(ctx context.Context, sel ast.SelectionSet, v *music.LyricLine) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MusicLyricLine(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOMusicLyrics2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyrics(ctx context.Context, sel ast.SelectionSet, v music.Lyrics) graphql.Marshaler {
+	return ec._MusicLyrics(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOMusicLyrics2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐLyrics(ctx context.Context, sel ast.SelectionSet, v *music.Lyrics) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MusicLyrics(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOMusicResource2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐResource(ctx context.Context, v interface{}) (music.Resource, error) {
 	return ec.unmarshalInputMusicResource(ctx, v)
 }
@@ -11337,6 +24651,10 @@ func (ec *executionContext) unmarshalOMusicSelector2ᚖgoᚗstevenxieᚗmeᚋapi
 	return &res, err
 }
 
+func (ec *executionContext) marshalOMusicTrack2goᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx context.Context, sel ast.SelectionSet, v music.Track) graphql.Marshaler {
+	return ec._MusicTrack(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalOMusicTrack2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx context.Context, sel ast.SelectionSet, v []music.Track) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -11377,6 +24695,25 @@ func (ec *executionContext) marshalOMusicTrack2ᚕgoᚗstevenxieᚗmeᚋapiᚋv2
 	return ret
 }
 
+func (ec *executionContext) marshalOMusicTrack2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋmusicᚐTrack(ctx context.Context, sel ast.SelectionSet, v *music.Track) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MusicTrack(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOSchedulingWorkingHoursInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐWorkingHoursInput(ctx context.Context, v interface{}) (schedgql.WorkingHoursInput, error) {
+	return ec.unmarshalInputSchedulingWorkingHoursInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalOSchedulingWorkingHoursInput2ᚖgoᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐWorkingHoursInput(ctx context.Context, v interface{}) (*schedgql.WorkingHoursInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOSchedulingWorkingHoursInput2goᚗstevenxieᚗmeᚋapiᚋv2ᚋschedulingᚋschedgqlᚐWorkingHoursInput(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -11385,6 +24722,38 @@ func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.S
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstring(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -0,0 +1,48 @@
+package svcgql
+
+import (
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/git/gitgql"
+	"go.stevenxie.me/api/v2/graphql"
+)
+
+func newGitResolvers(svc git.Service) gitResolvers {
+	return gitResolvers{
+		commit: gitgql.NewCommitResolver(svc),
+		repo:   gitgql.NewRepoResolver(svc),
+	}
+}
+
+type gitResolvers struct {
+	commit        gitgql.CommitResolver
+	verification  gitgql.VerificationResolver
+	repo          gitgql.RepoResolver
+	contributions gitgql.ContributionsResolver
+	pullRequest   gitgql.PullRequestActivityResolver
+	review        gitgql.ReviewActivityResolver
+	issue         gitgql.IssueActivityResolver
+}
+
+func (res gitResolvers) GitContributions() graphql.GitContributionsResolver {
+	return res.contributions
+}
+
+func (res gitResolvers) GitPullRequestActivity() graphql.GitPullRequestActivityResolver {
+	return res.pullRequest
+}
+
+func (res gitResolvers) GitReviewActivity() graphql.GitReviewActivityResolver {
+	return res.review
+}
+
+func (res gitResolvers) GitIssueActivity() graphql.GitIssueActivityResolver {
+	return res.issue
+}
+
+func (res gitResolvers) GitRepo() graphql.GitRepoResolver { return res.repo }
+
+func (res gitResolvers) GitCommit() graphql.GitCommitResolver { return res.commit }
+
+func (res gitResolvers) GitCommitVerification() graphql.GitCommitVerificationResolver {
+	return res.verification
+}
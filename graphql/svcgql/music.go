@@ -8,10 +8,12 @@ import (
 
 func newMusicResolvers(svc music.Service) *musicResolvers {
 	return &musicResolvers{
-		track:   musicgql.NewTrackResolver(svc),
+		track:   musicgql.NewTrackResolver(svc, svc),
 		album:   musicgql.NewAlbumResolver(svc),
 		artist:  musicgql.NewArtistResolver(svc),
 		current: musicgql.CurrentlyPlayingResolver{},
+		line:    musicgql.LyricLineResolver{},
+		request: musicgql.NewRequestResolver(svc),
 	}
 }
 
@@ -20,6 +22,8 @@ type musicResolvers struct {
 	album   musicgql.AlbumResolver
 	artist  musicgql.ArtistResolver
 	current musicgql.CurrentlyPlayingResolver
+	line    musicgql.LyricLineResolver
+	request musicgql.RequestResolver
 }
 
 func (res *musicResolvers) MusicTrack() graphql.MusicTrackResolver   { return res.track }
@@ -28,3 +32,11 @@ func (res *musicResolvers) MusicArtist() graphql.MusicArtistResolver { return re
 func (res *musicResolvers) CurrentlyPlayingMusic() graphql.CurrentlyPlayingMusicResolver {
 	return res.current
 }
+
+func (res *musicResolvers) MusicLyricLine() graphql.MusicLyricLineResolver {
+	return res.line
+}
+
+func (res *musicResolvers) MusicRequest() graphql.MusicRequestResolver {
+	return res.request
+}
@@ -11,18 +11,28 @@ import (
 	"go.stevenxie.me/api/v2/graphql"
 	"go.stevenxie.me/api/v2/music"
 	"go.stevenxie.me/api/v2/music/musicgql"
+	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/productivity/prodgql"
+	"go.stevenxie.me/api/v2/scheduling"
+	"go.stevenxie.me/api/v2/scheduling/schedgql"
 )
 
 func newMutationResolver(svcs Services) graphql.MutationResolver {
 	return mutationResolver{
-		music: musicgql.NewMutation(svcs.Music),
-		auth:  svcs.Auth,
+		music:    musicgql.NewMutation(svcs.Music),
+		musicsvc: svcs.Music,
+		prod:     prodgql.NewMutation(svcs.ProductivityGoals),
+		booking:  svcs.Booking,
+		auth:     svcs.Auth,
 	}
 }
 
 type mutationResolver struct {
-	music musicgql.Mutation
-	auth  auth.Service
+	music    musicgql.Mutation
+	musicsvc music.RequestService
+	prod     prodgql.Mutation
+	booking  scheduling.BookingService
+	auth     auth.Service
 }
 
 var _ graphql.MutationResolver = (*mutationResolver)(nil)
@@ -40,3 +50,63 @@ func (res mutationResolver) Music(
 	}
 	return &res.music, nil
 }
+
+func (res mutationResolver) Productivity(
+	ctx context.Context,
+	code string,
+) (*prodgql.Mutation, error) {
+	ok, err := res.auth.HasPermission(ctx, code, productivity.PermGoals)
+	if err != nil {
+		return nil, errors.Wrap(err, "svcgql: checking permissions")
+	}
+	if !ok {
+		return nil, authutil.ErrAccessDenied
+	}
+	return &res.prod, nil
+}
+
+func (res mutationResolver) RequestMusic(
+	ctx context.Context,
+	code string,
+	resource music.Selector,
+	requester *string,
+) (*music.Request, error) {
+	// Those who can control my music can also request it.
+	var ok bool
+	for _, perm := range []auth.Permission{
+		music.PermRequest,
+		music.PermControl,
+	} {
+		var err error
+		if ok, err = res.auth.HasPermission(ctx, code, perm); err != nil {
+			return nil, errors.Wrap(err, "svcgql: checking permissions")
+		}
+		if ok {
+			break
+		}
+	}
+	if !ok {
+		return nil, authutil.ErrAccessDenied
+	}
+
+	var opts []music.SubmitRequestOption
+	if requester != nil {
+		opts = append(opts, music.SubmitWithRequester(*requester))
+	}
+	return res.musicsvc.SubmitRequest(ctx, code, resource, opts...)
+}
+
+func (res mutationResolver) BookMeeting(
+	ctx context.Context,
+	code string,
+	booking schedgql.BookingInput,
+) (*scheduling.Booking, error) {
+	ok, err := res.auth.HasPermission(ctx, code, scheduling.PermBook)
+	if err != nil {
+		return nil, errors.Wrap(err, "svcgql: checking permissions")
+	}
+	if !ok {
+		return nil, authutil.ErrAccessDenied
+	}
+	return res.booking.Book(ctx, code, booking.Request())
+}
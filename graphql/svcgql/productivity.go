@@ -5,9 +5,25 @@ import (
 	"go.stevenxie.me/api/v2/productivity/prodgql"
 )
 
+func newProductivityResolvers(svcs Services) productivityResolvers {
+	return productivityResolvers{
+		productivity: prodgql.NewResolver(
+			svcs.Productivity,
+			svcs.ProductivityGoals,
+			svcs.Auth,
+		),
+	}
+}
+
 type productivityResolvers struct {
 	productivity prodgql.Resolver
 	record       prodgql.RecordResolver
+	activity     prodgql.ActivityResolver
+	trend        prodgql.TrendResolver
+	trendPoint   prodgql.TrendPointResolver
+	goal         prodgql.GoalResolver
+	goalProgress prodgql.GoalProgressResolver
+	focusSession prodgql.FocusSessionResolver
 }
 
 func (res productivityResolvers) Productivity() graphql.ProductivityResolver {
@@ -17,3 +33,27 @@ func (res productivityResolvers) Productivity() graphql.ProductivityResolver {
 func (res productivityResolvers) ProductivityRecord() graphql.ProductivityRecordResolver {
 	return res.record
 }
+
+func (res productivityResolvers) ProductivityActivity() graphql.ProductivityActivityResolver {
+	return res.activity
+}
+
+func (res productivityResolvers) ProductivityTrend() graphql.ProductivityTrendResolver {
+	return res.trend
+}
+
+func (res productivityResolvers) ProductivityTrendPoint() graphql.ProductivityTrendPointResolver {
+	return res.trendPoint
+}
+
+func (res productivityResolvers) ProductivityGoal() graphql.ProductivityGoalResolver {
+	return res.goal
+}
+
+func (res productivityResolvers) ProductivityGoalProgress() graphql.ProductivityGoalProgressResolver {
+	return res.goalProgress
+}
+
+func (res productivityResolvers) ProductivityFocusSession() graphql.ProductivityFocusSessionResolver {
+	return res.focusSession
+}
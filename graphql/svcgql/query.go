@@ -2,6 +2,7 @@ package svcgql
 
 import (
 	"context"
+	"time"
 
 	"go.stevenxie.me/api/v2/productivity"
 	"go.stevenxie.me/api/v2/productivity/prodgql"
@@ -16,17 +17,20 @@ import (
 	"go.stevenxie.me/api/v2/location/locgql"
 	"go.stevenxie.me/api/v2/music/musicgql"
 	"go.stevenxie.me/api/v2/scheduling/schedgql"
+	"go.stevenxie.me/api/v2/status"
+	"go.stevenxie.me/api/v2/status/statusgql"
 )
 
 func newQueryResolver(svcs Services) graphql.QueryResolver {
 	return queryResolver{
 		about:  aboutgql.NewQuery(svcs.About, svcs.Auth),
 		prod:   prodgql.NewQuery(svcs.Productivity),
-		gitq:   gitgql.NewQuery(svcs.Git),
+		gitq:   gitgql.NewQuery(svcs.Git, svcs.GitStats),
 		locq:   locgql.NewQuery(svcs.Location, svcs.Auth),
 		authq:  authgql.NewQuery(svcs.Auth),
-		musicq: musicgql.NewQuery(svcs.Music),
-		schedq: schedgql.NewQuery(svcs.Scheduling, svcs.Auth),
+		musicq: musicgql.NewQuery(svcs.Music, svcs.Auth),
+		schedq: schedgql.NewQuery(svcs.Scheduling, svcs.Travel, svcs.Auth),
+		status: statusgql.NewQuery(svcs.Status, svcs.Auth),
 		assistq: assistgql.NewQuery(assistgql.QueryServices{
 			Transit: svcs.Transit,
 		}),
@@ -34,8 +38,9 @@ func newQueryResolver(svcs Services) graphql.QueryResolver {
 }
 
 type queryResolver struct {
-	about aboutgql.Query
-	prod  prodgql.Query
+	about  aboutgql.Query
+	prod   prodgql.Query
+	status statusgql.Query
 
 	gitq    gitgql.Query
 	locq    locgql.Query
@@ -59,6 +64,22 @@ func (qr queryResolver) Productivity(ctx context.Context) (
 	return qr.prod.Productivity(ctx)
 }
 
+func (qr queryResolver) ProductivityTrend(
+	ctx context.Context,
+	from, to time.Time,
+	resolution *string,
+	window *int,
+) (*productivity.Trend, error) {
+	return qr.prod.ProductivityTrend(ctx, from, to, resolution, window)
+}
+
+func (qr queryResolver) Status(
+	ctx context.Context,
+	code *string,
+) (*status.Status, error) {
+	return qr.status.Status(ctx, code)
+}
+
 func (qr queryResolver) Git(context.Context) (*gitgql.Query, error) {
 	return &qr.gitq, nil
 }
@@ -9,11 +9,13 @@ import (
 
 	"go.stevenxie.me/api/v2/auth"
 	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/git/stats"
 	"go.stevenxie.me/api/v2/graphql"
 	"go.stevenxie.me/api/v2/location"
 	"go.stevenxie.me/api/v2/music"
 	"go.stevenxie.me/api/v2/productivity"
 	"go.stevenxie.me/api/v2/scheduling"
+	"go.stevenxie.me/api/v2/status"
 )
 
 // NewResolverRoot creates a new graphql.ResolverRoot
@@ -21,11 +23,14 @@ func NewResolverRoot(svcs Services, strms Streamers) graphql.ResolverRoot {
 	return resolverRoot{
 		query:        newQueryResolver(svcs),
 		mutation:     newMutationResolver(svcs),
-		subscription: newSubscriptionResolver(strms),
+		subscription: newSubscriptionResolver(svcs, strms),
 
+		gitResolvers:          newGitResolvers(svcs.Git),
 		musicResolvers:        newMusicResolvers(svcs.Music),
 		locationResolvers:     locationResolvers{},
-		productivityResolvers: productivityResolvers{},
+		productivityResolvers: newProductivityResolvers(svcs),
+		schedulingResolvers:   schedulingResolvers{},
+		statusResolvers:       statusResolvers{},
 
 		fullAbout:        aboutgql.Resolver{},
 		transitDeparture: transgql.DepartureResolver{},
@@ -36,18 +41,27 @@ type (
 	// Services handle the underlying requests for a graphql.ResolverRoot.
 	Services struct {
 		Git          git.Service
+		GitStats     stats.Service
 		Auth         auth.Service
 		About        about.Service
 		Music        music.Service
 		Transit      transit.Service
 		Location     location.Service
 		Scheduling   scheduling.Service
+		Booking      scheduling.BookingService
+		Travel       scheduling.TravelService
 		Productivity productivity.Service
+
+		ProductivityGoals productivity.GoalService
+		Status            status.Service
 	}
 
 	// Streamers handles streams for a graphql.ResolverRoot.
 	Streamers struct {
-		Music music.Streamer
+		Git          git.Streamer
+		Music        music.Streamer
+		Productivity productivity.GoalStreamer
+		Status       status.Streamer
 	}
 )
 
@@ -56,9 +70,12 @@ type resolverRoot struct {
 	mutation     graphql.MutationResolver
 	subscription graphql.SubscriptionResolver
 
+	gitResolvers
 	*musicResolvers
 	locationResolvers
 	productivityResolvers
+	schedulingResolvers
+	statusResolvers
 
 	fullAbout        graphql.FullAboutResolver
 	transitDeparture graphql.TransitDepartureResolver
@@ -0,0 +1,24 @@
+package svcgql
+
+import (
+	"go.stevenxie.me/api/v2/graphql"
+	"go.stevenxie.me/api/v2/scheduling/schedgql"
+)
+
+type schedulingResolvers struct {
+	event   schedgql.EventResolver
+	booking schedgql.BookingResolver
+	travel  schedgql.TravelBlockResolver
+}
+
+func (res schedulingResolvers) SchedulingEvent() graphql.SchedulingEventResolver {
+	return res.event
+}
+
+func (res schedulingResolvers) SchedulingBooking() graphql.SchedulingBookingResolver {
+	return res.booking
+}
+
+func (res schedulingResolvers) SchedulingTravelBlock() graphql.SchedulingTravelBlockResolver {
+	return res.travel
+}
@@ -0,0 +1,12 @@
+package svcgql
+
+import (
+	"go.stevenxie.me/api/v2/graphql"
+	"go.stevenxie.me/api/v2/status/statusgql"
+)
+
+type statusResolvers struct {
+	status statusgql.StatusResolver
+}
+
+func (res statusResolvers) Status() graphql.StatusResolver { return res.status }
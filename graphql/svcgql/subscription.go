@@ -3,19 +3,34 @@ package svcgql
 import (
 	"context"
 
+	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/git/gitgql"
 	"go.stevenxie.me/api/v2/graphql"
 	"go.stevenxie.me/api/v2/music"
 	"go.stevenxie.me/api/v2/music/musicgql"
+	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/productivity/prodgql"
+	"go.stevenxie.me/api/v2/status"
+	"go.stevenxie.me/api/v2/status/statusgql"
 )
 
-func newSubscriptionResolver(strms Streamers) graphql.SubscriptionResolver {
+func newSubscriptionResolver(
+	svcs Services,
+	strms Streamers,
+) graphql.SubscriptionResolver {
 	return subscriptionResolver{
-		music: musicgql.NewSubscriptionResolver(strms.Music),
+		git:    gitgql.NewSubscriptionResolver(strms.Git),
+		music:  musicgql.NewSubscriptionResolver(strms.Music, svcs.Auth),
+		prod:   prodgql.NewSubscriptionResolver(strms.Productivity, svcs.Auth),
+		status: statusgql.NewSubscriptionResolver(strms.Status, svcs.Auth),
 	}
 }
 
 type subscriptionResolver struct {
-	music musicgql.SubscriptionResolver
+	git    gitgql.SubscriptionResolver
+	music  musicgql.SubscriptionResolver
+	prod   prodgql.SubscriptionResolver
+	status statusgql.SubscriptionResolver
 }
 
 var _ graphql.SubscriptionResolver = (*subscriptionResolver)(nil)
@@ -24,3 +39,34 @@ func (res subscriptionResolver) Music(ctx context.Context) (
 	<-chan *music.CurrentlyPlaying, error) {
 	return res.music.CurrentlyPlaying(ctx)
 }
+
+func (res subscriptionResolver) MusicLyricLine(ctx context.Context) (
+	<-chan *music.LyricLine, error) {
+	return res.music.LyricLine(ctx)
+}
+
+func (res subscriptionResolver) MusicRequests(
+	ctx context.Context,
+	code *string,
+) (<-chan []music.Request, error) {
+	return res.music.Requests(ctx, code)
+}
+
+func (res subscriptionResolver) GitCommits(ctx context.Context) (
+	<-chan *git.Commit, error) {
+	return res.git.Commits(ctx)
+}
+
+func (res subscriptionResolver) ProductivityGoalBreached(
+	ctx context.Context,
+	code string,
+) (<-chan *productivity.GoalProgress, error) {
+	return res.prod.GoalBreaches(ctx, code)
+}
+
+func (res subscriptionResolver) Status(
+	ctx context.Context,
+	code *string,
+) (<-chan *status.Status, error) {
+	return res.status.Status(ctx, code)
+}
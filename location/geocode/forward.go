@@ -0,0 +1,28 @@
+package geocode
+
+import (
+	"go.stevenxie.me/api/v2/location"
+)
+
+type (
+	// GeocodeOptions are option parameters for a geocoding request.
+	GeocodeOptions struct {
+		// Near biases the search towards Places near a position.
+		Near *location.Coordinates
+	}
+
+	// A GeocodeOption modifies a GeocodeOptions.
+	GeocodeOption func(*GeocodeOptions)
+
+	// A GeocodeResult is the result of a geocoding search.
+	GeocodeResult struct {
+		Place     location.Place
+		Relevance float32
+	}
+)
+
+// GeocodeWithProximity biases a geocoding request towards Places near
+// coord.
+func GeocodeWithProximity(coord location.Coordinates) GeocodeOption {
+	return func(opt *GeocodeOptions) { opt.Near = &coord }
+}
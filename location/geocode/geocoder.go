@@ -7,8 +7,13 @@ import (
 )
 
 // A Geocoder can look up geographical features that correspond to a set of
-// coordinates.
+// coordinates, and the coordinates of a free-form address.
 type Geocoder interface {
+	Geocode(
+		ctx context.Context,
+		query string,
+		opts ...GeocodeOption,
+	) ([]GeocodeResult, error)
 	ReverseGeocode(
 		ctx context.Context,
 		coord location.Coordinates,
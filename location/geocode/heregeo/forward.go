@@ -0,0 +1,102 @@
+package heregeo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/location/geocode"
+)
+
+func (g geocoder) Geocode(
+	ctx context.Context,
+	query string,
+	opts ...geocode.GeocodeOption,
+) ([]geocode.GeocodeResult, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, g.tracer,
+		name.OfFunc(geocoder.Geocode),
+	)
+	defer span.Finish()
+
+	var opt geocode.GeocodeOptions
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	// Create and perform request.
+	url := buildGeocodeURL(query, &opt)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "heregeo: create request")
+	}
+	res, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// Decode response.
+	var data geocodeResponse
+	if err = json.NewDecoder(res.Body).Decode(&data); err != nil {
+		return nil, errors.Wrap(err, "heregeo: decode response body")
+	}
+	if err = res.Body.Close(); err != nil {
+		return nil, errors.Wrap(err, "heregeo: close response body")
+	}
+
+	// Parse response; a query that matches nothing has no result views.
+	if len(data.Response.View) == 0 {
+		return []geocode.GeocodeResult{}, nil
+	}
+
+	var (
+		matches = data.Response.View[0].Result
+		results = make([]geocode.GeocodeResult, len(matches))
+	)
+	for i := range matches {
+		match := &matches[i]
+		place, err := match.place()
+		if err != nil {
+			return nil, err
+		}
+		results[i] = geocode.GeocodeResult{
+			Place:     *place,
+			Relevance: match.Relevance,
+		}
+	}
+	return results, nil
+}
+
+const _geocodeURL = "https://geocoder.api.here.com/6.2/geocode.json"
+
+// _geocodeProximityRadius is the radius (in meters) of the area towards which
+// geocoding searches are biased.
+const _geocodeProximityRadius = 50000
+
+func buildGeocodeURL(query string, opt *geocode.GeocodeOptions) string {
+	url, err := url.Parse(_geocodeURL)
+	if err != nil {
+		panic(err)
+	}
+
+	params := url.Query()
+	params.Set("gen", "9")
+	params.Set("searchtext", query)
+	params.Set("locationattributes", "address")
+	if c := opt.Near; c != nil {
+		params.Set(
+			"prox",
+			fmt.Sprintf("%f,%f,%d", c.Y, c.X, _geocodeProximityRadius),
+		)
+	}
+
+	url.RawQuery = params.Encode()
+	return url.String()
+}
@@ -69,44 +69,7 @@ func (g geocoder) ReverseGeocode(
 	defer res.Body.Close()
 
 	// Decode response.
-	var data struct {
-		Response struct {
-			View []struct {
-				Result []struct {
-					Relevance  float32
-					Distance   float32
-					MatchLevel string
-					Location   struct {
-						ID       string `json:"LocationId"`
-						Type     string `json:"LocationType"`
-						Position struct {
-							Latitude  float64
-							Longitude float64
-						} `json:"DisplayPosition"`
-						Address struct {
-							Label       string
-							Country     string
-							State       string
-							County      string
-							City        string
-							District    string
-							PostalCode  string
-							Street      string
-							HouseNumber string
-						}
-						Shape *struct {
-							Value string
-						}
-						AdminInfo *struct {
-							TimeZone struct {
-								ID string `json:"id"`
-							}
-						}
-					}
-				}
-			}
-		}
-	}
+	var data geocodeResponse
 	if err = json.NewDecoder(res.Body).Decode(&data); err != nil {
 		return nil, errors.Wrap(err, "heregeo: decode response body")
 	}
@@ -123,53 +86,16 @@ func (g geocoder) ReverseGeocode(
 		matches = data.Response.View[0].Result
 		results = make([]geocode.ReverseGeocodeResult, len(matches))
 	)
-	for i, match := range matches {
-		var (
-			loc  = &match.Location
-			pos  = &loc.Position
-			addr = &loc.Address
-		)
-
-		// Decode shape in response.
-		var shape []location.Coordinates
-		if res := loc.Shape; res != nil {
-			if shape, err = decodeShapeResponse(res.Value); err != nil {
-				return nil, errors.Wrap(err, "heregeo: decode shape")
-			}
+	for i := range matches {
+		match := &matches[i]
+		place, err := match.place()
+		if err != nil {
+			return nil, err
 		}
-
-		// Load timezone.
-		var timeZone *time.Location
-		if info := loc.AdminInfo; info != nil {
-			if timeZone, err = time.LoadLocation(info.TimeZone.ID); err != nil {
-				return nil, errors.Wrap(err, "heregeo: parsing timezone")
-			}
-		}
-
-		// Save result.
 		results[i] = geocode.ReverseGeocodeResult{
-			Place: location.Place{
-				ID:    loc.ID,
-				Level: match.MatchLevel,
-				Type:  loc.Type,
-				Position: location.Coordinates{
-					X: pos.Longitude,
-					Y: pos.Latitude,
-				},
-				Address: location.Address{
-					Label:    addr.Label,
-					Country:  addr.Country,
-					State:    addr.State,
-					County:   addr.County,
-					City:     addr.City,
-					District: addr.District,
-					Postcode: addr.PostalCode,
-					Street:   addr.Street,
-					Number:   addr.HouseNumber,
-				},
-				TimeZone: timeZone,
-				Shape:    shape,
-			},
+			Place:     *place,
+			Relevance: match.Relevance,
+			Distance:  match.Distance,
 		}
 	}
 	return results, nil
@@ -265,3 +191,98 @@ func decodeShapeResponse(value string) (shape []location.Coordinates,
 	}
 	return shape, nil
 }
+
+type (
+	// A geocodeResponse is the response to a (reverse-)geocoding request.
+	geocodeResponse struct {
+		Response struct {
+			View []struct {
+				Result []geocodeMatch
+			}
+		}
+	}
+
+	geocodeMatch struct {
+		Relevance  float32
+		Distance   float32
+		MatchLevel string
+		Location   struct {
+			ID       string `json:"LocationId"`
+			Type     string `json:"LocationType"`
+			Position struct {
+				Latitude  float64
+				Longitude float64
+			} `json:"DisplayPosition"`
+			Address struct {
+				Label       string
+				Country     string
+				State       string
+				County      string
+				City        string
+				District    string
+				PostalCode  string
+				Street      string
+				HouseNumber string
+			}
+			Shape *struct {
+				Value string
+			}
+			AdminInfo *struct {
+				TimeZone struct {
+					ID string `json:"id"`
+				}
+			}
+		}
+	}
+)
+
+// place parses the location.Place described by m.
+func (m *geocodeMatch) place() (*location.Place, error) {
+	var (
+		loc  = &m.Location
+		pos  = &loc.Position
+		addr = &loc.Address
+	)
+
+	// Decode shape in response.
+	var (
+		shape []location.Coordinates
+		err   error
+	)
+	if res := loc.Shape; res != nil {
+		if shape, err = decodeShapeResponse(res.Value); err != nil {
+			return nil, errors.Wrap(err, "heregeo: decode shape")
+		}
+	}
+
+	// Load timezone.
+	var timeZone *time.Location
+	if info := loc.AdminInfo; info != nil {
+		if timeZone, err = time.LoadLocation(info.TimeZone.ID); err != nil {
+			return nil, errors.Wrap(err, "heregeo: parsing timezone")
+		}
+	}
+
+	return &location.Place{
+		ID:    loc.ID,
+		Level: m.MatchLevel,
+		Type:  loc.Type,
+		Position: location.Coordinates{
+			X: pos.Longitude,
+			Y: pos.Latitude,
+		},
+		Address: location.Address{
+			Label:    addr.Label,
+			Country:  addr.Country,
+			State:    addr.State,
+			County:   addr.County,
+			City:     addr.City,
+			District: addr.District,
+			Postcode: addr.PostalCode,
+			Street:   addr.Street,
+			Number:   addr.HouseNumber,
+		},
+		TimeZone: timeZone,
+		Shape:    shape,
+	}, nil
+}
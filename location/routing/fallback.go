@@ -0,0 +1,49 @@
+package routing
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+
+	"go.stevenxie.me/api/v2/location"
+	"go.stevenxie.me/api/v2/pkg/basic"
+)
+
+// WithFallback creates a Router that plans Routes using primary, and falls
+// back to fallback if primary fails.
+func WithFallback(primary, fallback Router, opts ...basic.Option) Router {
+	cfg := basic.BuildOptions(opts...)
+	return fallbackRouter{
+		primary:  primary,
+		fallback: fallback,
+		log:      logutil.WithComponent(cfg.Logger, (*fallbackRouter)(nil)),
+	}
+}
+
+type fallbackRouter struct {
+	primary, fallback Router
+	log               *logrus.Entry
+}
+
+var _ Router = (*fallbackRouter)(nil)
+
+func (r fallbackRouter) Route(
+	ctx context.Context,
+	from, to location.Coordinates,
+	opts ...RouteOption,
+) (*Route, error) {
+	route, err := r.primary.Route(ctx, from, to, opts...)
+	if err == nil {
+		return route, nil
+	}
+	if ctx.Err() != nil {
+		return nil, err
+	}
+	logutil.
+		WithMethod(r.log, fallbackRouter.Route).
+		WithContext(ctx).
+		WithError(err).
+		Warn("Failed to plan route; falling back.")
+	return r.fallback.Route(ctx, from, to, opts...)
+}
@@ -0,0 +1,152 @@
+package hereroute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/location"
+	"go.stevenxie.me/api/v2/location/routing"
+	"go.stevenxie.me/api/v2/pkg/basic"
+	"go.stevenxie.me/api/v2/pkg/here"
+)
+
+// NewRouter creates a new routing.Router.
+func NewRouter(c here.Client, opts ...basic.Option) routing.Router {
+	cfg := basic.BuildOptions(opts...)
+	return router{
+		client: c,
+		tracer: cfg.Tracer,
+	}
+}
+
+type router struct {
+	client here.Client
+	tracer opentracing.Tracer
+}
+
+var _ routing.Router = (*router)(nil)
+
+func (r router) Route(
+	ctx context.Context,
+	from, to location.Coordinates,
+	opts ...routing.RouteOption,
+) (*routing.Route, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, r.tracer,
+		name.OfFunc(router.Route),
+	)
+	defer span.Finish()
+
+	// Create and perform request.
+	opt := routing.BuildRouteOptions(opts...)
+	url, err := buildRouteURL(from, to, &opt)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "hereroute: create request")
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// Decode response.
+	var data struct {
+		Response struct {
+			Route []struct {
+				Summary struct {
+					Distance    float64 `json:"distance"`
+					TravelTime  int     `json:"travelTime"`
+					TrafficTime *int    `json:"trafficTime"`
+				} `json:"summary"`
+			} `json:"route"`
+		} `json:"response"`
+
+		// Errors are reported using the following fields.
+		Type    string `json:"type"`
+		Subtype string `json:"subtype"`
+		Details string `json:"details"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&data); err != nil {
+		return nil, errors.Wrap(err, "hereroute: decode response body")
+	}
+	if err = res.Body.Close(); err != nil {
+		return nil, errors.Wrap(err, "hereroute: close response body")
+	}
+	if data.Type != "" {
+		return nil, errors.Newf(
+			"hereroute: %s (%s): %s",
+			data.Type, data.Subtype, data.Details,
+		)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Newf("hereroute: bad response status %d", res.StatusCode)
+	}
+	if len(data.Response.Route) == 0 {
+		return nil, errors.New("hereroute: no routes")
+	}
+
+	// Prefer the travel time with traffic, if it is available.
+	summary := &data.Response.Route[0].Summary
+	secs := summary.TravelTime
+	if t := summary.TrafficTime; t != nil {
+		secs = *t
+	}
+	return &routing.Route{
+		Duration: time.Duration(secs) * time.Second,
+		Distance: summary.Distance,
+	}, nil
+}
+
+const _routeURL = "https://route.api.here.com/routing/7.2/calculateroute.json"
+
+func buildRouteURL(
+	from, to location.Coordinates,
+	opt *routing.RouteOptions,
+) (string, error) {
+	url, err := url.Parse(_routeURL)
+	if err != nil {
+		panic(err)
+	}
+
+	// Determine routing mode.
+	var mode string
+	switch opt.Mode {
+	case routing.ModeCar:
+		mode = "fastest;car;traffic:enabled"
+	case routing.ModePedestrian:
+		mode = "fastest;pedestrian"
+	case routing.ModePublicTransport:
+		mode = "fastest;publicTransport"
+		if !opt.Departure.IsZero() {
+			mode = "fastest;publicTransportTimeTable"
+		}
+	default:
+		return "", errors.Newf("hereroute: unknown mode '%s'", opt.Mode)
+	}
+
+	params := url.Query()
+	params.Set("waypoint0", fmt.Sprintf("geo!%f,%f", from.Y, from.X))
+	params.Set("waypoint1", fmt.Sprintf("geo!%f,%f", to.Y, to.X))
+	params.Set("mode", mode)
+	params.Set("routeattributes", "summary")
+	if !opt.Departure.IsZero() {
+		params.Set("departure", opt.Departure.Format(time.RFC3339))
+	} else {
+		params.Set("departure", "now")
+	}
+
+	url.RawQuery = params.Encode()
+	return url.String(), nil
+}
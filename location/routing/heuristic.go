@@ -0,0 +1,68 @@
+package routing
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/location"
+)
+
+// NewHeuristicRouter creates a Router that estimates Routes from the distance
+// between their endpoints, and the typical speed of each Mode.
+func NewHeuristicRouter() Router { return heuristicRouter{} }
+
+type heuristicRouter struct{}
+
+var _ Router = (*heuristicRouter)(nil)
+
+// _heuristicSpeeds are the typical door-to-door speeds of each Mode, in meters
+// per second.
+var _heuristicSpeeds = map[Mode]float64{
+	ModeCar:             30 / 3.6,
+	ModePedestrian:      4.5 / 3.6,
+	ModePublicTransport: 15 / 3.6,
+}
+
+// _detourFactor is the typical ratio of the distance travelled along a route,
+// to the straight-line distance between its endpoints.
+const _detourFactor = 1.3
+
+func (heuristicRouter) Route(
+	_ context.Context,
+	from, to location.Coordinates,
+	opts ...RouteOption,
+) (*Route, error) {
+	opt := BuildRouteOptions(opts...)
+	speed, ok := _heuristicSpeeds[opt.Mode]
+	if !ok {
+		return nil, errors.Newf("routing: unknown mode '%s'", opt.Mode)
+	}
+	var (
+		dist = Distance(from, to) * _detourFactor
+		secs = math.Round(dist / speed)
+	)
+	return &Route{
+		Duration:  time.Duration(secs) * time.Second,
+		Distance:  math.Round(dist),
+		Estimated: true,
+	}, nil
+}
+
+// _earthRadius is the mean radius of the Earth, in meters.
+const _earthRadius = 6371e3
+
+// Distance returns the great-circle distance between a and b, in meters.
+func Distance(a, b location.Coordinates) float64 {
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	var (
+		lat1, lat2 = rad(a.Y), rad(b.Y)
+		dlat       = lat2 - lat1
+		dlon       = rad(b.X - a.X)
+		h          = math.Pow(math.Sin(dlat/2), 2) +
+			math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dlon/2), 2)
+	)
+	return 2 * _earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
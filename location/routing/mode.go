@@ -0,0 +1,25 @@
+package routing
+
+import (
+	"github.com/cockroachdb/errors"
+)
+
+// A Mode is a mode of transport.
+type Mode string
+
+// The set of supported Modes.
+const (
+	ModeCar             Mode = "car"
+	ModePedestrian      Mode = "pedestrian"
+	ModePublicTransport Mode = "publicTransport"
+)
+
+// ParseMode parses a string representing a Mode.
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(s); m {
+	case ModeCar, ModePedestrian, ModePublicTransport:
+		return m, nil
+	default:
+		return "", errors.Newf("routing: unknown mode '%s'", s)
+	}
+}
@@ -0,0 +1,63 @@
+package routing
+
+import (
+	"context"
+	"time"
+
+	"go.stevenxie.me/api/v2/location"
+)
+
+// A Router can estimate how long it takes to travel between two positions.
+type Router interface {
+	Route(
+		ctx context.Context,
+		from, to location.Coordinates,
+		opts ...RouteOption,
+	) (*Route, error)
+}
+
+type (
+	// A Route summarizes the trip between two positions.
+	Route struct {
+		Duration time.Duration `json:"duration"`
+
+		// Distance is the length of the Route, in meters.
+		Distance float64 `json:"distance"`
+
+		// Estimated is true if the Route was estimated heuristically, rather
+		// than planned using a road network.
+		Estimated bool `json:"estimated"`
+	}
+
+	// RouteOptions are option parameters for Router.Route.
+	RouteOptions struct {
+		Mode Mode
+
+		// Departure is the time at which the trip begins, which may affect its
+		// duration (i.e. due to traffic). If zero, the trip begins now.
+		Departure time.Time
+	}
+
+	// A RouteOption modifies a RouteOptions.
+	RouteOption func(*RouteOptions)
+)
+
+// RouteWithMode sets the Mode of transport used for a Route.
+func RouteWithMode(m Mode) RouteOption {
+	return func(opt *RouteOptions) { opt.Mode = m }
+}
+
+// RouteWithDeparture sets the departure time of a Route.
+func RouteWithDeparture(t time.Time) RouteOption {
+	return func(opt *RouteOptions) { opt.Departure = t }
+}
+
+// BuildRouteOptions builds a RouteOptions from opts, using ModeCar by
+// default.
+func BuildRouteOptions(opts ...RouteOption) RouteOptions {
+	opt := RouteOptions{Mode: ModeCar}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return opt
+}
@@ -3,6 +3,7 @@ package music
 import (
 	"context"
 	"reflect"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	validation "github.com/go-ozzo/ozzo-validation"
@@ -12,6 +13,9 @@ import (
 type Controller interface {
 	Play(ctx context.Context, s *Selector) error
 	Pause(ctx context.Context) error
+
+	// Queue adds the resource selected by s to the end of the play queue.
+	Queue(ctx context.Context, s *Selector) error
 }
 
 // PlayResource configures the ControlService.Play method to play the resource
@@ -36,6 +40,17 @@ type (
 	}
 )
 
+// A ResourceKind describes the kind of resource that a Selector selects.
+type ResourceKind string
+
+// The set of valid ResourceKinds.
+const (
+	KindTrack    ResourceKind = "track"
+	KindAlbum    ResourceKind = "album"
+	KindArtist   ResourceKind = "artist"
+	KindPlaylist ResourceKind = "playlist"
+)
+
 var _ validation.Validatable = (*Selector)(nil)
 
 // Validate returns an error if the Cond is not valid (i.e. it does not
@@ -56,11 +71,49 @@ func (s *Selector) Validate() error {
 	return nil
 }
 
+// Resource returns the kind and value of the Resource selected by s.
+//
+// If s selects a resource by its URI, Resource returns ("", nil).
+func (s *Selector) Resource() (ResourceKind, *Resource) {
+	resources := []struct {
+		Kind  ResourceKind
+		Value *Resource
+	}{
+		{Kind: KindTrack, Value: s.Track},
+		{Kind: KindAlbum, Value: s.Album},
+		{Kind: KindArtist, Value: s.Artist},
+		{Kind: KindPlaylist, Value: s.Playlist},
+	}
+	for _, r := range resources {
+		if r.Value != nil {
+			return r.Kind, r.Value
+		}
+	}
+	return "", nil
+}
+
+// Kind returns the kind of resource selected by s.
+//
+// If s selects a resource by its URI (like 'spotify:track:ID'), the kind is
+// read from the URI; if it cannot be determined, Kind returns "".
+func (s *Selector) Kind() ResourceKind {
+	if u := s.URI; u != nil {
+		parts := strings.SplitN(*u, ":", 3)
+		if len(parts) < 3 {
+			return ""
+		}
+		return ResourceKind(parts[1])
+	}
+	kind, _ := s.Resource()
+	return kind
+}
+
 type (
 	// A ControlService wraps a Controller with a friendlier API.
 	ControlService interface {
 		Play(ctx context.Context, opts ...PlayOption) error
 		Pause(ctx context.Context) error
+		Queue(ctx context.Context, s Selector) error
 	}
 
 	// PlayOptions are option parameters for ControlService.Play.
@@ -13,6 +13,19 @@ type CurrentlyPlaying struct {
 	Playing   bool          `json:"playing"`
 }
 
+// Position estimates the playback position of the current track at t, by
+// extrapolating from Progress.
+func (cp *CurrentlyPlaying) Position(t time.Time) time.Duration {
+	pos := cp.Progress
+	if cp.Playing {
+		pos += t.Sub(cp.Timestamp)
+	}
+	if d := cp.Track.Duration; (d > 0) && (pos > d) {
+		pos = d
+	}
+	return pos
+}
+
 type (
 	// A CurrentService handles requests for a description of my currently
 	// playing music.
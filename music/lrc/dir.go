@@ -0,0 +1,80 @@
+package lrc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/music"
+)
+
+// NewDirSource creates a music.LyricsSource that reads LRC files from the
+// directory dir.
+//
+// For each track, it looks for the following files, in order:
+//   - '<dir>/<track ID>.lrc' (with any extension in the ID replaced, so that
+//     MPD tracks can be matched by their file path)
+//   - '<dir>/<first artist> - <track name>.lrc'
+func NewDirSource(dir string) music.LyricsSource {
+	return dirSource{dir: filepath.Clean(dir)}
+}
+
+type dirSource struct {
+	dir string
+}
+
+var _ music.LyricsSource = (*dirSource)(nil)
+
+func (src dirSource) GetLyrics(
+	_ context.Context,
+	t *music.Track,
+) (*music.Lyrics, error) {
+	for _, name := range candidateNames(t) {
+		path := filepath.Join(src.dir, name)
+
+		// Ensure that path doesn't escape the lyrics directory.
+		if !strings.HasPrefix(path, src.dir+string(filepath.Separator)) {
+			continue
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errors.Wrap(err, "lrc: open lyrics file")
+		}
+		lyrics, err := Parse(f)
+		f.Close()
+		if err != nil {
+			return nil, errors.WithDetailf(
+				errors.Wrap(err, "lrc: parse lyrics file"),
+				"Path: %s", path,
+			)
+		}
+		return lyrics, nil
+	}
+	return nil, nil
+}
+
+func candidateNames(t *music.Track) []string {
+	var names []string
+	if id := t.ID; id != "" {
+		names = append(names, strings.TrimSuffix(id, filepath.Ext(id))+".lrc")
+	}
+	if len(t.Artists) > 0 {
+		name := t.Artists[0].Name + " - " + t.Name
+		names = append(names, sanitizeName(name)+".lrc")
+	}
+	return names
+}
+
+// sanitizeName replaces characters that are not allowed in file names.
+var sanitizeName = strings.NewReplacer(
+	"/", "_",
+	"\\", "_",
+	"\x00", "",
+).Replace
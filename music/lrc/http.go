@@ -0,0 +1,128 @@
+package lrc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/pkg/httputil"
+)
+
+// NewHTTPSource creates a music.LyricsSource that fetches lyrics over HTTP.
+//
+// The request URL is derived from tmpl, in which the following placeholders
+// are replaced with the (query-escaped) track attributes:
+//   - '{id}': the track ID
+//   - '{title}': the track name
+//   - '{artist}': the name of the track's first artist
+//   - '{album}': the name of the track's album
+//   - '{duration}': the track duration, in seconds
+//
+// Responses may either contain raw LRC text, or JSON in the format used by
+// LRCLIB (an object with 'syncedLyrics' and 'plainLyrics' fields). A 404
+// response indicates that there are no lyrics for a track.
+func NewHTTPSource(
+	tmpl string,
+	opts ...httputil.BasicClientOption,
+) (music.LyricsSource, error) {
+	if _, err := url.Parse(tmpl); err != nil {
+		return nil, errors.Wrap(err, "lrc: parse URL template")
+	}
+	cfg := httputil.BasicClientOptions{
+		HTTPClient: new(http.Client),
+	}
+	for _, apply := range opts {
+		apply(&cfg)
+	}
+	return httpSource{
+		client: cfg.HTTPClient,
+		tmpl:   tmpl,
+	}, nil
+}
+
+type httpSource struct {
+	client httputil.BasicClient
+	tmpl   string
+}
+
+var _ music.LyricsSource = (*httpSource)(nil)
+
+func (src httpSource) GetLyrics(
+	ctx context.Context,
+	t *music.Track,
+) (*music.Lyrics, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet, src.requestURL(t),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "lrc: create request")
+	}
+	res, err := src.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "lrc: perform request")
+	}
+	defer res.Body.Close()
+
+	switch code := res.StatusCode; {
+	case code == http.StatusNotFound:
+		return nil, nil
+	case code >= 300:
+		return nil, errors.Newf("lrc: bad response status '%s'", res.Status)
+	}
+
+	var body io.Reader = res.Body
+	ctype, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if ctype == "application/json" {
+		var data struct {
+			SyncedLyrics *string `json:"syncedLyrics"`
+			PlainLyrics  *string `json:"plainLyrics"`
+		}
+		if err = json.NewDecoder(res.Body).Decode(&data); err != nil {
+			return nil, errors.Wrap(err, "lrc: decode response as JSON")
+		}
+		switch {
+		case data.SyncedLyrics != nil:
+			body = strings.NewReader(*data.SyncedLyrics)
+		case data.PlainLyrics != nil:
+			body = strings.NewReader(*data.PlainLyrics)
+		default:
+			return nil, nil
+		}
+	}
+
+	lyrics, err := Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	if err = res.Body.Close(); err != nil {
+		return nil, errors.Wrap(err, "lrc: close response body")
+	}
+	return lyrics, nil
+}
+
+func (src httpSource) requestURL(t *music.Track) string {
+	var artist, album string
+	if len(t.Artists) > 0 {
+		artist = t.Artists[0].Name
+	}
+	if t.Album != nil {
+		album = t.Album.Name
+	}
+	return strings.NewReplacer(
+		"{id}", url.QueryEscape(t.ID),
+		"{title}", url.QueryEscape(t.Name),
+		"{artist}", url.QueryEscape(artist),
+		"{album}", url.QueryEscape(album),
+		"{duration}", strconv.Itoa(int(t.Duration.Seconds())),
+	).Replace(src.tmpl)
+}
@@ -0,0 +1,105 @@
+package lrc
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/music"
+)
+
+var (
+	_timeTagRegexp = regexp.MustCompile(`^\[(\d+):(\d{1,2})(?:[.:](\d{1,3}))?\]`)
+	_metaTagRegexp = regexp.MustCompile(`^\[([a-zA-Z#]+):(.*)\]$`)
+)
+
+// Parse parses LRC-formatted lyrics from r.
+//
+// If r contains no time tags, the resulting music.Lyrics will be unsynced.
+func Parse(r io.Reader) (*music.Lyrics, error) {
+	var (
+		lines  []music.LyricLine
+		plain  []string
+		offset time.Duration
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		// Parse metadata tags.
+		if m := _metaTagRegexp.FindStringSubmatch(line); m != nil {
+			if strings.EqualFold(m[1], "offset") {
+				ms, err := strconv.Atoi(strings.TrimSpace(m[2]))
+				if err != nil {
+					return nil, errors.Wrap(err, "lrc: parse offset tag")
+				}
+				offset = time.Duration(ms) * time.Millisecond
+			}
+			continue
+		}
+
+		// Parse time tags; a line may be prefixed by several of them.
+		var times []time.Duration
+		for {
+			m := _timeTagRegexp.FindStringSubmatch(line)
+			if m == nil {
+				break
+			}
+			times = append(times, parseTimeTag(m))
+			line = line[len(m[0]):]
+		}
+		line = strings.TrimSpace(line)
+
+		if len(times) == 0 {
+			if line != "" {
+				plain = append(plain, line)
+			}
+			continue
+		}
+		plain = append(plain, line)
+		for _, t := range times {
+			// A positive offset means that lyrics should appear sooner.
+			if t -= offset; t < 0 {
+				t = 0
+			}
+			lines = append(lines, music.LyricLine{Time: t, Text: line})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "lrc: read lyrics")
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Time < lines[j].Time
+	})
+	if lines == nil {
+		lines = []music.LyricLine{}
+	}
+	return &music.Lyrics{
+		Text:  strings.TrimSpace(strings.Join(plain, "\n")),
+		Lines: lines,
+	}, nil
+}
+
+// parseTimeTag parses the submatches of _timeTagRegexp into a time.Duration.
+func parseTimeTag(m []string) time.Duration {
+	mins, _ := strconv.Atoi(m[1])
+	secs, _ := strconv.Atoi(m[2])
+	t := time.Duration(mins)*time.Minute + time.Duration(secs)*time.Second
+	if frac := m[3]; frac != "" {
+		// Normalize fraction to milliseconds (i.e. '5' -> 500, '05' -> 50).
+		for len(frac) < 3 {
+			frac += "0"
+		}
+		ms, _ := strconv.Atoi(frac)
+		t += time.Duration(ms) * time.Millisecond
+	}
+	return t
+}
@@ -0,0 +1,70 @@
+package music
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+type (
+	// Lyrics are the lyrics of a Track.
+	Lyrics struct {
+		// Text is the plain-text representation of the lyrics.
+		Text string `json:"text"`
+
+		// Lines are the time-synced lines of the lyrics, sorted by time.
+		//
+		// Lines is empty if the lyrics are not synced.
+		Lines []LyricLine `json:"lines"`
+	}
+
+	// A LyricLine is a line of lyrics that is synced to a point in a Track.
+	LyricLine struct {
+		Time time.Duration `json:"time"`
+		Text string        `json:"text"`
+	}
+)
+
+// Synced returns true if the Lyrics are time-synced.
+func (l *Lyrics) Synced() bool { return len(l.Lines) > 0 }
+
+// LineAt returns the index of the line that is being sung at pos, or -1 if
+// no such line exists.
+func (l *Lyrics) LineAt(pos time.Duration) int {
+	return sort.Search(len(l.Lines), func(i int) bool {
+		return l.Lines[i].Time > pos
+	}) - 1
+}
+
+type (
+	// A LyricsSource can get the lyrics for a Track.
+	LyricsSource interface {
+		// GetLyrics returns nil if there are no lyrics for t.
+		GetLyrics(ctx context.Context, t *Track) (*Lyrics, error)
+	}
+
+	// A LyricsService can get the lyrics for a Track.
+	LyricsService interface {
+		GetTrackLyrics(ctx context.Context, t *Track) (*Lyrics, error)
+	}
+
+	// A LyricsStreamer can stream the currently sung LyricLine of my currently
+	// playing music.
+	LyricsStreamer interface {
+		StreamLyricLine(ctx context.Context, ch chan<- LyricLineResult) error
+	}
+)
+
+// A LyricLineResult is the result of a request for the currently sung
+// LyricLine.
+//
+// Line is nil if nothing is being sung.
+type LyricLineResult struct {
+	Line  *LyricLine
+	Error error
+}
+
+// HasError returns true if the LyricLineResult has an error.
+func (res LyricLineResult) HasError() bool {
+	return res.Error != nil
+}
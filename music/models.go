@@ -1,10 +1,6 @@
 package music
 
-import (
-	"time"
-
-	"github.com/zmb3/spotify"
-)
+import "time"
 
 // A Track is a unit of playable music.
 type Track struct {
@@ -38,5 +34,9 @@ type Album struct {
 	Artists []Artist `json:"artists"`
 }
 
-// An Image forwards a reference.
-type Image spotify.Image
+// An Image describes the cover image of an Album.
+type Image struct {
+	Height int    `json:"height"`
+	Width  int    `json:"width"`
+	URL    string `json:"url"`
+}
@@ -0,0 +1,188 @@
+package mpd
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/gopkg/name"
+)
+
+// Namespace is the package namespace, used for things like envvars.
+const Namespace = "mpd"
+
+// NewClient creates a new Client that connects to the MPD server at addr.
+//
+// If the environment variable 'MPD_PASSWORD' is set, it will be used to
+// authenticate with the server.
+func NewClient(addr string, opts ...ClientOption) (*Client, error) {
+	if addr == "" {
+		return nil, errors.New("mpd: addr must be non-empty")
+	}
+	opt := ClientOptions{
+		Timeout: 5 * time.Second,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return &Client{
+		addr:     addr,
+		password: os.Getenv(name.EnvKey(Namespace, "PASSWORD")),
+		dialer:   net.Dialer{Timeout: opt.Timeout},
+		timeout:  opt.Timeout,
+	}, nil
+}
+
+// ClientWithTimeout configures the timeout for each exchange between a Client
+// and the MPD server.
+func ClientWithTimeout(timeout time.Duration) ClientOption {
+	return func(opt *ClientOptions) { opt.Timeout = timeout }
+}
+
+type (
+	// A Client can send commands to an MPD server.
+	//
+	// Each call opens a new connection to the server, so a Client is safe for
+	// concurrent use.
+	Client struct {
+		addr     string
+		password string
+		dialer   net.Dialer
+		timeout  time.Duration
+	}
+
+	// ClientOptions configures a Client.
+	ClientOptions struct {
+		Timeout time.Duration
+	}
+
+	// A ClientOption modifies a ClientOptions.
+	ClientOption func(*ClientOptions)
+
+	// A Command is an MPD command, along with its arguments.
+	Command struct {
+		Name string
+		Args []string
+	}
+
+	// An Attr is a key-value pair in a response from the MPD server.
+	Attr struct {
+		Key   string
+		Value string
+	}
+)
+
+// Cmd creates a Command.
+func Cmd(name string, args ...string) Command {
+	return Command{Name: name, Args: args}
+}
+
+func (cmd Command) String() string {
+	var b strings.Builder
+	b.WriteString(cmd.Name)
+	for _, arg := range cmd.Args {
+		b.WriteByte(' ')
+		b.WriteString(quoteArg(arg))
+	}
+	return b.String()
+}
+
+// Do executes a Command, and returns the resulting response attributes.
+func (c *Client) Do(ctx context.Context, cmd Command) ([]Attr, error) {
+	return c.exchange(ctx, cmd.String())
+}
+
+// DoList executes a set of Commands atomically, as an MPD command list.
+func (c *Client) DoList(ctx context.Context, cmds ...Command) ([]Attr, error) {
+	lines := make([]string, 0, len(cmds)+2)
+	lines = append(lines, "command_list_begin")
+	for _, cmd := range cmds {
+		lines = append(lines, cmd.String())
+	}
+	lines = append(lines, "command_list_end")
+	return c.exchange(ctx, strings.Join(lines, "\n"))
+}
+
+func (c *Client) exchange(ctx context.Context, req string) ([]Attr, error) {
+	conn, err := c.dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "mpd: dial server")
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(c.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err = conn.SetDeadline(deadline); err != nil {
+		return nil, errors.Wrap(err, "mpd: set connection deadline")
+	}
+
+	// Read server greeting.
+	r := bufio.NewReader(conn)
+	greeting, err := r.ReadString('\n')
+	if err != nil {
+		return nil, errors.Wrap(err, "mpd: read greeting")
+	}
+	if !strings.HasPrefix(greeting, "OK MPD ") {
+		return nil, errors.Newf("mpd: unexpected greeting '%s'",
+			strings.TrimSpace(greeting))
+	}
+
+	// Authenticate, if required.
+	if c.password != "" {
+		if _, err = conn.Write([]byte(
+			Cmd("password", c.password).String() + "\n",
+		)); err != nil {
+			return nil, errors.Wrap(err, "mpd: write password")
+		}
+		if _, err = readResponse(r); err != nil {
+			return nil, errors.Wrap(err, "mpd: authenticate")
+		}
+	}
+
+	if _, err = conn.Write([]byte(req + "\n")); err != nil {
+		return nil, errors.Wrap(err, "mpd: write command")
+	}
+	return readResponse(r)
+}
+
+func readResponse(r *bufio.Reader) ([]Attr, error) {
+	var attrs []Attr
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, errors.Wrap(err, "mpd: read response")
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "OK":
+			return attrs, nil
+		case strings.HasPrefix(line, "ACK "):
+			return nil, errors.Newf("mpd: %s", strings.TrimPrefix(line, "ACK "))
+		}
+
+		i := strings.Index(line, ": ")
+		if i < 0 {
+			return nil, errors.Newf("mpd: malformed response line '%s'", line)
+		}
+		attrs = append(attrs, Attr{Key: line[:i], Value: line[i+2:]})
+	}
+}
+
+func quoteArg(arg string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range arg {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,115 @@
+package mpd
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/pkg/basic"
+)
+
+// NewController creates a new music.Controller.
+func NewController(c *Client, opts ...basic.Option) music.Controller {
+	opt := basic.BuildOptions(opts...)
+	return controller{
+		client: c,
+		log:    logutil.WithComponent(opt.Logger, (*controller)(nil)),
+		tracer: opt.Tracer,
+	}
+}
+
+type controller struct {
+	client *Client
+	log    *logrus.Entry
+	tracer opentracing.Tracer
+}
+
+var _ music.Controller = (*controller)(nil)
+
+func (ctrl controller) Play(ctx context.Context, s *music.Selector) error {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, ctrl.tracer,
+		name.OfFunc(controller.Play),
+	)
+	defer span.Finish()
+
+	log := logutil.
+		WithMethod(ctrl.log, controller.Play).
+		WithContext(ctx)
+
+	if s == nil {
+		log.Trace("Resuming current track.")
+		_, err := ctrl.client.Do(ctx, Cmd("play"))
+		return err
+	}
+
+	log = log.WithField("selector", *s)
+	if err := s.Validate(); err != nil {
+		log.WithError(err).Error("Invalid music.Selector.")
+		return errors.Wrap(err, "mpd: validate music.Selector")
+	}
+
+	enqueue := enqueueCmd(s)
+	log.WithField("command", enqueue).Trace("Derived enqueue command.")
+
+	// Replace the current queue with the selected resource, and play.
+	log.Trace("Playing specified resource...")
+	_, err := ctrl.client.DoList(ctx, Cmd("clear"), enqueue, Cmd("play"))
+	return err
+}
+
+func (ctrl controller) Pause(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, ctrl.tracer,
+		name.OfFunc(controller.Pause),
+	)
+	defer span.Finish()
+
+	_, err := ctrl.client.Do(ctx, Cmd("pause", "1"))
+	return err
+}
+
+func (ctrl controller) Queue(ctx context.Context, s *music.Selector) error {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, ctrl.tracer,
+		name.OfFunc(controller.Queue),
+	)
+	defer span.Finish()
+
+	log := logutil.
+		WithMethod(ctrl.log, controller.Queue).
+		WithContext(ctx).
+		WithField("selector", s)
+
+	if err := s.Validate(); err != nil {
+		log.WithError(err).Error("Invalid music.Selector.")
+		return errors.Wrap(err, "mpd: validate music.Selector")
+	}
+
+	log.Trace("Adding resource to queue...")
+	_, err := ctrl.client.Do(ctx, enqueueCmd(s))
+	return err
+}
+
+// enqueueCmd derives a Command that adds the resource selected by s to the
+// end of the queue.
+func enqueueCmd(s *music.Selector) Command {
+	if u := s.URI; u != nil {
+		return Cmd("add", *u)
+	}
+	switch kind, r := s.Resource(); kind {
+	case music.KindAlbum:
+		return Cmd("findadd", "album", r.ID)
+	case music.KindArtist:
+		return Cmd("findadd", "artist", r.ID)
+	case music.KindPlaylist:
+		return Cmd("load", r.ID)
+	default:
+		return Cmd("add", r.ID)
+	}
+}
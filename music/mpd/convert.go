@@ -0,0 +1,106 @@
+package mpd
+
+import (
+	"strconv"
+	"time"
+
+	"go.stevenxie.me/api/v2/music"
+)
+
+// songsFromAttrs splits attrs into a set of songs, each of which begins with
+// a 'file' attribute.
+func songsFromAttrs(attrs []Attr) [][]Attr {
+	var songs [][]Attr
+	for _, a := range attrs {
+		if a.Key == "file" {
+			songs = append(songs, nil)
+		}
+		if n := len(songs); n > 0 {
+			songs[n-1] = append(songs[n-1], a)
+		}
+	}
+	return songs
+}
+
+func tracksFromAttrs(dst *[]music.Track, attrs []Attr) {
+	songs := songsFromAttrs(attrs)
+	*dst = make([]music.Track, len(songs))
+	for i, song := range songs {
+		trackFromAttrs(&(*dst)[i], song)
+	}
+}
+
+func trackFromAttrs(dst *music.Track, attrs []Attr) {
+	var (
+		album        string
+		albumArtists []string
+	)
+	for _, a := range attrs {
+		switch a.Key {
+		case "file":
+			dst.ID = a.Value
+			dst.URI = a.Value
+		case "Title":
+			dst.Name = a.Value
+		case "Artist":
+			dst.Artists = append(dst.Artists, artistFromName(a.Value))
+		case "AlbumArtist":
+			albumArtists = append(albumArtists, a.Value)
+		case "Album":
+			album = a.Value
+		case "Time":
+			if dst.Duration == 0 {
+				if secs, err := strconv.Atoi(a.Value); err == nil {
+					dst.Duration = time.Duration(secs) * time.Second
+				}
+			}
+		case "duration":
+			dst.Duration = parseSeconds(a.Value)
+		}
+	}
+
+	// Fall back to the file name if the song is untagged.
+	if dst.Name == "" {
+		dst.Name = dst.ID
+	}
+	if dst.Artists == nil {
+		dst.Artists = []music.Artist{}
+	}
+
+	a := albumFromName(album)
+	if len(albumArtists) > 0 {
+		for _, name := range albumArtists {
+			a.Artists = append(a.Artists, artistFromName(name))
+		}
+	} else {
+		a.Artists = dst.Artists
+	}
+	dst.Album = &a
+}
+
+func albumFromName(name string) music.Album {
+	return music.Album{
+		ID:      name,
+		URI:     name,
+		Name:    name,
+		Images:  []music.Image{},
+		Artists: []music.Artist{},
+	}
+}
+
+func artistFromName(name string) music.Artist {
+	return music.Artist{
+		ID:   name,
+		URI:  name,
+		Name: name,
+	}
+}
+
+// parseSeconds parses a fractional number of seconds, as reported by MPD.
+func parseSeconds(s string) time.Duration {
+	secs, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(secs * float64(time.Second))
+}
@@ -0,0 +1,90 @@
+package mpd
+
+import (
+	"context"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/pkg/basic"
+)
+
+// NewCurrentService creates a new music.CurrentService.
+func NewCurrentService(c *Client, opts ...basic.Option) music.CurrentService {
+	cfg := basic.BuildOptions(opts...)
+	return currentService{
+		client: c,
+		log:    logutil.WithComponent(cfg.Logger, (*currentService)(nil)),
+		tracer: cfg.Tracer,
+	}
+}
+
+type currentService struct {
+	client *Client
+	log    *logrus.Entry
+	tracer opentracing.Tracer
+}
+
+var _ music.CurrentService = (*currentService)(nil)
+
+func (svc currentService) GetCurrent(ctx context.Context) (*music.CurrentlyPlaying,
+	error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(currentService.GetCurrent),
+	)
+	defer span.Finish()
+
+	log := logutil.
+		WithMethod(svc.log, currentService.GetCurrent).
+		WithContext(ctx)
+
+	log.Trace("Requesting player status and current song from MPD.")
+	attrs, err := svc.client.DoList(ctx, Cmd("status"), Cmd("currentsong"))
+	if err != nil {
+		log.WithError(err).Error("Failed to get current song from MPD.")
+		return nil, err
+	}
+	timestamp := time.Now()
+
+	// Split status attributes from song attributes.
+	var (
+		state   string
+		elapsed time.Duration
+		song    []Attr
+	)
+	for i, a := range attrs {
+		if a.Key == "file" {
+			song = attrs[i:]
+			break
+		}
+		switch a.Key {
+		case "state":
+			state = a.Value
+		case "elapsed":
+			elapsed = parseSeconds(a.Value)
+		}
+	}
+	if (state == "stop") || (len(song) == 0) {
+		log.Trace("Nothing is playing right now.")
+		return nil, nil
+	}
+
+	var track music.Track
+	trackFromAttrs(&track, song)
+	log.
+		WithField("track", track).
+		Trace("Marshal response to music.Track.")
+
+	return &music.CurrentlyPlaying{
+		Timestamp: timestamp,
+		Playing:   state == "play",
+		Progress:  elapsed,
+		Track:     track,
+	}, nil
+}
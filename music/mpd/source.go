@@ -0,0 +1,89 @@
+package mpd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/api/v2/music"
+)
+
+// NewSource creates a new music.Source.
+//
+// Since MPD does not assign IDs to albums and artists, they are identified by
+// their names; tracks are identified by their file URI.
+func NewSource(c *Client) music.Source {
+	return source{client: c}
+}
+
+type source struct {
+	client *Client
+}
+
+var _ music.Source = (*source)(nil)
+
+func (src source) GetTrack(ctx context.Context, id string) (*music.Track, error) {
+	attrs, err := src.client.Do(ctx, Cmd("find", "file", id))
+	if err != nil {
+		return nil, err
+	}
+	songs := songsFromAttrs(attrs)
+	if len(songs) == 0 {
+		return nil, errors.Newf("mpd: no such track '%s'", id)
+	}
+
+	t := new(music.Track)
+	trackFromAttrs(t, songs[0])
+	return t, nil
+}
+
+func (src source) GetAlbumTracks(
+	ctx context.Context,
+	id string,
+	opt music.PaginationOptions,
+) ([]music.Track, error) {
+	attrs, err := src.client.Do(ctx, Cmd(
+		"find", "album", id,
+		"window", fmt.Sprintf("%d:%d", opt.Offset, opt.Offset+opt.Limit),
+	))
+	if err != nil {
+		return nil, err
+	}
+	var ts []music.Track
+	tracksFromAttrs(&ts, attrs)
+	return ts, nil
+}
+
+func (src source) GetArtistAlbums(
+	ctx context.Context,
+	id string,
+	opt music.PaginationOptions,
+) ([]music.Album, error) {
+	attrs, err := src.client.Do(ctx, Cmd("list", "album", "artist", id))
+	if err != nil {
+		return nil, err
+	}
+
+	// MPD doesn't support paginating 'list' results, so paginate them
+	// manually.
+	var names []string
+	for _, a := range attrs {
+		if a.Key == "Album" && a.Value != "" {
+			names = append(names, a.Value)
+		}
+	}
+	if opt.Offset >= len(names) {
+		return []music.Album{}, nil
+	}
+	names = names[opt.Offset:]
+	if len(names) > opt.Limit {
+		names = names[:opt.Limit]
+	}
+
+	as := make([]music.Album, len(names))
+	for i, name := range names {
+		as[i] = albumFromName(name)
+		as[i].Artists = []music.Artist{artistFromName(id)}
+	}
+	return as, nil
+}
@@ -0,0 +1,104 @@
+package musiccard
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	stddraw "image/draw"
+	_ "image/jpeg" // register JPEG decoder for album art
+	"image/png"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+
+	"go.stevenxie.me/api/v2/music"
+)
+
+// RenderPNG renders a now-playing card for cp as a PNG, and writes it to w.
+//
+// cp may be nil, in which case the card will indicate that nothing is
+// playing.
+//
+// PNG cards are rendered with a fixed-width bitmap font, which only supports
+// ASCII characters.
+func (r *Renderer) RenderPNG(
+	ctx context.Context,
+	w io.Writer,
+	cp *music.CurrentlyPlaying,
+	t Theme,
+) error {
+	var (
+		c   = r.buildCard(ctx, cp)
+		img = image.NewRGBA(image.Rect(0, 0, Width, Height))
+	)
+	fill(img, img.Bounds(), t.Background)
+
+	// Draw album art.
+	artRect := image.Rect(_padding, _padding, _padding+ArtSize, _padding+ArtSize)
+	fill(img, artRect, t.Track)
+	if a := c.Art; a != nil {
+		src, _, err := image.Decode(bytes.NewReader(a.Data))
+		if err != nil {
+			r.log.WithError(err).Warn("Failed to decode album art.")
+		} else {
+			draw.ApproxBiLinear.Scale(
+				img, artRect,
+				src, src.Bounds(),
+				draw.Src, nil,
+			)
+		}
+	}
+
+	// Draw text.
+	const maxChars = _textWidth / 7 // each glyph is 7px wide
+	text := func(y int, col color.RGBA, s string) {
+		d := font.Drawer{
+			Dst:  img,
+			Src:  image.NewUniform(col),
+			Face: basicfont.Face7x13,
+			Dot:  fixed.P(_textX, y),
+		}
+		d.DrawString(truncate(s, maxChars))
+	}
+	if !c.Active {
+		text(Height/2+5, t.Foreground, c.Title)
+		return errors.Wrap(png.Encode(w, img), "musiccard: encode PNG")
+	}
+	text(_padding+11, t.Muted, c.Status())
+	text(_padding+36, t.Foreground, c.Title)
+	text(_padding+56, t.Muted, c.Artists)
+	text(_padding+74, t.Muted, c.Album)
+
+	// Draw progress bar.
+	const barY = _padding + ArtSize - 14
+	fill(img, image.Rect(_textX, barY, _textX+_textWidth, barY+4), t.Track)
+	filled := int(c.Progress() * _textWidth)
+	fill(img, image.Rect(_textX, barY, _textX+filled, barY+4), t.Accent)
+
+	var (
+		pos = formatDuration(c.Position)
+		dur = formatDuration(c.Duration)
+	)
+	text(_padding+ArtSize, t.Muted, pos)
+	{
+		d := font.Drawer{
+			Dst:  img,
+			Src:  image.NewUniform(t.Muted),
+			Face: basicfont.Face7x13,
+		}
+		x := _textX + _textWidth - d.MeasureString(dur).Round()
+		d.Dot = fixed.P(x, _padding+ArtSize)
+		d.DrawString(dur)
+	}
+
+	return errors.Wrap(png.Encode(w, img), "musiccard: encode PNG")
+}
+
+func fill(dst stddraw.Image, r image.Rectangle, c color.RGBA) {
+	stddraw.Draw(dst, r, image.NewUniform(c), image.Point{}, stddraw.Src)
+}
@@ -0,0 +1,301 @@
+package musiccard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/pkg/httputil"
+)
+
+// Card dimensions, in pixels.
+const (
+	Width   = 480
+	Height  = 144
+	ArtSize = Height - 2*_padding
+
+	_padding   = 16
+	_textX     = _padding + ArtSize + _padding
+	_textWidth = Width - _textX - _padding
+)
+
+// NewRenderer creates a new Renderer.
+func NewRenderer(opts ...RendererOption) *Renderer {
+	opt := RendererOptions{
+		Logger:       logutil.NoopEntry(),
+		HTTPClient:   &http.Client{Timeout: 5 * time.Second},
+		ArtCacheSize: 16,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return &Renderer{
+		httpc: opt.HTTPClient,
+		log:   logutil.WithComponent(opt.Logger, (*Renderer)(nil)),
+		cache: newArtCache(opt.ArtCacheSize),
+	}
+}
+
+// RendererWithLogger configures a Renderer to write logs with log.
+func RendererWithLogger(log *logrus.Entry) RendererOption {
+	return func(opt *RendererOptions) { opt.Logger = log }
+}
+
+// RendererWithHTTPClient configures a Renderer to fetch album art using c.
+func RendererWithHTTPClient(c httputil.BasicClient) RendererOption {
+	return func(opt *RendererOptions) { opt.HTTPClient = c }
+}
+
+type (
+	// A Renderer renders now-playing cards, which describe a
+	// music.CurrentlyPlaying.
+	Renderer struct {
+		httpc httputil.BasicClient
+		log   *logrus.Entry
+		cache *artCache
+	}
+
+	// RendererOptions configures a Renderer.
+	RendererOptions struct {
+		Logger       *logrus.Entry
+		HTTPClient   httputil.BasicClient
+		ArtCacheSize int
+	}
+
+	// A RendererOption modifies a RendererOptions.
+	RendererOption func(*RendererOptions)
+)
+
+// card is the content of a now-playing card.
+type card struct {
+	Active  bool // whether or not there is a current track
+	Playing bool
+
+	Title   string
+	Artists string
+	Album   string
+
+	Position time.Duration
+	Duration time.Duration
+
+	Art *art // nil if there is no album art
+}
+
+// Progress returns the fraction of the track that has been played.
+func (c *card) Progress() float64 {
+	if c.Duration <= 0 {
+		return 0
+	}
+	return float64(c.Position) / float64(c.Duration)
+}
+
+// Status returns a description of the playback status.
+func (c *card) Status() string {
+	if c.Playing {
+		return "Now playing"
+	}
+	return "Paused"
+}
+
+type art struct {
+	ContentType string
+	Data        []byte
+}
+
+// buildCard builds a card from cp, which may be nil.
+func (r *Renderer) buildCard(
+	ctx context.Context,
+	cp *music.CurrentlyPlaying,
+) *card {
+	if cp == nil {
+		return &card{Title: "Not playing"}
+	}
+
+	t := &cp.Track
+	c := card{
+		Active:   true,
+		Playing:  cp.Playing,
+		Title:    t.Name,
+		Position: cp.Position(time.Now()),
+		Duration: t.Duration,
+	}
+	{
+		names := make([]string, len(t.Artists))
+		for i, a := range t.Artists {
+			names[i] = a.Name
+		}
+		c.Artists = strings.Join(names, ", ")
+	}
+	if a := t.Album; a != nil {
+		c.Album = a.Name
+		if img := chooseImage(a.Images); img != nil {
+			art, err := r.fetchArt(ctx, img.URL)
+			if err != nil {
+				r.log.
+					WithError(err).
+					WithField("url", img.URL).
+					Warn("Failed to fetch album art; rendering without it.")
+			}
+			c.Art = art
+		}
+	}
+	return &c
+}
+
+// chooseImage chooses the smallest image that is large enough to be rendered
+// sharply on high-DPI displays, or the largest image if none are.
+func chooseImage(imgs []music.Image) *music.Image {
+	var best *music.Image
+	for i := range imgs {
+		img := &imgs[i]
+		switch {
+		case best == nil:
+			best = img
+		case best.Width < 2*ArtSize:
+			if img.Width > best.Width {
+				best = img
+			}
+		case (img.Width >= 2*ArtSize) && (img.Width < best.Width):
+			best = img
+		}
+	}
+	return best
+}
+
+func (r *Renderer) fetchArt(ctx context.Context, url string) (*art, error) {
+	if a, ok := r.cache.Get(url); ok {
+		return a, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "musiccard: create request")
+	}
+	res, err := r.httpc.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "musiccard: perform request")
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return nil, errors.Newf("musiccard: bad response status '%s'", res.Status)
+	}
+
+	// Read one byte past the limit, so that oversized art is rejected rather
+	// than truncated.
+	const maxSize = 1 << 20 // 1 MB
+	data, err := ioutil.ReadAll(io.LimitReader(res.Body, maxSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "musiccard: read response body")
+	}
+	if len(data) > maxSize {
+		return nil, errors.Newf("musiccard: art exceeds %d bytes", maxSize)
+	}
+	ctype := res.Header.Get("Content-Type")
+	if ctype == "" {
+		ctype = http.DetectContentType(data)
+	}
+	if !strings.HasPrefix(ctype, "image/") {
+		return nil, errors.Newf("musiccard: unexpected content type '%s'", ctype)
+	}
+
+	a := &art{ContentType: ctype, Data: data}
+	r.cache.Put(url, a)
+	return a, nil
+}
+
+// CacheDuration determines how long a card rendered for cp can be cached
+// for.
+//
+// While a track is playing, cards are cached until the track ends (so that
+// track changes are picked up promptly), for at most max. Otherwise, they are
+// cached for max.
+func CacheDuration(cp *music.CurrentlyPlaying, max time.Duration) time.Duration {
+	if (cp == nil) || !cp.Playing {
+		return max
+	}
+	remaining := cp.Track.Duration - cp.Position(time.Now())
+	switch {
+	case remaining < time.Second:
+		return time.Second
+	case remaining > max:
+		return max
+	default:
+		return remaining
+	}
+}
+
+// formatDuration formats d as 'm:ss'.
+func formatDuration(d time.Duration) string {
+	secs := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
+
+// truncate shortens s to at most n runes, adding an ellipsis if necessary.
+func truncate(s string, n int) string {
+	rs := []rune(s)
+	if len(rs) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(rs[:n-3])) + "..."
+}
+
+// artCache is a small, concurrent-safe LRU cache for album art.
+type artCache struct {
+	size int
+
+	mux   sync.Mutex
+	items map[string]*art
+	order []string // least recently used first
+}
+
+func newArtCache(size int) *artCache {
+	return &artCache{
+		size:  size,
+		items: make(map[string]*art, size),
+	}
+}
+
+func (c *artCache) Get(url string) (*art, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	a, ok := c.items[url]
+	if ok {
+		c.touch(url)
+	}
+	return a, ok
+}
+
+func (c *artCache) Put(url string, a *art) {
+	if c.size <= 0 {
+		return
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if _, ok := c.items[url]; !ok && (len(c.items) >= c.size) {
+		delete(c.items, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.items[url] = a
+	c.touch(url)
+}
+
+// touch marks url as the most recently used item; c.mux must be held.
+func (c *artCache) touch(url string) {
+	for i, u := range c.order {
+		if u == url {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	c.order = append(c.order, url)
+}
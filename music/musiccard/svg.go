@@ -0,0 +1,113 @@
+package musiccard
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/music"
+)
+
+const _fontFamily = "-apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica," +
+	"Arial,sans-serif"
+
+// RenderSVG renders a now-playing card for cp as an SVG, and writes it to w.
+//
+// cp may be nil, in which case the card will indicate that nothing is
+// playing.
+func (r *Renderer) RenderSVG(
+	ctx context.Context,
+	w io.Writer,
+	cp *music.CurrentlyPlaying,
+	t Theme,
+) error {
+	var (
+		c  = r.buildCard(ctx, cp)
+		bw = bufio.NewWriter(w)
+	)
+
+	fmt.Fprintf(
+		bw,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" `+
+			`viewBox="0 0 %d %d" font-family="%s">`,
+		Width, Height, Width, Height, _fontFamily,
+	)
+	fmt.Fprintf(
+		bw,
+		`<rect width="%d" height="%d" rx="8" fill="%s"/>`,
+		Width, Height, hexColor(t.Background),
+	)
+
+	// Draw album art.
+	if a := c.Art; a != nil {
+		fmt.Fprintf(
+			bw,
+			`<image x="%d" y="%d" width="%d" height="%d" href="data:%s;base64,%s"/>`,
+			_padding, _padding, ArtSize, ArtSize,
+			a.ContentType, base64.StdEncoding.EncodeToString(a.Data),
+		)
+	} else {
+		fmt.Fprintf(
+			bw,
+			`<rect x="%d" y="%d" width="%d" height="%d" rx="4" fill="%s"/>`,
+			_padding, _padding, ArtSize, ArtSize, hexColor(t.Track),
+		)
+	}
+
+	// Draw text.
+	text := func(y, size int, weight string, fill string, s string) {
+		fmt.Fprintf(
+			bw,
+			`<text x="%d" y="%d" font-size="%d" font-weight="%s" fill="%s">%s</text>`,
+			_textX, y, size, weight, fill, html.EscapeString(s),
+		)
+	}
+	var (
+		fg    = hexColor(t.Foreground)
+		muted = hexColor(t.Muted)
+	)
+	if !c.Active {
+		text(Height/2+6, 18, "600", fg, c.Title)
+		return errors.Wrap(finishSVG(bw), "musiccard: write SVG")
+	}
+	text(_padding+12, 11, "400", muted, c.Status())
+	text(_padding+38, 18, "600", fg, truncate(c.Title, 30))
+	text(_padding+60, 14, "400", muted, truncate(c.Artists, 40))
+	text(_padding+78, 12, "400", muted, truncate(c.Album, 48))
+
+	// Draw progress bar.
+	const barY = _padding + ArtSize - 14
+	fmt.Fprintf(
+		bw,
+		`<rect x="%d" y="%d" width="%d" height="4" rx="2" fill="%s"/>`,
+		_textX, barY, _textWidth, hexColor(t.Track),
+	)
+	fmt.Fprintf(
+		bw,
+		`<rect x="%d" y="%d" width="%.1f" height="4" rx="2" fill="%s"/>`,
+		_textX, barY, c.Progress()*_textWidth, hexColor(t.Accent),
+	)
+	fmt.Fprintf(
+		bw,
+		`<text x="%d" y="%d" font-size="11" fill="%s">%s</text>`,
+		_textX, _padding+ArtSize, muted, formatDuration(c.Position),
+	)
+	fmt.Fprintf(
+		bw,
+		`<text x="%d" y="%d" font-size="11" text-anchor="end" fill="%s">%s</text>`,
+		_textX+_textWidth, _padding+ArtSize, muted, formatDuration(c.Duration),
+	)
+	return errors.Wrap(finishSVG(bw), "musiccard: write SVG")
+}
+
+func finishSVG(bw *bufio.Writer) error {
+	if _, err := bw.WriteString("</svg>"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
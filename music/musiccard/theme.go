@@ -0,0 +1,82 @@
+package musiccard
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+)
+
+// A Theme describes the colors used to render a card.
+type Theme struct {
+	Background color.RGBA
+	Foreground color.RGBA
+	Muted      color.RGBA
+	Track      color.RGBA // the unfilled portion of the progress bar
+	Accent     color.RGBA // the filled portion of the progress bar
+}
+
+// DefaultTheme is the name of the Theme used when none is specified.
+const DefaultTheme = "light"
+
+// Themes are the set of available Themes, by name.
+var Themes = map[string]Theme{
+	"light": {
+		Background: rgb(0xffffff),
+		Foreground: rgb(0x24292e),
+		Muted:      rgb(0x6a737d),
+		Track:      rgb(0xe1e4e8),
+		Accent:     rgb(0x0366d6),
+	},
+	"dark": {
+		Background: rgb(0x0d1117),
+		Foreground: rgb(0xf0f6fc),
+		Muted:      rgb(0x8b949e),
+		Track:      rgb(0x30363d),
+		Accent:     rgb(0x58a6ff),
+	},
+	"spotify": {
+		Background: rgb(0x191414),
+		Foreground: rgb(0xffffff),
+		Muted:      rgb(0xb3b3b3),
+		Track:      rgb(0x404040),
+		Accent:     rgb(0x1db954),
+	},
+}
+
+// ThemeByName looks up a Theme by its name.
+//
+// If name is empty, the DefaultTheme is returned.
+func ThemeByName(name string) (Theme, error) {
+	if name == "" {
+		name = DefaultTheme
+	}
+	if t, ok := Themes[name]; ok {
+		return t, nil
+	}
+
+	names := make([]string, 0, len(Themes))
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return Theme{}, errors.WithHintf(
+		errors.Newf("musiccard: unknown theme '%s'", name),
+		"Available themes: %v", names,
+	)
+}
+
+func rgb(hex uint32) color.RGBA {
+	return color.RGBA{
+		R: uint8(hex >> 16),
+		G: uint8(hex >> 8),
+		B: uint8(hex),
+		A: 0xff,
+	}
+}
+
+// hexColor formats c as a CSS hex color.
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
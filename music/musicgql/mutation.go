@@ -38,3 +38,19 @@ func (mut Mutation) Pause(ctx context.Context) (bool, error) {
 	}
 	return true, nil
 }
+
+// ApproveRequest approves a pending music request.
+func (mut Mutation) ApproveRequest(
+	ctx context.Context,
+	id string,
+) (*music.Request, error) {
+	return mut.svc.ApproveRequest(ctx, id)
+}
+
+// RejectRequest rejects a pending music request.
+func (mut Mutation) RejectRequest(
+	ctx context.Context,
+	id string,
+) (*music.Request, error) {
+	return mut.svc.RejectRequest(ctx, id)
+}
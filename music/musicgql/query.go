@@ -3,20 +3,74 @@ package musicgql
 import (
 	"context"
 
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/auth"
 	"go.stevenxie.me/api/v2/music"
 )
 
 // NewQuery creates a new Query.
-func NewQuery(svc music.Service) Query {
-	return Query{svc: svc}
+func NewQuery(svc music.Service, auth auth.Service) Query {
+	return Query{
+		svc:  svc,
+		auth: auth,
+	}
 }
 
 // A Query resolves queries for my music-related data.
 type Query struct {
-	svc music.Service
+	svc  music.Service
+	auth auth.Service
 }
 
 // Current gets my current playing music information.
 func (q Query) Current(ctx context.Context) (*music.CurrentlyPlaying, error) {
 	return q.svc.GetCurrent(ctx)
 }
+
+// Requests gets pending music requests, as well as recently resolved ones.
+//
+// The names of requesters are only visible to the holder of a code that can
+// moderate requests.
+func (q Query) Requests(
+	ctx context.Context,
+	code *string,
+) ([]music.Request, error) {
+	reqs, err := q.svc.GetRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := canModerate(ctx, q.auth, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		reqs = redactRequests(reqs)
+	}
+	return reqs, nil
+}
+
+// canModerate reports whether the holder of code can moderate music requests
+// (i.e. whether they can control my music).
+func canModerate(
+	ctx context.Context,
+	auth auth.Service,
+	code *string,
+) (bool, error) {
+	if code == nil {
+		return false, nil
+	}
+	ok, err := auth.HasPermission(ctx, *code, music.PermControl)
+	if err != nil {
+		return false, errors.Wrap(err, "musicgql: checking permissions")
+	}
+	return ok, nil
+}
+
+func redactRequests(reqs []music.Request) []music.Request {
+	redacted := make([]music.Request, len(reqs))
+	for i := range reqs {
+		redacted[i] = reqs[i].Redacted()
+	}
+	return redacted
+}
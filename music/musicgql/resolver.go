@@ -8,13 +8,17 @@ import (
 )
 
 // NewTrackResolver creates a new TrackResolver.
-func NewTrackResolver(svc music.SourceService) TrackResolver {
-	return TrackResolver{svc: svc}
+func NewTrackResolver(
+	svc music.SourceService,
+	lyrics music.LyricsService,
+) TrackResolver {
+	return TrackResolver{svc: svc, lyrics: lyrics}
 }
 
 // A TrackResolver resolves fields for a music.Track.
 type TrackResolver struct {
-	svc music.SourceService
+	svc    music.SourceService
+	lyrics music.LyricsService
 }
 
 //revive:disable-line:exported
@@ -36,6 +40,14 @@ func (TrackResolver) Duration(_ context.Context, t *music.Track) (int, error) {
 	return int(t.Duration.Milliseconds()), nil
 }
 
+//revive:disable-line:exported
+func (res TrackResolver) Lyrics(
+	ctx context.Context,
+	t *music.Track,
+) (*music.Lyrics, error) {
+	return res.lyrics.GetTrackLyrics(ctx, t)
+}
+
 // NewAlbumResolver creates a new AlbumResolver.
 func NewAlbumResolver(svc music.SourceService) AlbumResolver {
 	return AlbumResolver{svc: svc}
@@ -106,3 +118,58 @@ func (CurrentlyPlayingResolver) Progress(
 ) (int, error) {
 	return int(cp.Progress.Milliseconds()), nil
 }
+
+// A LyricLineResolver resolves fields for a music.LyricLine.
+type LyricLineResolver zero.Struct
+
+//revive:disable-line:exported
+func (LyricLineResolver) Time(_ context.Context, l *music.LyricLine) (int, error) {
+	return int(l.Time.Milliseconds()), nil
+}
+
+// NewRequestResolver creates a new RequestResolver.
+func NewRequestResolver(svc music.SourceService) RequestResolver {
+	return RequestResolver{svc: svc}
+}
+
+// A RequestResolver resolves fields for a music.Request.
+type RequestResolver struct {
+	svc music.SourceService
+}
+
+// A RequestedResource describes the resource selected by a music.Request.
+type RequestedResource struct {
+	Kind *string `json:"kind"`
+	ID   *string `json:"id"`
+	URI  *string `json:"uri"`
+}
+
+//revive:disable-line:exported
+func (RequestResolver) Resource(
+	_ context.Context,
+	r *music.Request,
+) (*RequestedResource, error) {
+	s := &r.Selector
+	if s.URI != nil {
+		return &RequestedResource{URI: s.URI}, nil
+	}
+	kind, res := s.Resource()
+	k := string(kind)
+	return &RequestedResource{Kind: &k, ID: &res.ID}, nil
+}
+
+//revive:disable-line:exported
+func (res RequestResolver) Track(
+	ctx context.Context,
+	r *music.Request,
+) (*music.Track, error) {
+	if r.Selector.Track == nil {
+		return nil, nil
+	}
+	return res.svc.GetTrack(ctx, r.Selector.Track.ID)
+}
+
+//revive:disable-line:exported
+func (RequestResolver) Status(_ context.Context, r *music.Request) (string, error) {
+	return string(r.Status), nil
+}
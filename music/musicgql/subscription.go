@@ -4,19 +4,26 @@ import (
 	"context"
 
 	"github.com/99designs/gqlgen/graphql"
+
+	"go.stevenxie.me/api/v2/auth"
 	"go.stevenxie.me/api/v2/music"
 )
 
 // NewSubscriptionResolver creates a new SubscriptionResolver.
-func NewSubscriptionResolver(stream music.Streamer) SubscriptionResolver {
+func NewSubscriptionResolver(
+	stream music.Streamer,
+	auth auth.Service,
+) SubscriptionResolver {
 	return SubscriptionResolver{
 		stream: stream,
+		auth:   auth,
 	}
 }
 
 // A SubscriptionResolver resolves music-related GraphQL subscriptions.
 type SubscriptionResolver struct {
 	stream music.Streamer
+	auth   auth.Service
 }
 
 // CurrentlyPlaying opens a music.CurrentlyPlaying stream.
@@ -41,7 +48,10 @@ func (res SubscriptionResolver) CurrentlyPlaying(ctx context.Context) (
 			}
 			curr := res.Current
 			if !IsEqualsCurrentlyPlaying(prev, curr) {
-				dst <- curr
+				select {
+				case dst <- curr:
+				case <-ctx.Done():
+				}
 			}
 			prev = curr
 		}
@@ -53,3 +63,70 @@ func (res SubscriptionResolver) CurrentlyPlaying(ctx context.Context) (
 	}
 	return dst, nil
 }
+
+// LyricLine opens a stream of the currently sung music.LyricLine.
+func (res SubscriptionResolver) LyricLine(ctx context.Context) (
+	<-chan *music.LyricLine,
+	error,
+) {
+	var (
+		src = make(chan music.LyricLineResult, 1)
+		dst = make(chan *music.LyricLine, 1)
+	)
+
+	go func(
+		src <-chan music.LyricLineResult,
+		dst chan<- *music.LyricLine,
+	) {
+		for res := range src {
+			if res.HasError() {
+				graphql.AddError(ctx, res.Error)
+				continue
+			}
+			select {
+			case dst <- res.Line:
+			case <-ctx.Done():
+			}
+		}
+		close(dst)
+	}(src, dst)
+
+	if err := res.stream.StreamLyricLine(ctx, src); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// Requests opens a stream of the music request queue state.
+//
+// The names of requesters are only visible to the holder of a code that can
+// moderate requests.
+func (res SubscriptionResolver) Requests(
+	ctx context.Context,
+	code *string,
+) (<-chan []music.Request, error) {
+	ok, err := canModerate(ctx, res.auth, code)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan []music.Request, 1)
+	if err := res.stream.StreamRequests(ctx, ch); err != nil {
+		return nil, err
+	}
+	if ok {
+		return ch, nil
+	}
+
+	redacted := make(chan []music.Request, 1)
+	go func() {
+		defer close(redacted)
+		for reqs := range ch {
+			select {
+			case redacted <- redactRequests(reqs):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return redacted, nil
+}
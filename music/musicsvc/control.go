@@ -69,3 +69,17 @@ func (svc controlService) Pause(ctx context.Context) error {
 	}
 	return nil
 }
+
+func (svc controlService) Queue(ctx context.Context, s music.Selector) error {
+	log := logutil.
+		WithMethod(svc.log, controlService.Queue).
+		WithContext(ctx).
+		WithField("selector", s)
+
+	log.Trace("Adding the selected resource to the queue...")
+	if err := svc.ctrl.Queue(ctx, &s); err != nil {
+		log.WithError(err).Error("Failed to queue resource.")
+		return err
+	}
+	return nil
+}
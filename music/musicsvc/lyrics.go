@@ -0,0 +1,68 @@
+package musicsvc
+
+import (
+	"context"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/pkg/basic"
+)
+
+// NewLyricsService creates a new music.LyricsService.
+//
+// Sources are queried in order, until one of them has lyrics for the
+// requested track.
+func NewLyricsService(
+	srcs []music.LyricsSource,
+	opts ...basic.Option,
+) music.LyricsService {
+	cfg := basic.BuildOptions(opts...)
+	return lyricsService{
+		srcs:   srcs,
+		log:    logutil.WithComponent(cfg.Logger, (*lyricsService)(nil)),
+		tracer: cfg.Tracer,
+	}
+}
+
+type lyricsService struct {
+	srcs   []music.LyricsSource
+	log    *logrus.Entry
+	tracer opentracing.Tracer
+}
+
+var _ music.LyricsService = (*lyricsService)(nil)
+
+func (svc lyricsService) GetTrackLyrics(
+	ctx context.Context,
+	t *music.Track,
+) (*music.Lyrics, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(lyricsService.GetTrackLyrics),
+	)
+	defer span.Finish()
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(lyricsService.GetTrackLyrics),
+		"id":              t.ID,
+	}).WithContext(ctx)
+
+	log.Trace("Getting track lyrics...")
+	for _, src := range svc.srcs {
+		lyrics, err := src.GetLyrics(ctx, t)
+		if err != nil {
+			log.WithError(err).Error("Failed to get track lyrics.")
+			return nil, err
+		}
+		if lyrics != nil {
+			log.WithField("synced", lyrics.Synced()).Trace("Got track lyrics.")
+			return lyrics, nil
+		}
+	}
+	log.Trace("No lyrics found for track.")
+	return nil, nil
+}
@@ -0,0 +1,368 @@
+package musicsvc
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/pkg/fanout"
+)
+
+// NewRequestService creates a new RequestService, which keeps its Requests in
+// memory and adds approved Requests to the play queue using ctrl.
+func NewRequestService(
+	ctrl music.ControlService,
+	opts ...RequestServiceOption,
+) *RequestService {
+	opt := RequestServiceOptions{
+		Logger:         logutil.NoopEntry(),
+		Tracer:         new(opentracing.NoopTracer),
+		RateLimit:      3,
+		RateWindow:     time.Hour,
+		MaxPending:     25,
+		RetainResolved: 25,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	log := logutil.WithComponent(opt.Logger, (*RequestService)(nil))
+	svc := &RequestService{
+		ctrl:      ctrl,
+		log:       log,
+		tracer:    opt.Tracer,
+		opt:       opt,
+		approving: make(map[string]zero.Struct),
+		history:   make(map[string][]time.Time),
+		hub: fanout.NewHub(
+			fanout.HubWithLogger(log),
+			fanout.HubWithReplay(true),
+		),
+	}
+	svc.hub.Publish(svc.snapshot())
+	return svc
+}
+
+// RequestServiceWithLogger configures a RequestService to write logs with log.
+func RequestServiceWithLogger(log *logrus.Entry) RequestServiceOption {
+	return func(opt *RequestServiceOptions) { opt.Logger = log }
+}
+
+// RequestServiceWithTracer configures a RequestService to trace calls with t.
+func RequestServiceWithTracer(t opentracing.Tracer) RequestServiceOption {
+	return func(opt *RequestServiceOptions) { opt.Tracer = t }
+}
+
+// RequestServiceWithRateLimit configures a RequestService to accept at most
+// limit Requests per code within each window.
+func RequestServiceWithRateLimit(
+	limit int,
+	window time.Duration,
+) RequestServiceOption {
+	return func(opt *RequestServiceOptions) {
+		opt.RateLimit = limit
+		opt.RateWindow = window
+	}
+}
+
+// RequestServiceWithMaxPending configures the maximum number of pending
+// Requests that a RequestService will hold.
+func RequestServiceWithMaxPending(max int) RequestServiceOption {
+	return func(opt *RequestServiceOptions) { opt.MaxPending = max }
+}
+
+type (
+	// A RequestService implements a music.RequestService and a
+	// music.RequestStreamer.
+	RequestService struct {
+		ctrl   music.ControlService
+		log    *logrus.Entry
+		tracer opentracing.Tracer
+		opt    RequestServiceOptions
+		hub    *fanout.Hub
+
+		mux       sync.Mutex
+		seq       int
+		reqs      []music.Request // ordered by creation time
+		approving map[string]zero.Struct
+		history   map[string][]time.Time // submission times, by code
+	}
+
+	// RequestServiceOptions configures a RequestService.
+	RequestServiceOptions struct {
+		Logger *logrus.Entry
+		Tracer opentracing.Tracer
+
+		RateLimit      int
+		RateWindow     time.Duration
+		MaxPending     int
+		RetainResolved int
+	}
+
+	// A RequestServiceOption modifies a RequestServiceOptions.
+	RequestServiceOption func(*RequestServiceOptions)
+)
+
+var (
+	_ music.RequestService  = (*RequestService)(nil)
+	_ music.RequestStreamer = (*RequestService)(nil)
+)
+
+// SubmitRequest implements music.RequestService.
+func (svc *RequestService) SubmitRequest(
+	ctx context.Context,
+	code string, s music.Selector,
+	opts ...music.SubmitRequestOption,
+) (*music.Request, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc((*RequestService).SubmitRequest),
+	)
+	defer span.Finish()
+
+	var opt music.SubmitRequestOptions
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod((*RequestService).SubmitRequest),
+		"selector":        s,
+	}).WithContext(ctx)
+
+	if err := s.Validate(); err != nil {
+		return nil, errors.Wrap(err, "musicsvc: validate music.Selector")
+	}
+
+	// Only tracks can be added to the play queue, so reject other kinds of
+	// resources before they count against the rate limit.
+	if kind := s.Kind(); kind != music.KindTrack {
+		log.WithField("kind", kind).Info("Rejected request for a non-track.")
+		return nil, music.ErrRequestNotTrack
+	}
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	// Enforce rate limits.
+	now := time.Now()
+	history := svc.history[code][:0]
+	for _, t := range svc.history[code] {
+		if now.Sub(t) < svc.opt.RateWindow {
+			history = append(history, t)
+		}
+	}
+	svc.history[code] = history
+	if len(history) >= svc.opt.RateLimit {
+		log.Info("Rejected request due to rate limiting.")
+		return nil, music.ErrRequestRateLimited
+	}
+	if svc.countPending() >= svc.opt.MaxPending {
+		log.Info("Rejected request since the queue is full.")
+		return nil, music.ErrRequestQueueFull
+	}
+	svc.history[code] = append(history, now)
+
+	// Add request to queue.
+	svc.seq++
+	req := music.Request{
+		ID:        strconv.Itoa(svc.seq),
+		Selector:  s,
+		Requester: opt.Requester,
+		Status:    music.RequestPending,
+		CreatedAt: now,
+	}
+	svc.reqs = append(svc.reqs, req)
+	svc.publish()
+
+	log.WithField("id", req.ID).Info("Received music request.")
+	return &req, nil
+}
+
+// GetRequests implements music.RequestService.
+func (svc *RequestService) GetRequests(context.Context) ([]music.Request, error) {
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+	return svc.snapshot(), nil
+}
+
+// ApproveRequest implements music.RequestService.
+func (svc *RequestService) ApproveRequest(
+	ctx context.Context,
+	id string,
+) (*music.Request, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc((*RequestService).ApproveRequest),
+	)
+	defer span.Finish()
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod((*RequestService).ApproveRequest),
+		"id":              id,
+	}).WithContext(ctx)
+
+	// Claim the request, so that it isn't resolved concurrently while its
+	// resource is being queued.
+	svc.mux.Lock()
+	req, err := svc.findPending(id)
+	if err != nil {
+		svc.mux.Unlock()
+		return nil, err
+	}
+	svc.approving[id] = zero.Empty()
+	svc.mux.Unlock()
+
+	log.Trace("Adding requested resource to queue...")
+	err = svc.ctrl.Queue(ctx, req.Selector)
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+	delete(svc.approving, id)
+	if err != nil {
+		log.WithError(err).Error("Failed to queue requested resource.")
+		return nil, errors.WithMessage(err, "musicsvc: queue requested resource")
+	}
+	return svc.resolve(id, music.RequestApproved), nil
+}
+
+// RejectRequest implements music.RequestService.
+func (svc *RequestService) RejectRequest(
+	ctx context.Context,
+	id string,
+) (*music.Request, error) {
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+	if _, err := svc.findPending(id); err != nil {
+		return nil, err
+	}
+	svc.log.
+		WithField(logutil.MethodKey, name.OfMethod((*RequestService).RejectRequest)).
+		WithField("id", id).
+		WithContext(ctx).
+		Info("Rejected music request.")
+	return svc.resolve(id, music.RequestRejected), nil
+}
+
+// StreamRequests implements music.RequestStreamer.
+//
+// The current state of the queue is sent immediately, and then again whenever
+// it changes. ch is closed once ctx is done.
+func (svc *RequestService) StreamRequests(
+	ctx context.Context,
+	ch chan<- []music.Request,
+) error {
+	if ch == nil {
+		panic(errors.New("musicsvc: nil channel"))
+	}
+	send := func(ctx context.Context, v zero.Interface) bool {
+		select {
+		case ch <- v.([]music.Request):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	if err := svc.hub.Subscribe(
+		ctx, send,
+		func() { close(ch) },
+	); err != nil {
+		return errors.Wrap(err, "musicsvc: subscribe to requests")
+	}
+	return nil
+}
+
+// findPending finds the pending request with the specified id; svc.mux must
+// be held.
+func (svc *RequestService) findPending(id string) (*music.Request, error) {
+	for i := range svc.reqs {
+		req := &svc.reqs[i]
+		if req.ID != id {
+			continue
+		}
+		if req.Status != music.RequestPending {
+			return nil, music.ErrRequestResolved
+		}
+		if _, ok := svc.approving[id]; ok {
+			return nil, errors.WithDetail(
+				music.ErrRequestResolved,
+				"Request is being approved.",
+			)
+		}
+		r := *req
+		return &r, nil
+	}
+	return nil, music.ErrRequestNotFound
+}
+
+// resolve marks the request with the specified id as resolved; svc.mux must
+// be held.
+func (svc *RequestService) resolve(
+	id string,
+	status music.RequestStatus,
+) *music.Request {
+	var resolved music.Request
+	now := time.Now()
+	for i := range svc.reqs {
+		if req := &svc.reqs[i]; req.ID == id {
+			req.Status = status
+			req.ResolvedAt = &now
+			resolved = *req
+			break
+		}
+	}
+
+	// Prune the oldest resolved requests.
+	var (
+		excess = -svc.opt.RetainResolved
+		reqs   = svc.reqs[:0]
+	)
+	for _, req := range svc.reqs {
+		if req.Status != music.RequestPending {
+			excess++
+		}
+	}
+	for _, req := range svc.reqs {
+		if (excess > 0) && (req.Status != music.RequestPending) {
+			excess--
+			continue
+		}
+		reqs = append(reqs, req)
+	}
+	svc.reqs = reqs
+
+	svc.publish()
+	return &resolved
+}
+
+// countPending counts the pending requests; svc.mux must be held.
+func (svc *RequestService) countPending() (n int) {
+	for _, req := range svc.reqs {
+		if req.Status == music.RequestPending {
+			n++
+		}
+	}
+	return n
+}
+
+// snapshot copies the current requests; svc.mux must be held.
+func (svc *RequestService) snapshot() []music.Request {
+	reqs := make([]music.Request, len(svc.reqs))
+	copy(reqs, svc.reqs)
+	return reqs
+}
+
+// publish sends a snapshot of the current requests to all subscribers; svc.mux
+// must be held, so that snapshots are published in order.
+func (svc *RequestService) publish() {
+	svc.hub.Publish(svc.snapshot())
+}
@@ -7,11 +7,15 @@ func NewService(
 	src music.SourceService,
 	curr music.CurrentService,
 	ctrl music.ControlService,
+	lyrics music.LyricsService,
+	reqs music.RequestService,
 ) music.Service {
 	return service{
 		SourceService:  src,
 		CurrentService: curr,
 		ControlService: ctrl,
+		LyricsService:  lyrics,
+		RequestService: reqs,
 	}
 }
 
@@ -19,6 +23,29 @@ type service struct {
 	music.SourceService
 	music.CurrentService
 	music.ControlService
+	music.LyricsService
+	music.RequestService
 }
 
 var _ music.Service = (*service)(nil)
+
+// NewStreamer creates a new music.Streamer.
+func NewStreamer(
+	curr music.CurrentStreamer,
+	lyrics music.LyricsStreamer,
+	reqs music.RequestStreamer,
+) music.Streamer {
+	return streamer{
+		CurrentStreamer: curr,
+		LyricsStreamer:  lyrics,
+		RequestStreamer: reqs,
+	}
+}
+
+type streamer struct {
+	music.CurrentStreamer
+	music.LyricsStreamer
+	music.RequestStreamer
+}
+
+var _ music.Streamer = (*streamer)(nil)
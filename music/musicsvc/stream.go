@@ -2,41 +2,66 @@ package musicsvc
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/sirupsen/logrus"
 
 	"go.stevenxie.me/api/v2/music"
-	"go.stevenxie.me/api/v2/pkg/poll"
+	"go.stevenxie.me/api/v2/pkg/fanout"
 	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/zero"
 )
 
-// NewCurrentStreamer creates a new CurrentService.
+// NewCurrentStreamer creates a new CurrentStreamer.
+//
+// The CurrentStreamer only polls curr while it has subscribers.
 func NewCurrentStreamer(
 	curr music.CurrentService,
 	opts ...CurrentStreamerOption,
 ) CurrentStreamer {
 	opt := CurrentStreamerOptions{
-		Logger: logutil.NoopEntry(),
+		Logger:           logutil.NoopEntry(),
+		PollInterval:     time.Second,
+		FastPollInterval: 250 * time.Millisecond,
+		IdlePollInterval: 5 * time.Second,
+		BufferSize:       4,
 	}
 	for _, apply := range opts {
 		apply(&opt)
 	}
-	log := logutil.WithComponent(opt.Logger, (*CurrentStreamer)(nil))
+
 	var (
-		actor  = newCurrentStreamActor(curr, log)
-		poller = poll.NewPoller(
-			actor, opt.PollInterval,
-			poll.PollerWithLogger(log),
+		log  = logutil.WithComponent(opt.Logger, (*CurrentStreamer)(nil))
+		wake = make(chan zero.Struct, 1)
+		hub  = fanout.NewHub(
+			fanout.HubWithLogger(log),
+			fanout.HubWithBufferSize(opt.BufferSize),
+			fanout.HubWithReplay(true),
+			fanout.HubWithForgetWhenIdle(true),
+			fanout.HubWithSubscriberHook(func(n int) {
+				if n != 1 {
+					return
+				}
+				select {
+				case wake <- zero.Empty():
+				default:
+				}
+			}),
 		)
 	)
-	return CurrentStreamer{
+	stream := CurrentStreamer{
 		curr: curr,
-		poll: poller,
-		act:  actor,
+		hub:  hub,
 		log:  log,
+		opt:  opt,
+		wake: wake,
+		stop: make(chan zero.Struct),
+		once: new(sync.Once),
 	}
+	go stream.run()
+	return stream
 }
 
 // StreamerWithLogger configures a CurrentStreamer to write logs with
@@ -46,24 +71,56 @@ func StreamerWithLogger(log *logrus.Entry) CurrentStreamerOption {
 }
 
 // StreamerWithPollInterval configures the interval at which a
-// CurrentStreamer polls for changes.
+// CurrentStreamer polls for changes while music is playing.
 func StreamerWithPollInterval(interval time.Duration) CurrentStreamerOption {
 	return func(opt *CurrentStreamerOptions) { opt.PollInterval = interval }
 }
 
+// StreamerWithFastPollInterval configures the interval at which a
+// CurrentStreamer polls for changes when the current track is about to end.
+func StreamerWithFastPollInterval(
+	interval time.Duration,
+) CurrentStreamerOption {
+	return func(opt *CurrentStreamerOptions) { opt.FastPollInterval = interval }
+}
+
+// StreamerWithIdlePollInterval configures the interval at which a
+// CurrentStreamer polls for changes when music is paused, or nothing is
+// playing.
+func StreamerWithIdlePollInterval(
+	interval time.Duration,
+) CurrentStreamerOption {
+	return func(opt *CurrentStreamerOptions) { opt.IdlePollInterval = interval }
+}
+
+// StreamerWithBufferSize configures the number of results that a
+// CurrentStreamer buffers for each subscriber.
+//
+// If a subscriber falls behind, its oldest buffered results are dropped.
+func StreamerWithBufferSize(size int) CurrentStreamerOption {
+	return func(opt *CurrentStreamerOptions) { opt.BufferSize = size }
+}
+
 type (
 	// A CurrentStreamer can stream information about my currently playing music.
 	CurrentStreamer struct {
 		curr music.CurrentService
-		poll *poll.Poller
-		act  *currentStreamActor
+		hub  *fanout.Hub
 		log  *logrus.Entry
+		opt  CurrentStreamerOptions
+
+		wake chan zero.Struct
+		stop chan zero.Struct
+		once *sync.Once
 	}
 
-	// A CurrentStreamerOptions configures a Service.
+	// A CurrentStreamerOptions configures a CurrentStreamer.
 	CurrentStreamerOptions struct {
-		Logger       *logrus.Entry
-		PollInterval time.Duration
+		Logger           *logrus.Entry
+		PollInterval     time.Duration
+		FastPollInterval time.Duration
+		IdlePollInterval time.Duration
+		BufferSize       int
 	}
 
 	// A CurrentStreamerOption modifies a CurrentStreamerOptions.
@@ -73,6 +130,8 @@ type (
 var _ music.CurrentStreamer = (*CurrentStreamer)(nil)
 
 // StreamCurrent implements music.CurrentStreamer.
+//
+// ch is closed once ctx is done, or the CurrentStreamer is stopped.
 func (stream CurrentStreamer) StreamCurrent(
 	ctx context.Context,
 	ch chan<- music.CurrentlyPlayingResult,
@@ -80,18 +139,103 @@ func (stream CurrentStreamer) StreamCurrent(
 	if ch == nil {
 		panic(errors.New("musicsvc: nil channel"))
 	}
-	stream.act.AddSub(ch)
-
-	// Wait for context to complete, then remove actor.
-	go func() {
-		<-ctx.Done()
-		stream.act.DelSub(ch)
-	}()
+	send := func(ctx context.Context, v zero.Interface) bool {
+		select {
+		case ch <- v.(music.CurrentlyPlayingResult):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	if err := stream.hub.Subscribe(
+		ctx, send,
+		func() { close(ch) },
+	); err != nil {
+		return errors.Wrap(err, "musicsvc: subscribe to stream")
+	}
 	return nil
 }
 
 // Stop stops the CurrentStreamer.
 func (stream CurrentStreamer) Stop() {
-	stream.poll.Stop()
-	stream.act.Close()
+	stream.once.Do(func() {
+		close(stream.stop)
+		stream.hub.Close()
+	})
+}
+
+func (stream CurrentStreamer) run() {
+	log := logutil.WithMethod(stream.log, CurrentStreamer.run)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stream.stop
+		cancel()
+	}()
+
+	timer := time.NewTimer(0)
+	for {
+		// Wait for subscribers before polling.
+		if stream.hub.Len() == 0 {
+			log.Trace("No subscribers; waiting...")
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			select {
+			case <-stream.stop:
+				return
+			case <-stream.wake:
+				continue
+			}
+		}
+
+		log.Trace("Polling for currently playing music...")
+		cp, err := stream.curr.GetCurrent(ctx)
+		stream.hub.Publish(music.CurrentlyPlayingResult{
+			Current: cp,
+			Error:   err,
+		})
+
+		interval := stream.nextInterval(cp, err)
+		log.
+			WithField("interval", interval).
+			Trace("Scheduled next poll.")
+		timer.Reset(interval)
+		select {
+		case <-stream.stop:
+			timer.Stop()
+			return
+		case <-stream.wake:
+			// A first subscriber joined while waiting; poll immediately rather
+			// than leaving it without results until the timer fires.
+			if !timer.Stop() {
+				<-timer.C
+			}
+		case <-timer.C:
+		}
+	}
+}
+
+// nextInterval determines how long to wait before polling again, based on
+// the latest poll results.
+func (stream CurrentStreamer) nextInterval(
+	cp *music.CurrentlyPlaying,
+	err error,
+) time.Duration {
+	opt := &stream.opt
+	if (err != nil) || (cp == nil) || !cp.Playing {
+		return opt.IdlePollInterval
+	}
+
+	// Poll more frequently as the current track nears its end, so that track
+	// changes are picked up quickly.
+	remaining := cp.Track.Duration - cp.Position(time.Now())
+	if remaining <= opt.PollInterval {
+		return opt.FastPollInterval
+	}
+	return opt.PollInterval
 }
@@ -0,0 +1,157 @@
+package musicsvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/pkg/basic"
+)
+
+// NewLyricsStreamer creates a new music.LyricsStreamer, which derives the
+// currently sung line from the music.CurrentlyPlaying values streamed by curr.
+func NewLyricsStreamer(
+	curr music.CurrentStreamer,
+	lyrics music.LyricsService,
+	opts ...basic.Option,
+) music.LyricsStreamer {
+	cfg := basic.BuildOptions(opts...)
+	return lyricsStreamer{
+		curr:   curr,
+		lyrics: lyrics,
+		log:    logutil.WithComponent(cfg.Logger, (*lyricsStreamer)(nil)),
+	}
+}
+
+type lyricsStreamer struct {
+	curr   music.CurrentStreamer
+	lyrics music.LyricsService
+	log    *logrus.Entry
+}
+
+var _ music.LyricsStreamer = (*lyricsStreamer)(nil)
+
+// StreamLyricLine implements music.LyricsStreamer.
+//
+// A result is sent each time the currently sung line changes; ch is closed
+// once ctx is done, or the underlying music.CurrentStreamer stops.
+func (stream lyricsStreamer) StreamLyricLine(
+	ctx context.Context,
+	ch chan<- music.LyricLineResult,
+) error {
+	if ch == nil {
+		panic(errors.New("musicsvc: nil channel"))
+	}
+	src := make(chan music.CurrentlyPlayingResult, 1)
+	if err := stream.curr.StreamCurrent(ctx, src); err != nil {
+		return err
+	}
+	go stream.run(ctx, src, ch)
+	return nil
+}
+
+func (stream lyricsStreamer) run(
+	ctx context.Context,
+	src <-chan music.CurrentlyPlayingResult,
+	dst chan<- music.LyricLineResult,
+) {
+	defer close(dst)
+	log := logutil.
+		WithMethod(stream.log, lyricsStreamer.run).
+		WithContext(ctx)
+
+	send := func(res music.LyricLineResult) bool {
+		select {
+		case dst <- res:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var (
+		cp        *music.CurrentlyPlaying
+		trackID   string
+		lyrics    *music.Lyrics
+		line      = -1
+		lineTrack string
+		started   bool
+
+		timer = time.NewTimer(time.Hour)
+	)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case res, ok := <-src:
+			if !ok {
+				return
+			}
+			if res.HasError() {
+				if !send(music.LyricLineResult{Error: res.Error}) {
+					return
+				}
+				continue
+			}
+
+			// Load lyrics whenever the track changes.
+			cp = res.Current
+			if cp == nil {
+				trackID, lyrics = "", nil
+			} else if cp.Track.ID != trackID {
+				trackID = cp.Track.ID
+				var err error
+				if lyrics, err = stream.lyrics.GetTrackLyrics(
+					ctx,
+					&cp.Track,
+				); err != nil {
+					log.WithError(err).Error("Failed to get track lyrics.")
+					if !send(music.LyricLineResult{Error: err}) {
+						return
+					}
+				}
+			}
+		case <-timer.C:
+		}
+
+		// Determine the current line, and when the next line starts.
+		idx, wait := -1, time.Duration(-1)
+		if (cp != nil) && (lyrics != nil) && lyrics.Synced() {
+			pos := cp.Position(time.Now())
+			idx = lyrics.LineAt(pos)
+			if next := idx + 1; cp.Playing && (next < len(lyrics.Lines)) {
+				wait = lyrics.Lines[next].Time - pos
+			}
+		}
+
+		if !started || (idx != line) || (trackID != lineTrack) {
+			line, lineTrack, started = idx, trackID, true
+			var res music.LyricLineResult
+			if idx >= 0 {
+				l := lyrics.Lines[idx]
+				res.Line = &l
+			}
+			if !send(res) {
+				return
+			}
+		}
+
+		// Schedule an update for when the next line starts.
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if wait >= 0 {
+			timer.Reset(wait)
+		}
+	}
+}
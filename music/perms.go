@@ -5,4 +5,5 @@ import "go.stevenxie.me/api/v2/auth"
 // Valid permissions corresponding to this package.
 const (
 	PermControl auth.Permission = "music.control"
+	PermRequest auth.Permission = "music.request"
 )
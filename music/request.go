@@ -0,0 +1,118 @@
+package music
+
+import (
+	"context"
+	stderrs "errors"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// A Request is a request for a music resource to be added to my play queue,
+// which must be approved before it takes effect.
+type Request struct {
+	ID        string        `json:"id"`
+	Selector  Selector      `json:"selector"`
+	Requester *string       `json:"requester"`
+	Status    RequestStatus `json:"status"`
+
+	CreatedAt  time.Time  `json:"createdAt"`
+	ResolvedAt *time.Time `json:"resolvedAt"`
+}
+
+// Redacted returns a copy of the Request without the name of its requester,
+// for viewers who cannot moderate Requests.
+func (r *Request) Redacted() Request {
+	redacted := *r
+	redacted.Requester = nil
+	return redacted
+}
+
+// A RequestStatus describes the moderation status of a Request.
+type RequestStatus string
+
+// The set of valid RequestStatuses.
+const (
+	RequestPending  RequestStatus = "PENDING"
+	RequestApproved RequestStatus = "APPROVED"
+	RequestRejected RequestStatus = "REJECTED"
+)
+
+// SubmitWithRequester configures RequestService.SubmitRequest to attribute the
+// Request to the named requester.
+func SubmitWithRequester(name string) SubmitRequestOption {
+	return func(opt *SubmitRequestOptions) { opt.Requester = &name }
+}
+
+type (
+	// A RequestService manages a moderated queue of Requests.
+	RequestService interface {
+		// SubmitRequest submits a Request for the resource selected by s, on
+		// behalf of the holder of code.
+		SubmitRequest(
+			ctx context.Context,
+			code string, s Selector,
+			opts ...SubmitRequestOption,
+		) (*Request, error)
+
+		// GetRequests gets all pending Requests, as well as recently resolved
+		// ones.
+		GetRequests(ctx context.Context) ([]Request, error)
+
+		// ApproveRequest approves a pending Request, and adds its resource to
+		// the play queue.
+		ApproveRequest(ctx context.Context, id string) (*Request, error)
+
+		// RejectRequest rejects a pending Request.
+		RejectRequest(ctx context.Context, id string) (*Request, error)
+	}
+
+	// SubmitRequestOptions are option parameters for
+	// RequestService.SubmitRequest.
+	SubmitRequestOptions struct {
+		Requester *string
+	}
+
+	// A SubmitRequestOption modifies a SubmitRequestOptions.
+	SubmitRequestOption func(*SubmitRequestOptions)
+
+	// A RequestStreamer can stream the state of the Request queue.
+	RequestStreamer interface {
+		StreamRequests(ctx context.Context, ch chan<- []Request) error
+	}
+)
+
+// Errors returned by a RequestService.
+var (
+	ErrRequestNotFound = exthttp.WrapWithHTTPCode(
+		stderrs.New("music: no such request"),
+		http.StatusNotFound,
+	)
+	ErrRequestResolved = exthttp.WrapWithHTTPCode(
+		stderrs.New("music: request already resolved"),
+		http.StatusConflict,
+	)
+	ErrRequestRateLimited = exthttp.WrapWithHTTPCode(
+		errors.WithHint(
+			stderrs.New("music: too many requests"),
+			"Wait a while before making another request.",
+		),
+		http.StatusTooManyRequests,
+	)
+	ErrRequestNotTrack = exthttp.WrapWithHTTPCode(
+		errors.WithHint(
+			stderrs.New("music: only tracks can be requested"),
+			"Request a single track, rather than an album, artist, or playlist.",
+		),
+		http.StatusBadRequest,
+	)
+	ErrRequestQueueFull = exthttp.WrapWithHTTPCode(
+		errors.WithHint(
+			stderrs.New("music: request queue is full"),
+			"Wait for pending requests to be resolved.",
+		),
+		http.StatusServiceUnavailable,
+	)
+)
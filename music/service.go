@@ -6,10 +6,14 @@ type (
 		SourceService
 		CurrentService
 		ControlService
+		LyricsService
+		RequestService
 	}
 
 	// A Streamer handles all music-related streams.
 	Streamer interface {
 		CurrentStreamer
+		LyricsStreamer
+		RequestStreamer
 	}
 )
@@ -2,7 +2,10 @@ package spotify
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -53,37 +56,15 @@ func (ctrl controller) Play(ctx context.Context, s *music.Selector) error {
 			log.WithError(err).Error("Invalid music.Cond.")
 			return errors.Wrap(err, "spotify: validate music.Cond")
 		}
-		if u := s.URI; u != nil {
-			uri = u
-		} else {
-			resources := []struct {
-				Kind  string
-				Value *music.Resource
-			}{
-				{Kind: "track", Value: s.Track},
-				{Kind: "album", Value: s.Album},
-				{Kind: "artist", Value: s.Artist},
-				{Kind: "playlist", Value: s.Playlist},
-			}
-			for _, r := range resources {
-				if v := r.Value; v != nil {
-					uri = pointy.String(fmt.Sprintf("spotify:%s:%s", r.Kind, v.ID))
-					break
-				}
-			}
-		}
+		uri = pointy.String(selectorURI(s))
 		log.WithField("uri", *uri).Trace("Derived resource URI.")
 	}
 
 	// Build play options, and execute.
 	var opts spotify.PlayOptions
 	if uri != nil {
-		var (
-			u = *uri
-			l = len("spotify:")
-		)
-		kind := u[l : l+strings.IndexByte(u[l:], ':')]
-		if kind == "track" {
+		u := *uri
+		if uriKind(u) == music.KindTrack {
 			opts.URIs = []spotify.URI{spotify.URI(u)}
 		} else {
 			su := spotify.URI(u)
@@ -105,3 +86,87 @@ func (ctrl controller) Pause(ctx context.Context) error {
 
 	return errors.WithMessage(ctrl.client.Pause(), "spotify")
 }
+
+func (ctrl controller) Queue(ctx context.Context, s *music.Selector) error {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, ctrl.tracer,
+		name.OfFunc(controller.Queue),
+	)
+	defer span.Finish()
+
+	log := logutil.
+		WithMethod(ctrl.log, controller.Queue).
+		WithContext(ctx).
+		WithField("selector", s)
+
+	if err := s.Validate(); err != nil {
+		log.WithError(err).Error("Invalid music.Selector.")
+		return errors.Wrap(err, "spotify: validate music.Selector")
+	}
+	uri := selectorURI(s)
+	if kind := uriKind(uri); kind != music.KindTrack {
+		return errors.Newf("spotify: cannot queue resource of kind '%s'", kind)
+	}
+
+	// The Spotify client doesn't support the queue endpoint, so send the
+	// request manually.
+	token, err := ctrl.client.Token()
+	if err != nil {
+		return errors.Wrap(err, "spotify: get access token")
+	}
+	u, err := url.Parse(_queueURL)
+	if err != nil {
+		panic(err)
+	}
+	u.RawQuery = url.Values{"uri": []string{uri}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), nil)
+	if err != nil {
+		return errors.Wrap(err, "spotify: create request")
+	}
+	token.SetAuthHeader(req)
+
+	log.WithField("uri", uri).Trace("Adding track to queue...")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.WithError(err).Error("Failed to add track to queue.")
+		return errors.Wrap(err, "spotify: perform request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		var data struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.NewDecoder(res.Body).Decode(&data)
+		err = errors.Newf("spotify: bad response status '%s'", res.Status)
+		if msg := data.Error.Message; msg != "" {
+			err = errors.WithDetail(err, msg)
+		}
+		log.WithError(err).Error("Failed to add track to queue.")
+		return err
+	}
+	return nil
+}
+
+const _queueURL = "https://api.spotify.com/v1/me/player/queue"
+
+// selectorURI derives the Spotify URI of the resource selected by s.
+func selectorURI(s *music.Selector) string {
+	if u := s.URI; u != nil {
+		return *u
+	}
+	kind, r := s.Resource()
+	return fmt.Sprintf("spotify:%s:%s", kind, r.ID)
+}
+
+// uriKind returns the kind of resource identified by a Spotify URI.
+func uriKind(uri string) music.ResourceKind {
+	parts := strings.SplitN(uri, ":", 3)
+	if len(parts) < 3 {
+		return ""
+	}
+	return music.ResourceKind(parts[1])
+}
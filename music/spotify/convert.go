@@ -81,7 +81,11 @@ func imagesFromSpotify(dst *[]music.Image, src []spotify.Image) {
 	}
 	*dst = make([]music.Image, len(src))
 	for i, img := range src {
-		(*dst)[i] = music.Image(img)
+		(*dst)[i] = music.Image{
+			Height: img.Height,
+			Width:  img.Width,
+			URL:    img.URL,
+		}
 	}
 }
 
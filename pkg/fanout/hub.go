@@ -0,0 +1,273 @@
+package fanout // import "go.stevenxie.me/api/v2/pkg/fanout"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/zero"
+)
+
+// ErrClosed is returned when subscribing to a Hub that has been closed.
+var ErrClosed = errors.New("fanout: hub is closed")
+
+// NewHub creates a new Hub.
+func NewHub(opts ...HubOption) *Hub {
+	opt := HubOptions{
+		Logger:     logutil.NoopEntry(),
+		BufferSize: 1,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	if opt.BufferSize < 1 {
+		opt.BufferSize = 1
+	}
+	return &Hub{
+		log:    logutil.WithComponent(opt.Logger, (*Hub)(nil)),
+		size:   opt.BufferSize,
+		replay: opt.Replay,
+		forget: opt.ForgetWhenIdle,
+		hook:   opt.SubscriberHook,
+		subs:   make(map[*subscriber]zero.Struct),
+	}
+}
+
+// HubWithLogger configures a Hub to write logs with log.
+func HubWithLogger(log *logrus.Entry) HubOption {
+	return func(opt *HubOptions) { opt.Logger = log }
+}
+
+// HubWithBufferSize configures the number of values that a Hub will buffer
+// for each subscriber.
+//
+// When a subscriber's buffer is full, its oldest value is dropped.
+func HubWithBufferSize(size int) HubOption {
+	return func(opt *HubOptions) { opt.BufferSize = size }
+}
+
+// HubWithReplay configures a Hub to deliver the most recently published value
+// to new subscribers.
+func HubWithReplay(replay bool) HubOption {
+	return func(opt *HubOptions) { opt.Replay = replay }
+}
+
+// HubWithForgetWhenIdle configures a Hub to forget its most recently
+// published value while it has no subscribers, so that new subscribers aren't
+// replayed stale values.
+func HubWithForgetWhenIdle(forget bool) HubOption {
+	return func(opt *HubOptions) { opt.ForgetWhenIdle = forget }
+}
+
+// HubWithSubscriberHook configures a Hub to call hook with the number of
+// subscribers whenever that number changes.
+//
+// The hook is called while the Hub is locked, so it must not block or call
+// back into the Hub.
+func HubWithSubscriberHook(hook func(n int)) HubOption {
+	return func(opt *HubOptions) { opt.SubscriberHook = hook }
+}
+
+type (
+	// A Hub broadcasts published values to a dynamic set of subscribers.
+	//
+	// Each subscriber receives values through its own bounded buffer, so a
+	// slow subscriber never blocks publishers or other subscribers.
+	Hub struct {
+		log    *logrus.Entry
+		size   int
+		replay bool
+		forget bool
+		hook   func(n int)
+
+		mux     sync.Mutex
+		subs    map[*subscriber]zero.Struct
+		last    zero.Interface
+		hasLast bool
+		closed  bool
+	}
+
+	// HubOptions configures a Hub.
+	HubOptions struct {
+		Logger         *logrus.Entry
+		BufferSize     int
+		Replay         bool
+		ForgetWhenIdle bool
+		SubscriberHook func(n int)
+	}
+
+	// A HubOption modifies a HubOptions.
+	HubOption func(*HubOptions)
+
+	// A SendFunc delivers a value to a subscriber.
+	//
+	// It should return false if ctx is done before v could be delivered.
+	SendFunc func(ctx context.Context, v zero.Interface) bool
+)
+
+// Subscribe registers a subscriber that receives published values through
+// send, until ctx is done or the Hub is closed.
+//
+// Once the subscription has ended, done is called (if non-nil). It is
+// guaranteed that send will not be called after done.
+func (h *Hub) Subscribe(ctx context.Context, send SendFunc, done func()) error {
+	if send == nil {
+		panic(errors.New("fanout: nil SendFunc"))
+	}
+	sub := &subscriber{
+		size:   h.size,
+		signal: make(chan zero.Struct, 1),
+		stop:   make(chan zero.Struct),
+	}
+
+	h.mux.Lock()
+	if h.closed {
+		h.mux.Unlock()
+		return ErrClosed
+	}
+	if h.replay && h.hasLast {
+		sub.push(h.last)
+	}
+	h.subs[sub] = zero.Empty()
+	h.notify()
+	h.mux.Unlock()
+
+	go func() {
+		defer func() {
+			h.remove(sub)
+			if done != nil {
+				done()
+			}
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sub.stop:
+				return
+			case <-sub.signal:
+			}
+			for {
+				v, ok := sub.pop()
+				if !ok {
+					break
+				}
+				if !send(ctx, v) {
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Publish broadcasts v to all subscribers. It never blocks.
+func (h *Hub) Publish(v zero.Interface) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if h.closed {
+		return
+	}
+	if h.replay && !(h.forget && (len(h.subs) == 0)) {
+		h.last, h.hasLast = v, true
+	}
+	for sub := range h.subs {
+		if sub.push(v) {
+			h.log.
+				WithField("buffer_size", sub.size).
+				Trace("Subscriber buffer is full; dropped oldest value.")
+		}
+	}
+}
+
+// Len returns the number of active subscribers.
+func (h *Hub) Len() int {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	return len(h.subs)
+}
+
+// HasLast reports whether the Hub has a value to replay to new subscribers.
+func (h *Hub) HasLast() bool {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	return h.hasLast
+}
+
+// Close ends all subscriptions, and prevents new ones from being created.
+func (h *Hub) Close() {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if h.closed {
+		return
+	}
+	h.closed = true
+	for sub := range h.subs {
+		close(sub.stop)
+	}
+}
+
+func (h *Hub) remove(sub *subscriber) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	if h.forget && (len(h.subs) == 0) {
+		h.last, h.hasLast = nil, false
+	}
+	h.notify()
+}
+
+// notify calls the subscriber hook; h.mux must be held.
+func (h *Hub) notify() {
+	if h.hook != nil {
+		h.hook(len(h.subs))
+	}
+}
+
+type subscriber struct {
+	size   int
+	signal chan zero.Struct
+	stop   chan zero.Struct
+
+	mux sync.Mutex
+	buf []zero.Interface
+}
+
+// push adds v to the subscriber's buffer, dropping the oldest buffered value
+// if the buffer is full. It returns true if a value was dropped.
+func (sub *subscriber) push(v zero.Interface) (dropped bool) {
+	sub.mux.Lock()
+	if len(sub.buf) == sub.size {
+		copy(sub.buf, sub.buf[1:])
+		sub.buf = sub.buf[:len(sub.buf)-1]
+		dropped = true
+	}
+	sub.buf = append(sub.buf, v)
+	sub.mux.Unlock()
+
+	// Wake the delivery goroutine, if it isn't already awake.
+	select {
+	case sub.signal <- zero.Empty():
+	default:
+	}
+	return dropped
+}
+
+// pop removes the oldest value from the subscriber's buffer.
+func (sub *subscriber) pop() (zero.Interface, bool) {
+	sub.mux.Lock()
+	defer sub.mux.Unlock()
+	if len(sub.buf) == 0 {
+		return nil, false
+	}
+	v := sub.buf[0]
+	copy(sub.buf, sub.buf[1:])
+	sub.buf[len(sub.buf)-1] = nil
+	sub.buf = sub.buf[:len(sub.buf)-1]
+	return v, true
+}
@@ -0,0 +1,124 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/pkg/httputil"
+)
+
+// Namespace is the package namespace, used for things like envvars.
+const Namespace = "gitea"
+
+// A Client can make authenticated requests to the Gitea API.
+type Client struct {
+	httpc   *http.Client
+	baseURL string
+	token   string
+
+	mux  sync.Mutex
+	user *User
+}
+
+var _ httputil.BasicClient = (*Client)(nil)
+
+// A User is a Gitea user.
+type User struct {
+	ID    int    `json:"id"`
+	Login string `json:"login"`
+}
+
+// New creates a new Client for the Gitea instance at baseURL.
+//
+// It reads GITEA_TOKEN (a personal access token) from the environment; if no
+// such variable is found, an error will be returned.
+func New(baseURL string, opts ...httputil.BasicClientOption) (*Client, error) {
+	opt := httputil.BasicClientOptions{
+		HTTPClient: new(http.Client),
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	if baseURL == "" {
+		return nil, errors.New("gitea: empty base URL")
+	}
+
+	var token string
+	{
+		var (
+			key = name.EnvKey(Namespace, "TOKEN")
+			ok  bool
+		)
+		if token, ok = os.LookupEnv(key); !ok {
+			return nil, errors.Newf("gitea: no such environment variable '%s'", key)
+		}
+	}
+
+	return &Client{
+		httpc:   opt.HTTPClient,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}, nil
+}
+
+// BaseURL returns the base URL of the Gitea instance.
+func (c *Client) BaseURL() string { return c.baseURL }
+
+// APIURL returns the URL of the API endpoint at path.
+func (c *Client) APIURL(path string) string {
+	return c.baseURL + "/api/v1" + path
+}
+
+// Do sends an HTTP request and returns an HTTP response, following policy
+// (such as redirects, cookies, auth) as configured on the client.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "token "+c.token)
+	return c.httpc.Do(req)
+}
+
+// GetJSON performs a GET request to the API endpoint at path, and decodes the
+// response as JSON into v.
+func (c *Client) GetJSON(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet, c.APIURL(path),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrap(err, "gitea: create request")
+	}
+	res, err := c.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "gitea: perform request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return errors.Newf("gitea: bad response status '%s'", res.Status)
+	}
+	if err = json.NewDecoder(res.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "gitea: decode response as JSON")
+	}
+	return nil
+}
+
+// CurrentUser gets the authenticated user.
+func (c *Client) CurrentUser(ctx context.Context) (*User, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.user == nil {
+		var u User
+		if err := c.GetJSON(ctx, "/user", &u); err != nil {
+			return nil, err
+		}
+		c.user = &u
+	}
+	return c.user, nil
+}
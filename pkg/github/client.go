@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"sync"
 
 	"go.stevenxie.me/gopkg/name"
 	"golang.org/x/oauth2"
@@ -17,8 +18,10 @@ const Namespace = "github"
 
 // A Client can access the GitHub API.
 type Client struct {
-	ghc              *github.Client
-	httpc            *http.Client
+	ghc   *github.Client
+	httpc *http.Client
+
+	loginMux         sync.Mutex
 	currentUserLogin string
 }
 
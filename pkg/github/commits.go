@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// A Commit describes a commit in a GitHub repository.
+type Commit struct {
+	// Signature is nil if the commit is unsigned.
+	Signature *CommitSignature
+}
+
+// A CommitSignature is the cryptographic signature on a Commit.
+type CommitSignature struct {
+	// Type is the GraphQL type of the signature, i.e. 'GpgSignature',
+	// 'SshSignature', or 'SmimeSignature'.
+	Type string
+
+	IsValid bool
+
+	// State is GitHub's verification state for the signature, i.e. 'VALID' or
+	// 'UNKNOWN_KEY'.
+	State string
+
+	// Signer is the login of the user that made the signature, if known.
+	Signer *string
+}
+
+// NewCommitLoader creates a new CommitLoader.
+//
+// By default, it caches each Commit for a day.
+func NewCommitLoader(c *Client, opts ...LoaderOption) *CommitLoader {
+	cl := &CommitLoader{client: c}
+	cl.bl = newBatchLoader(cl.fetch, (*CommitLoader)(nil), 24*time.Hour, opts)
+	return cl
+}
+
+// A CommitLoader loads Commits using the GitHub GraphQL API.
+//
+// Like a RepoLoader, it combines loads into batches, and caches loaded Commits.
+type CommitLoader struct {
+	client *Client
+	bl     *batchLoader
+}
+
+// Load loads the Commit with the specified SHA, from the repository with the
+// full name (i.e. 'owner/name').
+//
+// If the commit does not exist (or is not visible), Load returns nil.
+func (cl *CommitLoader) Load(
+	ctx context.Context,
+	fullName, sha string,
+) (*Commit, error) {
+	if strings.Count(fullName, "/") != 1 {
+		return nil, errors.Newf("github: invalid repository name '%s'", fullName)
+	}
+	v, err := cl.bl.load(ctx, fullName+"@"+sha)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Commit), nil
+}
+
+type commitData struct {
+	Object *struct {
+		Signature *struct {
+			Typename string `json:"__typename"`
+			IsValid  bool   `json:"isValid"`
+			State    string `json:"state"`
+			Signer   *struct {
+				Login string `json:"login"`
+			} `json:"signer"`
+		} `json:"signature"`
+	} `json:"object"`
+}
+
+// fetch loads the commits with the specified keys (i.e. 'owner/name@sha') in a
+// single request.
+func (cl *CommitLoader) fetch(
+	ctx context.Context,
+	keys []string,
+) ([]interface{}, error) {
+	// Build query, using an aliased field for each commit.
+	var (
+		params strings.Builder
+		fields strings.Builder
+		vars   = make(map[string]string, 3*len(keys))
+	)
+	for i, key := range keys {
+		var (
+			at    = strings.LastIndexByte(key, '@')
+			parts = strings.SplitN(key[:at], "/", 2)
+		)
+		if i > 0 {
+			params.WriteString(", ")
+		}
+		fmt.Fprintf(
+			&params,
+			"$o%d: String!, $n%d: String!, $s%d: GitObjectID!",
+			i, i, i,
+		)
+		fmt.Fprintf(
+			&fields,
+			"c%d: repository(owner: $o%d, name: $n%d) { "+
+				"object(oid: $s%d) { ... on Commit { signature { "+
+				"__typename isValid state signer { login } "+
+				"} } } }\n",
+			i, i, i, i,
+		)
+		vars[fmt.Sprintf("o%d", i)] = parts[0]
+		vars[fmt.Sprintf("n%d", i)] = parts[1]
+		vars[fmt.Sprintf("s%d", i)] = key[at+1:]
+	}
+
+	// Commits (and repos) that don't exist have null data.
+	var data map[string]*commitData
+	if err := cl.client.graphQL(
+		ctx,
+		fmt.Sprintf("query(%s) {\n%s}", params.String(), fields.String()),
+		vars, &data,
+	); err != nil {
+		return nil, err
+	}
+
+	cms := make([]interface{}, len(keys))
+	for i := range keys {
+		cd := data[fmt.Sprintf("c%d", i)]
+		if (cd == nil) || (cd.Object == nil) {
+			cms[i] = (*Commit)(nil)
+			continue
+		}
+		var c Commit
+		if sig := cd.Object.Signature; sig != nil {
+			c.Signature = &CommitSignature{
+				Type:    sig.Typename,
+				IsValid: sig.IsValid,
+				State:   sig.State,
+			}
+			if sig.Signer != nil {
+				c.Signature.Signer = &sig.Signer.Login
+			}
+		}
+		cms[i] = &c
+	}
+	return cms, nil
+}
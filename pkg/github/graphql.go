@@ -0,0 +1,66 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+)
+
+// graphQL performs a query against the GitHub GraphQL API, and decodes the
+// resulting data into v.
+//
+// Errors in the response are ignored, since they accompany partial data
+// (i.e. when an aliased repository does not exist).
+func (c *Client) graphQL(
+	ctx context.Context,
+	query string, vars map[string]string,
+	v interface{},
+) error {
+	body, err := json.Marshal(struct {
+		Query     string            `json:"query"`
+		Variables map[string]string `json:"variables"`
+	}{
+		Query:     query,
+		Variables: vars,
+	})
+	if err != nil {
+		return errors.Wrap(err, "github: encode query")
+	}
+
+	// Perform request.
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost, c.BaseURL()+"/graphql",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return errors.Wrap(err, "github: create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := c.httpc.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "github: perform request")
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return errors.Newf("github: bad response status '%s'", res.Status)
+	}
+
+	// Decode response.
+	var data struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&data); err != nil {
+		return errors.Wrap(err, "github: decode response as JSON")
+	}
+	if (len(data.Data) == 0) || (string(data.Data) == "null") {
+		return errors.New("github: query returned no data")
+	}
+	if err = json.Unmarshal(data.Data, v); err != nil {
+		return errors.Wrap(err, "github: decode response data")
+	}
+	return nil
+}
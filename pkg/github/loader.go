@@ -0,0 +1,158 @@
+package github
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+)
+
+// LoaderWithLogger configures a loader to write logs with log.
+func LoaderWithLogger(log *logrus.Entry) LoaderOption {
+	return func(opt *LoaderOptions) { opt.Logger = log }
+}
+
+// LoaderWithTTL configures how long a loader caches each loaded value for.
+func LoaderWithTTL(ttl time.Duration) LoaderOption {
+	return func(opt *LoaderOptions) { opt.TTL = ttl }
+}
+
+type (
+	// LoaderOptions configures a loader, like a RepoLoader or a CommitLoader.
+	LoaderOptions struct {
+		Logger *logrus.Entry
+
+		// Wait is how long to wait for more loads before sending a batch, and
+		// MaxBatch is the maximum number of values in a batch.
+		Wait     time.Duration
+		MaxBatch int
+
+		TTL     time.Duration
+		Timeout time.Duration // for each batch request
+	}
+
+	// A LoaderOption modifies a LoaderOptions.
+	LoaderOption func(*LoaderOptions)
+)
+
+// A batchLoader loads values by key, combining loads that are made within a
+// short window of each other into a single batch, and caching loaded values.
+type batchLoader struct {
+	fetch func(ctx context.Context, keys []string) ([]interface{}, error)
+	log   *logrus.Entry
+	opt   LoaderOptions
+
+	mux   sync.Mutex
+	cache map[string]batchEntry
+	batch *batch
+}
+
+type (
+	batchEntry struct {
+		value   interface{}
+		expires time.Time
+	}
+
+	batch struct {
+		keys    []string
+		index   map[string]int
+		results []interface{}
+		err     error
+		done    chan struct{}
+	}
+)
+
+// newBatchLoader creates a batchLoader that fetches batches of values with
+// fetch, and logs on behalf of component.
+func newBatchLoader(
+	fetch func(ctx context.Context, keys []string) ([]interface{}, error),
+	component interface{},
+	defaultTTL time.Duration,
+	opts []LoaderOption,
+) *batchLoader {
+	opt := LoaderOptions{
+		Logger:   logutil.NoopEntry(),
+		Wait:     5 * time.Millisecond,
+		MaxBatch: 50,
+		TTL:      defaultTTL,
+		Timeout:  10 * time.Second,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return &batchLoader{
+		fetch: fetch,
+		log:   logutil.WithComponent(opt.Logger, component),
+		opt:   opt,
+		cache: make(map[string]batchEntry),
+	}
+}
+
+// load loads the value with the specified key.
+func (l *batchLoader) load(ctx context.Context, key string) (interface{}, error) {
+	l.mux.Lock()
+	if e, ok := l.cache[key]; ok && time.Now().Before(e.expires) {
+		l.mux.Unlock()
+		return e.value, nil
+	}
+	b := l.batch
+	if b == nil {
+		b = &batch{
+			index: make(map[string]int),
+			done:  make(chan struct{}),
+		}
+		l.batch = b
+		time.AfterFunc(l.opt.Wait, func() { l.dispatch(b) })
+	}
+	i, ok := b.index[key]
+	if !ok {
+		i = len(b.keys)
+		b.index[key] = i
+		b.keys = append(b.keys, key)
+		if len(b.keys) >= l.opt.MaxBatch {
+			go l.dispatch(b)
+		}
+	}
+	l.mux.Unlock()
+
+	select {
+	case <-b.done:
+		if b.err != nil {
+			return nil, b.err
+		}
+		return b.results[i], nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// dispatch sends b, if it has not already been sent.
+func (l *batchLoader) dispatch(b *batch) {
+	l.mux.Lock()
+	if l.batch != b {
+		l.mux.Unlock()
+		return
+	}
+	l.batch = nil
+	l.mux.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.opt.Timeout)
+	defer cancel()
+	b.results, b.err = l.fetch(ctx, b.keys)
+	if b.err != nil {
+		l.log.
+			WithError(b.err).
+			WithField("count", len(b.keys)).
+			Error("Failed to load batch.")
+	} else {
+		l.mux.Lock()
+		expires := time.Now().Add(l.opt.TTL)
+		for i, key := range b.keys {
+			l.cache[key] = batchEntry{value: b.results[i], expires: expires}
+		}
+		l.mux.Unlock()
+	}
+	close(b.done)
+}
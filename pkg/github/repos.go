@@ -0,0 +1,168 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// A Repo describes a GitHub repository.
+type Repo struct {
+	Description     *string
+	PrimaryLanguage *string
+	Languages       []RepoLanguage // ordered by size, largest first
+	Stars           int
+	Topics          []string
+	PushedAt        *time.Time
+	IsFork          bool
+	IsArchived      bool
+}
+
+// A RepoLanguage is the amount of code in a Repo written in a particular
+// language.
+type RepoLanguage struct {
+	Name string
+	Size int // in bytes
+}
+
+// NewRepoLoader creates a new RepoLoader.
+//
+// By default, it caches each Repo for an hour.
+func NewRepoLoader(c *Client, opts ...LoaderOption) *RepoLoader {
+	rl := &RepoLoader{client: c}
+	rl.bl = newBatchLoader(rl.fetch, (*RepoLoader)(nil), time.Hour, opts)
+	return rl
+}
+
+// A RepoLoader loads Repos using the GitHub GraphQL API.
+//
+// Loads that are made within a short window of each other are combined into a
+// single request, and loaded Repos are cached.
+type RepoLoader struct {
+	client *Client
+	bl     *batchLoader
+}
+
+// Load loads the Repo with the full name (i.e. 'owner/name').
+//
+// If the repository does not exist (or is not visible), Load returns nil.
+func (rl *RepoLoader) Load(ctx context.Context, fullName string) (*Repo, error) {
+	if strings.Count(fullName, "/") != 1 {
+		return nil, errors.Newf("github: invalid repository name '%s'", fullName)
+	}
+	v, err := rl.bl.load(ctx, fullName)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*Repo), nil
+}
+
+const _repoFields = `
+	description
+	primaryLanguage { name }
+	languages(first: 10, orderBy: {field: SIZE, direction: DESC}) {
+		edges { size node { name } }
+	}
+	stargazers { totalCount }
+	repositoryTopics(first: 20) { nodes { topic { name } } }
+	pushedAt
+	isFork
+	isArchived
+`
+
+type repoData struct {
+	Description     *string `json:"description"`
+	PrimaryLanguage *struct {
+		Name string `json:"name"`
+	} `json:"primaryLanguage"`
+	Languages struct {
+		Edges []struct {
+			Size int `json:"size"`
+			Node struct {
+				Name string `json:"name"`
+			} `json:"node"`
+		} `json:"edges"`
+	} `json:"languages"`
+	Stargazers struct {
+		TotalCount int `json:"totalCount"`
+	} `json:"stargazers"`
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string `json:"name"`
+			} `json:"topic"`
+		} `json:"nodes"`
+	} `json:"repositoryTopics"`
+	PushedAt   *time.Time `json:"pushedAt"`
+	IsFork     bool       `json:"isFork"`
+	IsArchived bool       `json:"isArchived"`
+}
+
+// fetch loads the repos with the specified full names in a single request.
+func (rl *RepoLoader) fetch(
+	ctx context.Context,
+	names []string,
+) ([]interface{}, error) {
+	// Build query, using an aliased field for each repo.
+	var (
+		params strings.Builder
+		fields strings.Builder
+		vars   = make(map[string]string, 2*len(names))
+	)
+	for i, name := range names {
+		parts := strings.SplitN(name, "/", 2)
+		if i > 0 {
+			params.WriteString(", ")
+		}
+		fmt.Fprintf(&params, "$o%d: String!, $n%d: String!", i, i)
+		fmt.Fprintf(
+			&fields,
+			"r%d: repository(owner: $o%d, name: $n%d) {%s}\n",
+			i, i, i, _repoFields,
+		)
+		vars[fmt.Sprintf("o%d", i)] = parts[0]
+		vars[fmt.Sprintf("n%d", i)] = parts[1]
+	}
+
+	// Repos that don't exist have null data.
+	var data map[string]*repoData
+	if err := rl.client.graphQL(
+		ctx,
+		fmt.Sprintf("query(%s) {\n%s}", params.String(), fields.String()),
+		vars, &data,
+	); err != nil {
+		return nil, err
+	}
+
+	repos := make([]interface{}, len(names))
+	for i := range names {
+		rd := data[fmt.Sprintf("r%d", i)]
+		if rd == nil {
+			repos[i] = (*Repo)(nil)
+			continue
+		}
+		r := Repo{
+			Description: rd.Description,
+			Stars:       rd.Stargazers.TotalCount,
+			PushedAt:    rd.PushedAt,
+			IsFork:      rd.IsFork,
+			IsArchived:  rd.IsArchived,
+			Languages:   make([]RepoLanguage, len(rd.Languages.Edges)),
+			Topics:      make([]string, len(rd.RepositoryTopics.Nodes)),
+		}
+		if pl := rd.PrimaryLanguage; pl != nil {
+			r.PrimaryLanguage = &pl.Name
+		}
+		for j, e := range rd.Languages.Edges {
+			r.Languages[j] = RepoLanguage{Name: e.Node.Name, Size: e.Size}
+		}
+		for j, n := range rd.RepositoryTopics.Nodes {
+			r.Topics[j] = n.Topic.Name
+		}
+		repos[i] = &r
+	}
+	return repos, nil
+}
@@ -8,6 +8,8 @@ import (
 
 // CurrentUserLogin gets the login of the authenticated user.
 func (c *Client) CurrentUserLogin() (string, error) {
+	c.loginMux.Lock()
+	defer c.loginMux.Unlock()
 	if c.currentUserLogin == "" {
 		res, err := c.httpc.Get(c.BaseURL() + "/user")
 		if err != nil {
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrs "errors"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors/exthttp"
+	"go.stevenxie.me/gopkg/name"
+)
+
+// SignatureHeader is the header that contains the HMAC-SHA256 signature of a
+// webhook payload.
+const SignatureHeader = "X-Hub-Signature-256"
+
+// ErrInvalidSignature is returned by ValidateSignature256 when a webhook
+// payload signature is missing or incorrect.
+var ErrInvalidSignature = exthttp.WrapWithHTTPCode(
+	stderrs.New("github: invalid webhook signature"),
+	http.StatusUnauthorized,
+)
+
+// WebhookSecret reads GITHUB_WEBHOOK_SECRET from the environment.
+//
+// If no such variable is found, ok will be false.
+func WebhookSecret() (secret []byte, ok bool) {
+	s, ok := os.LookupEnv(name.EnvKey(Namespace, "WEBHOOK_SECRET"))
+	if !ok || (s == "") {
+		return nil, false
+	}
+	return []byte(s), true
+}
+
+// ValidateSignature256 checks that sig (the value of the SignatureHeader) is
+// a valid HMAC-SHA256 signature of payload, keyed with secret.
+func ValidateSignature256(sig string, payload, secret []byte) error {
+	const prefix = "sha256="
+	if !strings.HasPrefix(sig, prefix) {
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(sig[len(prefix):])
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload) // never returns an error
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Unmarshal parses the iCalendar object encoded in data.
+//
+// Floating times, and dates, are interpreted in loc (or in the calendar's
+// X-WR-TIMEZONE, if it has one).
+func Unmarshal(data []byte, loc *time.Location) (*Calendar, error) {
+	return Decode(bytes.NewReader(data), loc)
+}
+
+// Decode reads and parses the first iCalendar object in r.
+//
+// Floating times, and dates, are interpreted in loc (or in the calendar's
+// X-WR-TIMEZONE, if it has one).
+func Decode(r io.Reader, loc *time.Location) (*Calendar, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "ical: read lines")
+	}
+
+	var (
+		cal    *Calendar
+		event  *Event
+		stack  []string
+		durVal string
+	)
+	for i, l := range lines {
+		prop, err := parseLine(l)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ical: line %d", i+1)
+		}
+
+		switch prop.name {
+		case "BEGIN":
+			name := strings.ToUpper(prop.value)
+			stack = append(stack, name)
+			switch {
+			case len(stack) == 1:
+				if name != "VCALENDAR" {
+					return nil, errors.Newf(
+						"ical: line %d: expected BEGIN:VCALENDAR, got BEGIN:%s",
+						i+1, prop.value,
+					)
+				}
+				cal = new(Calendar)
+			case (name == "VEVENT") && (len(stack) == 2):
+				event, durVal = new(Event), ""
+			}
+			continue
+		case "END":
+			name := strings.ToUpper(prop.value)
+			if (len(stack) == 0) || (stack[len(stack)-1] != name) {
+				return nil, errors.Newf(
+					"ical: line %d: unexpected END:%s", i+1, prop.value,
+				)
+			}
+			stack = stack[:len(stack)-1]
+			switch {
+			case (name == "VCALENDAR") && (len(stack) == 0):
+				return cal, nil
+			case (name == "VEVENT") && (len(stack) == 1):
+				if err = finishEvent(event, durVal); err != nil {
+					return nil, errors.Wrapf(err, "ical: event '%s'", event.UID)
+				}
+				cal.Events = append(cal.Events, *event)
+				event = nil
+			}
+			continue
+		}
+
+		switch {
+		case (len(stack) == 1) && (stack[0] == "VCALENDAR"):
+			switch prop.name {
+			case "PRODID":
+				cal.ProdID = prop.value
+			case "METHOD":
+				cal.Method = prop.value
+			case "X-WR-CALNAME":
+				cal.Name = unescapeText(prop.value)
+			case "X-WR-TIMEZONE":
+				if l, err := time.LoadLocation(prop.value); err == nil {
+					loc = l
+				}
+			}
+		case (len(stack) == 2) && (event != nil):
+			if err = parseEventProp(event, &prop, loc, &durVal); err != nil {
+				return nil, errors.Wrapf(err, "ical: line %d", i+1)
+			}
+		}
+	}
+	return nil, errors.New("ical: missing VCALENDAR")
+}
+
+func parseEventProp(
+	event *Event,
+	prop *property,
+	loc *time.Location,
+	durVal *string,
+) error {
+	var err error
+	switch prop.name {
+	case "UID":
+		event.UID = unescapeText(prop.value)
+	case "SUMMARY":
+		event.Summary = unescapeText(prop.value)
+	case "DESCRIPTION":
+		event.Description = unescapeText(prop.value)
+	case "LOCATION":
+		event.Location = unescapeText(prop.value)
+	case "URL":
+		event.URL = prop.value
+	case "STATUS":
+		event.Status = Status(strings.ToUpper(prop.value))
+	case "TRANSP":
+		event.Transparent = strings.EqualFold(prop.value, "TRANSPARENT")
+	case "DTSTAMP":
+		event.Stamp, _, err = parseTime(prop.value, prop.params, loc)
+	case "DTSTART":
+		event.Start, event.AllDay, err = parseTime(prop.value, prop.params, loc)
+	case "DTEND":
+		event.End, _, err = parseTime(prop.value, prop.params, loc)
+	case "DURATION":
+		*durVal = prop.value
+	case "RRULE":
+		event.RRule, err = ParseRRule(prop.value, loc)
+		if errors.Is(err, ErrUnsupportedRule) {
+			event.UnsupportedRRule, err = prop.value, nil
+		}
+	case "EXDATE":
+		for _, v := range strings.Split(prop.value, ",") {
+			t, _, err := parseTime(v, prop.params, loc)
+			if err != nil {
+				return errors.Wrap(err, "parse EXDATE")
+			}
+			event.ExDates = append(event.ExDates, t)
+		}
+	case "RECURRENCE-ID":
+		var t time.Time
+		if t, _, err = parseTime(prop.value, prop.params, loc); err == nil {
+			event.RecurrenceID = &t
+		}
+	}
+	return errors.Wrapf(err, "parse %s", prop.name)
+}
+
+// finishEvent validates an event, and determines its end time if it was not
+// specified explicitly.
+func finishEvent(event *Event, durVal string) error {
+	if event.Start.IsZero() {
+		return errors.New("missing DTSTART")
+	}
+	if !event.End.IsZero() {
+		return nil
+	}
+	switch {
+	case durVal != "":
+		dur, days, err := ParseDuration(durVal)
+		if err != nil {
+			return err
+		}
+		event.End = event.Start.AddDate(0, 0, days).Add(dur)
+	case event.AllDay:
+		event.End = event.Start.AddDate(0, 0, 1)
+	default:
+		event.End = event.Start
+	}
+	return nil
+}
+
+// ParseDuration parses the value of a DURATION property, like "PT1H30M" or
+// "P1D".
+//
+// Days and weeks are returned separately (as a number of days), since their
+// exact duration depends on DST transitions.
+func ParseDuration(s string) (dur time.Duration, days int, err error) {
+	m := _durationRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return 0, 0, errors.Newf("ical: invalid duration '%s'", s)
+	}
+	num := func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+	days = 7*num(m[2]) + num(m[3])
+	dur = time.Duration(num(m[4]))*time.Hour +
+		time.Duration(num(m[5]))*time.Minute +
+		time.Duration(num(m[6]))*time.Second
+	if m[1] == "-" {
+		dur, days = -dur, -days
+	}
+	return dur, days, nil
+}
+
+var _durationRegexp = regexp.MustCompile(
+	`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`,
+)
+
+// parseTime parses a DATE or DATE-TIME value.
+func parseTime(
+	value string,
+	params map[string]string,
+	loc *time.Location,
+) (t time.Time, date bool, err error) {
+	if tzid, ok := params["TZID"]; ok {
+		// Fall back to loc if the time zone is not known (i.e. for Windows
+		// time zone names).
+		if l, err := time.LoadLocation(strings.Trim(tzid, `"`)); err == nil {
+			loc = l
+		}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	switch {
+	case (params["VALUE"] == "DATE") || (len(value) == len(DateLayout)):
+		t, err = time.ParseInLocation(DateLayout, value, loc)
+		return t, true, err
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(DateTimeLayout, value)
+		return t, false, err
+	default:
+		t, err = time.ParseInLocation(
+			strings.TrimSuffix(DateTimeLayout, "Z"),
+			value, loc,
+		)
+		return t, false, err
+	}
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseLine parses a content line, like "DTSTART;TZID=America/Toronto:...".
+func parseLine(l string) (property, error) {
+	var (
+		prop   property
+		quoted bool
+		colon  = -1
+	)
+	for i, r := range l {
+		if r == '"' {
+			quoted = !quoted
+		} else if (r == ':') && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return prop, errors.Newf("malformed content line '%s'", l)
+	}
+	prop.value = l[colon+1:]
+
+	parts := splitParams(l[:colon])
+	prop.name = strings.ToUpper(parts[0])
+	for _, p := range parts[1:] {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		if prop.params == nil {
+			prop.params = make(map[string]string)
+		}
+		prop.params[strings.ToUpper(kv[0])] = kv[1]
+	}
+	return prop, nil
+}
+
+// splitParams splits a property name and its parameters on semicolons that
+// are not quoted.
+func splitParams(s string) []string {
+	var (
+		parts  []string
+		quoted bool
+		start  int
+	)
+	for i, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case (r == ';') && !quoted:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// readLines reads content lines from r, unfolding folded lines.
+func readLines(r io.Reader) ([]string, error) {
+	var (
+		scanner = bufio.NewScanner(r)
+		lines   []string
+	)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		l := strings.TrimRight(scanner.Text(), "\r")
+		if l == "" {
+			continue
+		}
+		if (l[0] == ' ') || (l[0] == '\t') {
+			if n := len(lines); n > 0 {
+				lines[n-1] += l[1:]
+			}
+			continue
+		}
+		lines = append(lines, l)
+	}
+	return lines, scanner.Err()
+}
+
+var _textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, ";",
+	`\,`, ",",
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+func unescapeText(s string) string { return _textUnescaper.Replace(s) }
@@ -0,0 +1,263 @@
+package ical
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// _recurringICS is a calendar in America/Toronto, whose events recur across
+// the end of DST on November 3, 2019.
+const _recurringICS = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Test//EN
+X-WR-CALNAME:Work
+X-WR-TIMEZONE:America/Toronto
+BEGIN:VTIMEZONE
+TZID:America/Toronto
+BEGIN:STANDARD
+DTSTART:19701101T020000
+TZOFFSETFROM:-0400
+TZOFFSETTO:-0500
+END:STANDARD
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:standup
+DTSTAMP:20191001T120000Z
+DTSTART;TZID=America/Toronto:20191028T093000
+DURATION:PT15M
+RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=5
+EXDATE;TZID=America/Toronto:20191030T093000
+SUMMARY:Standup
+DESCRIPTION:Daily sync\, with notes:\n- what I did
+ \n- what I'll do
+END:VEVENT
+BEGIN:VEVENT
+UID:standup
+DTSTAMP:20191001T120000Z
+RECURRENCE-ID;TZID=America/Toronto:20191101T093000
+DTSTART;TZID=America/Toronto:20191101T110000
+DTEND;TZID=America/Toronto:20191101T113000
+SUMMARY:Standup (moved)
+END:VEVENT
+BEGIN:VEVENT
+UID:review
+DTSTAMP:20191001T120000Z
+DTSTART;TZID="America/Toronto":20190927T150000
+DTEND;TZID="America/Toronto":20190927T160000
+RRULE:FREQ=MONTHLY;BYDAY=-1FR
+SUMMARY:Monthly review
+LOCATION:Room 1\, Floor 2
+TRANSP:TRANSPARENT
+END:VEVENT
+BEGIN:VEVENT
+UID:offsite
+DTSTART;VALUE=DATE:20191115
+SUMMARY:Offsite
+STATUS:TENTATIVE
+END:VEVENT
+END:VCALENDAR
+`
+
+func loadToronto(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("America/Toronto")
+	if err != nil {
+		t.Skipf("load time zone: %v", err)
+	}
+	return loc
+}
+
+func TestDecode(t *testing.T) {
+	loc := loadToronto(t)
+	at := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2019, month, day, hour, min, 0, 0, loc)
+	}
+
+	// Use CRLF line endings, as required by RFC 5545.
+	data := strings.Replace(_recurringICS, "\n", "\r\n", -1)
+	cal, err := Unmarshal([]byte(data), time.UTC)
+	if err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if cal.ProdID != "-//Test//Test//EN" {
+		t.Errorf("ProdID = %q", cal.ProdID)
+	}
+	if cal.Name != "Work" {
+		t.Errorf("Name = %q, want %q", cal.Name, "Work")
+	}
+	if n := len(cal.Events); n != 4 {
+		t.Fatalf("got %d events, want 4", n)
+	}
+
+	t.Run("weekly", func(t *testing.T) {
+		e := &cal.Events[0]
+		if !e.Start.Equal(at(time.October, 28, 9, 30)) {
+			t.Errorf("Start = %s", e.Start)
+		}
+		if d := e.Duration(); d != 15*time.Minute {
+			t.Errorf("Duration = %s, want 15m", d)
+		}
+		want := &RRule{
+			Freq:     FreqWeekly,
+			Interval: 1,
+			Count:    5,
+			ByDay: []WeekdayNum{
+				{Weekday: time.Monday},
+				{Weekday: time.Wednesday},
+				{Weekday: time.Friday},
+			},
+			WeekStart: time.Monday,
+		}
+		if !reflect.DeepEqual(e.RRule, want) {
+			t.Errorf("RRule = %+v, want %+v", e.RRule, want)
+		}
+		if (len(e.ExDates) != 1) ||
+			!e.ExDates[0].Equal(at(time.October, 30, 9, 30)) {
+			t.Errorf("ExDates = %v", e.ExDates)
+		}
+		const desc = "Daily sync, with notes:\n- what I did\n- what I'll do"
+		if e.Description != desc {
+			t.Errorf("Description = %q, want %q", e.Description, desc)
+		}
+		if e.RecurrenceID != nil {
+			t.Errorf("RecurrenceID = %s, want nil", e.RecurrenceID)
+		}
+	})
+
+	t.Run("override", func(t *testing.T) {
+		e := &cal.Events[1]
+		if e.UID != "standup" {
+			t.Errorf("UID = %q", e.UID)
+		}
+		if (e.RecurrenceID == nil) ||
+			!e.RecurrenceID.Equal(at(time.November, 1, 9, 30)) {
+			t.Errorf("RecurrenceID = %v", e.RecurrenceID)
+		}
+		if !e.Start.Equal(at(time.November, 1, 11, 0)) ||
+			!e.End.Equal(at(time.November, 1, 11, 30)) {
+			t.Errorf("Start, End = %s, %s", e.Start, e.End)
+		}
+		if e.RRule != nil {
+			t.Errorf("RRule = %+v, want nil", e.RRule)
+		}
+	})
+
+	t.Run("monthly", func(t *testing.T) {
+		e := &cal.Events[2]
+		if !e.Start.Equal(at(time.September, 27, 15, 0)) {
+			t.Errorf("Start = %s", e.Start)
+		}
+		if e.Location != "Room 1, Floor 2" {
+			t.Errorf("Location = %q", e.Location)
+		}
+		if !e.Transparent {
+			t.Error("expected a transparent event")
+		}
+		if (e.RRule == nil) || !reflect.DeepEqual(
+			e.RRule.ByDay,
+			[]WeekdayNum{{N: -1, Weekday: time.Friday}},
+		) {
+			t.Errorf("RRule = %+v", e.RRule)
+		}
+	})
+
+	t.Run("all day", func(t *testing.T) {
+		e := &cal.Events[3]
+		if !e.AllDay {
+			t.Error("expected an all-day event")
+		}
+		// Dates are interpreted in the calendar's X-WR-TIMEZONE.
+		if !e.Start.Equal(at(time.November, 15, 0, 0)) ||
+			!e.End.Equal(at(time.November, 16, 0, 0)) {
+			t.Errorf("Start, End = %s, %s", e.Start, e.End)
+		}
+		if e.Status != StatusTentative {
+			t.Errorf("Status = %q", e.Status)
+		}
+	})
+}
+
+func TestDecodeUnsupportedRRule(t *testing.T) {
+	const data = `BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:first-weekday
+DTSTART:20191001T090000Z
+DTEND:20191001T093000Z
+RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=1
+END:VEVENT
+BEGIN:VEVENT
+UID:lunch
+DTSTART:20191001T120000Z
+DTEND:20191001T130000Z
+RRULE:FREQ=DAILY;COUNT=3
+END:VEVENT
+END:VCALENDAR
+`
+	cal, err := Unmarshal([]byte(data), time.UTC)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if n := len(cal.Events); n != 2 {
+		t.Fatalf("got %d events, want 2", n)
+	}
+
+	// The event with the unsupported rule is kept, but does not recur.
+	e := &cal.Events[0]
+	if e.RRule != nil {
+		t.Errorf("RRule = %+v, want nil", e.RRule)
+	}
+	const rule = "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=1"
+	if e.UnsupportedRRule != rule {
+		t.Errorf("UnsupportedRRule = %q, want %q", e.UnsupportedRRule, rule)
+	}
+	start := time.Date(2019, time.October, 1, 9, 0, 0, 0, time.UTC)
+	if !e.Start.Equal(start) {
+		t.Errorf("Start = %s, want %s", e.Start, start)
+	}
+
+	if e := &cal.Events[1]; (e.UID != "lunch") || (e.RRule == nil) {
+		t.Errorf("got event %+v, want the recurring lunch", e)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		ics  string
+	}{
+		{
+			name: "event outside calendar",
+			ics: "BEGIN:VEVENT\nUID:a\nDTSTART:20191101T090000Z\n" +
+				"END:VEVENT\n",
+		},
+		{
+			name: "event in another root",
+			ics: "BEGIN:VJOURNAL\nBEGIN:VEVENT\nUID:a\n" +
+				"DTSTART:20191101T090000Z\nEND:VEVENT\nEND:VJOURNAL\n",
+		},
+		{
+			name: "missing start",
+			ics:  "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nEND:VEVENT\nEND:VCALENDAR\n",
+		},
+		{
+			name: "mismatched end",
+			ics:  "BEGIN:VCALENDAR\nBEGIN:VEVENT\nEND:VCALENDAR\n",
+		},
+		{
+			name: "unterminated",
+			ics:  "BEGIN:VCALENDAR\nPRODID:x\n",
+		},
+		{
+			name: "malformed line",
+			ics:  "BEGIN:VCALENDAR\nPRODID\nEND:VCALENDAR\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Unmarshal([]byte(tt.ics), time.UTC); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Layouts used to encode time.Times.
+const (
+	DateLayout     = "20060102"
+	DateTimeLayout = "20060102T150405Z"
+)
+
+// _maxLineLen is the maximum length of a content line, in octets, excluding
+// the line break.
+const _maxLineLen = 75
+
+// Marshal returns the iCalendar encoding of cal.
+func Marshal(cal *Calendar) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, cal); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encode writes the iCalendar encoding of cal to w.
+func Encode(w io.Writer, cal *Calendar) error {
+	enc := encoder{w: bufio.NewWriter(w), now: time.Now()}
+	enc.line("BEGIN", "VCALENDAR")
+	enc.line("VERSION", "2.0")
+	enc.line("PRODID", cal.ProdID)
+	enc.line("CALSCALE", "GREGORIAN")
+	if cal.Method != "" {
+		enc.line("METHOD", cal.Method)
+	}
+	if cal.Name != "" {
+		enc.text("X-WR-CALNAME", cal.Name)
+	}
+	for i := range cal.Events {
+		enc.event(&cal.Events[i])
+	}
+	enc.line("END", "VCALENDAR")
+	if enc.err != nil {
+		return enc.err
+	}
+	return enc.w.Flush()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	now time.Time
+	err error
+}
+
+func (enc *encoder) event(e *Event) {
+	enc.line("BEGIN", "VEVENT")
+	enc.text("UID", e.UID)
+	stamp := e.Stamp
+	if stamp.IsZero() {
+		stamp = enc.now
+	}
+	enc.line("DTSTAMP", stamp.UTC().Format(DateTimeLayout))
+	if e.AllDay {
+		enc.line("DTSTART;VALUE=DATE", e.Start.Format(DateLayout))
+		enc.line("DTEND;VALUE=DATE", e.End.Format(DateLayout))
+	} else {
+		enc.line("DTSTART", e.Start.UTC().Format(DateTimeLayout))
+		enc.line("DTEND", e.End.UTC().Format(DateTimeLayout))
+	}
+	if e.Summary != "" {
+		enc.text("SUMMARY", e.Summary)
+	}
+	if e.Description != "" {
+		enc.text("DESCRIPTION", e.Description)
+	}
+	if e.Location != "" {
+		enc.text("LOCATION", e.Location)
+	}
+	if e.URL != "" {
+		enc.line("URL", e.URL)
+	}
+	if e.Status != "" {
+		enc.line("STATUS", string(e.Status))
+	}
+	if e.Transparent {
+		enc.line("TRANSP", "TRANSPARENT")
+	} else {
+		enc.line("TRANSP", "OPAQUE")
+	}
+	enc.line("END", "VEVENT")
+}
+
+// text writes a content line with a TEXT value, which must be escaped.
+func (enc *encoder) text(name, value string) {
+	enc.line(name, _textEscaper.Replace(value))
+}
+
+var _textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// line writes a content line, folding it if it is too long.
+func (enc *encoder) line(name, value string) {
+	if enc.err != nil {
+		return
+	}
+	var (
+		l   = name + ":" + value
+		max = _maxLineLen
+	)
+	for len(l) > max {
+		// Fold at a rune boundary; continuation lines begin with a space,
+		// which counts towards their length.
+		n := max
+		for (n > 0) && !utf8.RuneStart(l[n]) {
+			n--
+		}
+		if _, enc.err = enc.w.WriteString(l[:n] + "\r\n "); enc.err != nil {
+			return
+		}
+		l = l[n:]
+		max = _maxLineLen - 1
+	}
+	_, enc.err = enc.w.WriteString(l + "\r\n")
+}
@@ -0,0 +1,74 @@
+package ical // import "go.stevenxie.me/api/v2/pkg/ical"
+
+import "time"
+
+// ContentType is the MIME type of an iCalendar object.
+const ContentType = "text/calendar; charset=utf-8"
+
+// A Calendar is an iCalendar object, containing a set of Events.
+type Calendar struct {
+	// ProdID identifies the product that created the Calendar.
+	ProdID string
+
+	// Method is the iTIP method of the Calendar, like "PUBLISH" or "REQUEST";
+	// it is omitted if empty.
+	Method string
+
+	// Name is the display name of the Calendar; it is omitted if empty.
+	Name   string
+	Events []Event
+}
+
+// An Event is an iCalendar VEVENT.
+type Event struct {
+	UID   string
+	Start time.Time
+	End   time.Time
+
+	// AllDay is true if the Event spans entire days, in which case only the
+	// dates of Start and End are encoded.
+	AllDay bool
+
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+
+	// Status is one of the Status constants; it is omitted if empty.
+	Status Status
+
+	// Transparent is true if the Event does not block time on a calendar.
+	Transparent bool
+
+	// Stamp is the time at which the Event was created or last modified; if
+	// zero, the time of encoding is used.
+	Stamp time.Time
+
+	// RRule and ExDates describe the occurrences of a recurring Event;
+	// RRule is nil if the Event does not recur.
+	RRule   *RRule
+	ExDates []time.Time
+
+	// UnsupportedRRule is the value of an RRULE that uses features that are
+	// not supported by ParseRRule. Such Events are decoded as if they do not
+	// recur, so that the rest of the calendar can still be read.
+	UnsupportedRRule string
+
+	// RecurrenceID is set if the Event overrides a single occurrence of a
+	// recurring Event with the same UID, and is the original start time of
+	// that occurrence.
+	RecurrenceID *time.Time
+}
+
+// Duration returns the duration of the Event.
+func (e *Event) Duration() time.Duration { return e.End.Sub(e.Start) }
+
+// Status is the status of an Event.
+type Status string
+
+// The set of valid Event Statuses.
+const (
+	StatusTentative Status = "TENTATIVE"
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
@@ -0,0 +1,426 @@
+package ical
+
+import (
+	stderrs "errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// An RRule is a recurrence rule, which describes the start times of the
+// occurrences of a recurring Event.
+//
+// Only the SECONDLY, MINUTELY, HOURLY, BYSETPOS, BYWEEKNO, BYYEARDAY, BYHOUR,
+// BYMINUTE, and BYSECOND parts are unsupported.
+type RRule struct {
+	Freq     Frequency
+	Interval int // defaults to 1
+
+	// At most one of Count and Until may be set; if neither is set, the rule
+	// recurs forever.
+	Count int
+	Until *time.Time
+
+	ByDay      []WeekdayNum
+	ByMonthDay []int // negative values count from the end of the month
+	ByMonth    []time.Month
+	WeekStart  time.Weekday
+}
+
+// Frequency is the frequency at which an RRule recurs.
+type Frequency string
+
+// The set of supported Frequencies.
+const (
+	FreqDaily   Frequency = "DAILY"
+	FreqWeekly  Frequency = "WEEKLY"
+	FreqMonthly Frequency = "MONTHLY"
+	FreqYearly  Frequency = "YEARLY"
+)
+
+// A WeekdayNum is a BYDAY value, like "MO" (every Monday) or "-1FR" (the last
+// Friday of the month).
+type WeekdayNum struct {
+	N       int // zero if the weekday is not qualified
+	Weekday time.Weekday
+}
+
+// ErrUnsupportedRule is returned by ParseRRule when a rule uses a feature that
+// is not supported.
+var ErrUnsupportedRule = stderrs.New("ical: unsupported recurrence rule")
+
+var _weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseRRule parses the value of an RRULE property.
+//
+// UNTIL values that are dates or floating times are interpreted in loc.
+func ParseRRule(s string, loc *time.Location) (*RRule, error) {
+	rule := RRule{Interval: 1, WeekStart: time.Monday}
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Newf("ical: malformed rule part '%s'", part)
+		}
+		key, value := strings.ToUpper(kv[0]), kv[1]
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.Freq = Frequency(strings.ToUpper(value))
+			switch rule.Freq {
+			case FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+			default:
+				return nil, errors.WithDetailf(
+					ErrUnsupportedRule,
+					"Unsupported frequency '%s'.", value,
+				)
+			}
+		case "INTERVAL":
+			if rule.Interval, err = strconv.Atoi(value); err != nil ||
+				(rule.Interval < 1) {
+				return nil, errors.Newf("ical: invalid interval '%s'", value)
+			}
+		case "COUNT":
+			if rule.Count, err = strconv.Atoi(value); err != nil ||
+				(rule.Count < 1) {
+				return nil, errors.Newf("ical: invalid count '%s'", value)
+			}
+		case "UNTIL":
+			until, _, err := parseTime(value, nil, loc)
+			if err != nil {
+				return nil, errors.Wrap(err, "ical: parse until")
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				wn, err := parseWeekdayNum(v)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, wn)
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				d, err := strconv.Atoi(v)
+				if (err != nil) || (d == 0) || (d < -31) || (d > 31) {
+					return nil, errors.Newf("ical: invalid month day '%s'", v)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, d)
+			}
+		case "BYMONTH":
+			for _, v := range strings.Split(value, ",") {
+				m, err := strconv.Atoi(v)
+				if (err != nil) || (m < 1) || (m > 12) {
+					return nil, errors.Newf("ical: invalid month '%s'", v)
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(m))
+			}
+		case "WKST":
+			wd, ok := _weekdays[strings.ToUpper(value)]
+			if !ok {
+				return nil, errors.Newf("ical: invalid week start '%s'", value)
+			}
+			rule.WeekStart = wd
+		default:
+			return nil, errors.WithDetailf(
+				ErrUnsupportedRule,
+				"Unsupported rule part '%s'.", key,
+			)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("ical: rule is missing a frequency")
+	}
+	if (rule.Count > 0) && (rule.Until != nil) {
+		return nil, errors.New("ical: rule has both a count and an until")
+	}
+	if (rule.Freq == FreqYearly) && (len(rule.ByMonth) == 0) {
+		for _, wn := range rule.ByDay {
+			if wn.N != 0 {
+				return nil, errors.WithDetail(
+					ErrUnsupportedRule,
+					"Yearly rules with numbered weekdays must specify months.",
+				)
+			}
+		}
+	}
+	return &rule, nil
+}
+
+func parseWeekdayNum(s string) (WeekdayNum, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if len(s) < 2 {
+		return WeekdayNum{}, errors.Newf("ical: invalid weekday '%s'", s)
+	}
+	wd, ok := _weekdays[s[len(s)-2:]]
+	if !ok {
+		return WeekdayNum{}, errors.Newf("ical: invalid weekday '%s'", s)
+	}
+	wn := WeekdayNum{Weekday: wd}
+	if n := s[:len(s)-2]; n != "" {
+		var err error
+		if wn.N, err = strconv.Atoi(n); err != nil || (wn.N == 0) ||
+			(wn.N < -5) || (wn.N > 5) {
+			return WeekdayNum{}, errors.Newf("ical: invalid weekday '%s'", s)
+		}
+	}
+	return wn, nil
+}
+
+// _maxPeriods limits the number of periods that an RRule is expanded over,
+// to guard against rules that never produce any occurrences.
+const _maxPeriods = 100000
+
+// Between returns the start times of the occurrences of the rule that start
+// at or after from and before to, for a recurring event that first starts at
+// dtstart.
+//
+// Occurrences keep the wall clock time of dtstart, even across DST
+// transitions.
+func (r *RRule) Between(dtstart, from, to time.Time) []time.Time {
+	var (
+		times []time.Time
+		count int
+	)
+	for k := 0; k < _maxPeriods; k++ {
+		cands := r.period(dtstart, k)
+		if cands == nil {
+			continue
+		}
+		for _, t := range cands {
+			if t.Before(dtstart) {
+				continue
+			}
+			if (r.Until != nil) && t.After(*r.Until) {
+				return times
+			}
+			if !t.Before(to) {
+				return times
+			}
+			count++
+			if !t.Before(from) {
+				times = append(times, t)
+			}
+			if (r.Count > 0) && (count >= r.Count) {
+				return times
+			}
+		}
+	}
+	return times
+}
+
+// period returns the sorted candidate occurrences in the k-th period after
+// dtstart, or nil if the period has no candidates.
+func (r *RRule) period(dtstart time.Time, k int) []time.Time {
+	var (
+		loc   = dtstart.Location()
+		n     = k * r.Interval
+		clock = func(y int, m time.Month, d int) time.Time {
+			return wallClock(
+				y, m, d,
+				dtstart.Hour(), dtstart.Minute(), dtstart.Second(),
+				loc,
+			)
+		}
+		cands []time.Time
+	)
+
+	switch r.Freq {
+	case FreqDaily:
+		t := clock(dtstart.Year(), dtstart.Month(), dtstart.Day()+n)
+		if r.matchesDay(t) && r.matchesMonthDay(t) && r.matchesMonth(t) {
+			cands = append(cands, t)
+		}
+
+	case FreqWeekly:
+		offset := (int(dtstart.Weekday()) - int(r.WeekStart) + 7) % 7
+		week := clock(
+			dtstart.Year(), dtstart.Month(), dtstart.Day()-offset+7*n,
+		)
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []WeekdayNum{{Weekday: dtstart.Weekday()}}
+		}
+		for _, wn := range days {
+			d := (int(wn.Weekday) - int(r.WeekStart) + 7) % 7
+			t := clock(week.Year(), week.Month(), week.Day()+d)
+			if r.matchesMonth(t) {
+				cands = append(cands, t)
+			}
+		}
+
+	case FreqMonthly:
+		m := clock(dtstart.Year(), dtstart.Month()+time.Month(n), 1)
+		if r.matchesMonth(m) {
+			cands = r.monthDays(m, dtstart, clock)
+		}
+
+	case FreqYearly:
+		year := dtstart.Year() + n
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{dtstart.Month()}
+		}
+		for _, month := range months {
+			cands = append(cands, r.monthDays(clock(year, month, 1), dtstart, clock)...)
+		}
+	}
+
+	sort.Slice(cands, func(i, j int) bool { return cands[i].Before(cands[j]) })
+	return dedupe(cands)
+}
+
+// wallClock returns the time in loc with the specified date and wall clock
+// time.
+//
+// Times that don't exist (since they fall in a gap when DST starts) are
+// interpreted using the UTC offset from before the gap, as required by RFC
+// 5545, Section 3.3.5; i.e. 2:30 AM becomes 3:30 AM.
+func wallClock(
+	year int, month time.Month, day int,
+	hour, min, sec int,
+	loc *time.Location,
+) time.Time {
+	t := time.Date(year, month, day, hour, min, sec, 0, loc)
+	if (t.Hour() == hour) && (t.Minute() == min) {
+		return t
+	}
+
+	// time.Date uses the offset from after the gap, which yields an instant
+	// before the gap (and so t is in the zone from before the gap).
+	_, offset := t.Zone()
+	return time.Date(
+		year, month, day,
+		hour, min, sec, 0,
+		time.FixedZone("", offset),
+	).In(loc)
+}
+
+// monthDays returns the candidate occurrences within the month that starts
+// at m.
+func (r *RRule) monthDays(
+	m, dtstart time.Time,
+	clock func(int, time.Month, int) time.Time,
+) []time.Time {
+	var (
+		y, mon = m.Year(), m.Month()
+		last   = clock(y, mon+1, 0).Day()
+		days   []int
+	)
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = last + d + 1
+			}
+			if (d >= 1) && (d <= last) {
+				days = append(days, d)
+			}
+		}
+	case len(r.ByDay) > 0:
+		for d := 1; d <= last; d++ {
+			days = append(days, d)
+		}
+	default:
+		if d := dtstart.Day(); d <= last {
+			days = append(days, d)
+		}
+	}
+
+	var cands []time.Time
+	for _, d := range days {
+		t := clock(y, mon, d)
+		if r.matchesMonthlyDay(t, last) {
+			cands = append(cands, t)
+		}
+	}
+	return cands
+}
+
+// matchesMonthlyDay reports whether t matches the BYDAY part of a monthly (or
+// yearly) rule, where numbered weekdays are counted within the month.
+func (r *RRule) matchesMonthlyDay(t time.Time, last int) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, wn := range r.ByDay {
+		if t.Weekday() != wn.Weekday {
+			continue
+		}
+		switch {
+		case wn.N == 0:
+			return true
+		case wn.N > 0:
+			if (t.Day()-1)/7+1 == wn.N {
+				return true
+			}
+		default:
+			if (last-t.Day())/7+1 == -wn.N {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *RRule) matchesDay(t time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, wn := range r.ByDay {
+		if t.Weekday() == wn.Weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RRule) matchesMonthDay(t time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	last := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	for _, d := range r.ByMonthDay {
+		if (d == t.Day()) || (last+d+1 == t.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RRule) matchesMonth(t time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if t.Month() == m {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupe(times []time.Time) []time.Time {
+	if len(times) < 2 {
+		return times
+	}
+	out := times[:1]
+	for _, t := range times[1:] {
+		if !t.Equal(out[len(out)-1]) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,124 @@
+package ical
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRRuleBetween(t *testing.T) {
+	loc := loadToronto(t)
+	at := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, loc)
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		dtstart  time.Time
+		from, to time.Time
+		want     []time.Time
+	}{
+		{
+			// Crosses the end of DST on November 3, keeping the wall clock
+			// time of DTSTART.
+			name:    "weekly with count",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=5",
+			dtstart: at(2019, time.October, 28, 9, 30),
+			from:    at(2019, time.October, 1, 0, 0),
+			to:      at(2020, time.January, 1, 0, 0),
+			want: []time.Time{
+				at(2019, time.October, 28, 9, 30),
+				at(2019, time.October, 30, 9, 30),
+				at(2019, time.November, 1, 9, 30),
+				at(2019, time.November, 4, 9, 30),
+				at(2019, time.November, 6, 9, 30),
+			},
+		},
+		{
+			// Occurrences before from still count towards COUNT.
+			name:    "weekly with count from later",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=5",
+			dtstart: at(2019, time.October, 28, 9, 30),
+			from:    at(2019, time.November, 2, 0, 0),
+			to:      at(2020, time.January, 1, 0, 0),
+			want: []time.Time{
+				at(2019, time.November, 4, 9, 30),
+				at(2019, time.November, 6, 9, 30),
+			},
+		},
+		{
+			name:    "last friday of the month",
+			rule:    "FREQ=MONTHLY;BYDAY=-1FR",
+			dtstart: at(2019, time.September, 27, 15, 0),
+			from:    at(2019, time.September, 1, 0, 0),
+			to:      at(2020, time.February, 1, 0, 0),
+			want: []time.Time{
+				at(2019, time.September, 27, 15, 0),
+				at(2019, time.October, 25, 15, 0),
+				at(2019, time.November, 29, 15, 0),
+				at(2019, time.December, 27, 15, 0),
+				at(2020, time.January, 31, 15, 0),
+			},
+		},
+		{
+			// 2:30 AM doesn't exist on March 10, 2019; time.Date normalizes it
+			// to 3:30 AM.
+			name:    "daily across the start of DST",
+			rule:    "FREQ=DAILY;UNTIL=20190311T235959",
+			dtstart: at(2019, time.March, 9, 2, 30),
+			from:    at(2019, time.March, 1, 0, 0),
+			to:      at(2019, time.April, 1, 0, 0),
+			want: []time.Time{
+				at(2019, time.March, 9, 2, 30),
+				at(2019, time.March, 10, 3, 30),
+				at(2019, time.March, 11, 2, 30),
+			},
+		},
+		{
+			name:    "every other week until",
+			rule:    "FREQ=WEEKLY;INTERVAL=2;UNTIL=20191201T000000Z",
+			dtstart: at(2019, time.November, 1, 12, 0),
+			from:    at(2019, time.November, 1, 0, 0),
+			to:      at(2020, time.January, 1, 0, 0),
+			want: []time.Time{
+				at(2019, time.November, 1, 12, 0),
+				at(2019, time.November, 15, 12, 0),
+				at(2019, time.November, 29, 12, 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRRule(tt.rule, loc)
+			if err != nil {
+				t.Fatalf("parse rule: %v", err)
+			}
+			got := rule.Between(tt.dtstart, tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d occurrences %v, want %d %v",
+					len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseRRuleErrors(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"COUNT=3",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;COUNT=3;UNTIL=20191201T000000Z",
+		"FREQ=MONTHLY;BYDAY=6FR",
+		"FREQ=YEARLY;BYDAY=1MO",
+		"FREQ=DAILY;BYSETPOS=1",
+	} {
+		if _, err := ParseRRule(rule, time.UTC); err == nil {
+			t.Errorf("ParseRRule(%q): expected an error", rule)
+		}
+	}
+}
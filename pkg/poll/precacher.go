@@ -2,6 +2,7 @@ package poll
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -63,6 +64,15 @@ func (pc Precacher) Results() (val zero.Interface, err error) {
 	return pc.ca.Results()
 }
 
+// Update replaces the latest cached value with the result of fn, which is
+// called with the current value.
+//
+// If the cache is empty, Update does nothing. Updates are serialized with the
+// values received from the Producer.
+func (pc Precacher) Update(fn func(zero.Interface) zero.Interface) {
+	pc.ca.Update(fn)
+}
+
 // Stop stops the Precacher from requesting new values from its Producer.
 func (pc Precacher) Stop() { pc.pl.Stop() }
 
@@ -76,20 +86,25 @@ func newCacheActor(p Producer, log *logrus.Entry) *cacheActor {
 
 type cacheActor struct {
 	Producer
-	result
-	log   *logrus.Entry
+	log *logrus.Entry
+
+	mux   sync.Mutex
+	res   result
 	empty bool
 }
 
 func (ca *cacheActor) Recv(v zero.Interface, err error) {
+	ca.mux.Lock()
+	defer ca.mux.Unlock()
+
 	log := ca.log.WithField("value", v)
 	ca.empty = false
-	ca.Error = err
+	ca.res.Error = err
 
 	// Only save new value if it is non-nil; that way, the previous value
 	// can be used in the event that an error occurs.
 	if v != nil {
-		ca.Value = v
+		ca.res.Value = v
 	}
 
 	if err != nil {
@@ -102,13 +117,24 @@ func (ca *cacheActor) Recv(v zero.Interface, err error) {
 }
 
 func (ca *cacheActor) Results() (zero.Interface, error) {
+	ca.mux.Lock()
+	defer ca.mux.Unlock()
 	if ca.empty {
 		return nil, ErrCacheEmpty
 	}
-	res := ca.result
+	res := ca.res
 	return res.Value, res.Error
 }
 
+func (ca *cacheActor) Update(fn func(zero.Interface) zero.Interface) {
+	ca.mux.Lock()
+	defer ca.mux.Unlock()
+	if ca.empty || (ca.res.Value == nil) {
+		return
+	}
+	ca.res.Value = fn(ca.res.Value)
+}
+
 // ErrCacheEmpty is returned by Precacher.Results when no values have been
 // received yet from the Producer.
 var ErrCacheEmpty = errors.New("poll: empty cache")
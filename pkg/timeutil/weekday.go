@@ -0,0 +1,16 @@
+package timeutil
+
+import (
+	"strings"
+	"time"
+)
+
+// ParseWeekday parses the name of a weekday, like "Monday", ignoring case.
+func ParseWeekday(name string) (time.Weekday, bool) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if strings.EqualFold(name, wd.String()) {
+			return wd, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,24 @@
+package productivity
+
+import (
+	"context"
+	"time"
+)
+
+// An Activity is the time spent using a particular application or website.
+type Activity struct {
+	// Name is the name of the application or website.
+	Name string `json:"name"`
+
+	// Group is the source's own classification of the Activity (i.e.
+	// RescueTime's "Software Development"), if it has one.
+	Group *string `json:"group"`
+
+	Category Category      `json:"category"`
+	Duration time.Duration `json:"duration"`
+}
+
+// An ActivitySource can load Activities for a given date.
+type ActivitySource interface {
+	GetActivities(ctx context.Context, date time.Time) ([]Activity, error)
+}
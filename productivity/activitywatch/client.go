@@ -0,0 +1,152 @@
+package activitywatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/pkg/httputil"
+)
+
+// DefaultBaseURL is the base URL of an aw-server running locally, on its
+// default port.
+const DefaultBaseURL = "http://localhost:5600"
+
+// Bucket types reported by the standard ActivityWatch watchers.
+const (
+	BucketTypeWindow = "currentwindow"
+	BucketTypeAFK    = "afkstatus"
+)
+
+// A Client can make requests to the REST API of an ActivityWatch server
+// (aw-server).
+type Client struct {
+	httpc   *http.Client
+	baseURL string
+}
+
+var _ httputil.BasicClient = (*Client)(nil)
+
+type (
+	// A Bucket is a collection of Events, recorded by a single watcher.
+	Bucket struct {
+		ID       string `json:"id"`
+		Type     string `json:"type"`
+		Client   string `json:"client"`
+		Hostname string `json:"hostname"`
+	}
+
+	// An Event is something that a watcher observed over a period of time.
+	Event struct {
+		Timestamp time.Time              `json:"timestamp"`
+		Duration  float64                `json:"duration"` // in seconds
+		Data      map[string]interface{} `json:"data"`
+	}
+)
+
+// End returns the time at which e ends.
+func (e *Event) End() time.Time {
+	return e.Timestamp.Add(time.Duration(e.Duration * float64(time.Second)))
+}
+
+// DataString returns the string value in e's Data with the specified key, or
+// an empty string if there is no such value.
+func (e *Event) DataString(key string) string {
+	s, _ := e.Data[key].(string)
+	return s
+}
+
+// NewClient creates a new Client for the aw-server at baseURL (or
+// DefaultBaseURL, if baseURL is empty).
+//
+// aw-server only listens locally, and so it doesn't require authentication.
+func NewClient(baseURL string, opts ...httputil.BasicClientOption) *Client {
+	opt := httputil.BasicClientOptions{
+		HTTPClient: new(http.Client),
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		httpc:   opt.HTTPClient,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Do sends an HTTP request and returns an HTTP response.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	return c.httpc.Do(req)
+}
+
+// Buckets lists the server's Buckets.
+func (c *Client) Buckets(ctx context.Context) ([]Bucket, error) {
+	var buckets map[string]Bucket
+	if err := c.getJSON(ctx, "/buckets/", nil, &buckets); err != nil {
+		return nil, err
+	}
+	list := make([]Bucket, 0, len(buckets))
+	for id, b := range buckets {
+		b.ID = id
+		list = append(list, b)
+	}
+	return list, nil
+}
+
+// Events lists the Events in the Bucket with the specified ID, which occur
+// between start and end.
+func (c *Client) Events(
+	ctx context.Context,
+	bucketID string,
+	start, end time.Time,
+) ([]Event, error) {
+	params := make(url.Values)
+	params.Set("start", start.Format(time.RFC3339))
+	params.Set("end", end.Format(time.RFC3339))
+	params.Set("limit", "-1")
+
+	var events []Event
+	if err := c.getJSON(
+		ctx,
+		"/buckets/"+url.PathEscape(bucketID)+"/events", params,
+		&events,
+	); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (c *Client) getJSON(
+	ctx context.Context,
+	path string, params url.Values,
+	v interface{},
+) error {
+	u := c.baseURL + "/api/0" + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return errors.Wrap(err, "activitywatch: create request")
+	}
+	res, err := c.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "activitywatch: perform request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return errors.Newf("activitywatch: bad response status '%s'", res.Status)
+	}
+	if err = json.NewDecoder(res.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "activitywatch: decode response as JSON")
+	}
+	return nil
+}
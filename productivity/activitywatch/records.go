@@ -0,0 +1,335 @@
+package activitywatch
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+// NewRecordSource creates a new productivity.RecordSource that reads window
+// events from an ActivityWatch server, and classifies them using cl.
+//
+// Time during which the server's AFK watcher reports that I was away is not
+// counted.
+func NewRecordSource(
+	c *Client,
+	cl *Classifier,
+	opts ...RecordSourceOption,
+) productivity.RecordSource {
+	return newRecordSource(c, cl, opts)
+}
+
+// NewIntervalSource creates a new productivity.IntervalSource that reads
+// window events from an ActivityWatch server, like a RecordSource.
+func NewIntervalSource(
+	c *Client,
+	cl *Classifier,
+	opts ...RecordSourceOption,
+) productivity.IntervalSource {
+	return newRecordSource(c, cl, opts)
+}
+
+// NewActivitySource creates a new productivity.ActivitySource that reads
+// window events from an ActivityWatch server, like a RecordSource.
+//
+// Each productivity.Activity is named after an app; apps whose window events
+// are classified differently are listed once per productivity.Category.
+func NewActivitySource(
+	c *Client,
+	cl *Classifier,
+	opts ...RecordSourceOption,
+) productivity.ActivitySource {
+	return newRecordSource(c, cl, opts)
+}
+
+func newRecordSource(
+	c *Client,
+	cl *Classifier,
+	opts []RecordSourceOption,
+) recordSource {
+	var opt RecordSourceOptions
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return recordSource{
+		client:     c,
+		classifier: cl,
+		hostname:   opt.Hostname,
+	}
+}
+
+// RecordSourceWithHostname configures a RecordSource to only read events that
+// were recorded on the host with the specified name.
+func RecordSourceWithHostname(name string) RecordSourceOption {
+	return func(opt *RecordSourceOptions) { opt.Hostname = name }
+}
+
+type (
+	// RecordSourceOptions configures a RecordSource.
+	RecordSourceOptions struct {
+		// If non-empty, only events recorded on the host with this name are
+		// read.
+		Hostname string
+	}
+
+	// A RecordSourceOption modifies a RecordSourceOptions.
+	RecordSourceOption func(*RecordSourceOptions)
+)
+
+type recordSource struct {
+	client     *Client
+	classifier *Classifier
+	hostname   string
+}
+
+var (
+	_ productivity.RecordSource   = (*recordSource)(nil)
+	_ productivity.ActivitySource = (*recordSource)(nil)
+	_ productivity.IntervalSource = (*recordSource)(nil)
+)
+
+// An interval is a period of time.
+type interval struct{ start, end time.Time }
+
+func (iv interval) duration() time.Duration { return iv.end.Sub(iv.start) }
+
+func (src recordSource) GetRecords(
+	ctx context.Context,
+	date time.Time,
+) ([]productivity.Record, error) {
+	start, end := dayRange(date)
+	durations := make(map[productivity.Category]time.Duration)
+	if err := src.walk(
+		ctx, start, end,
+		func(_, _ string, cat productivity.Category, iv interval) {
+			durations[cat] += iv.duration()
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	records := make([]productivity.Record, 0, len(durations))
+	for cat, dur := range durations {
+		records = append(records, productivity.Record{
+			Category: cat,
+			Duration: dur.Round(time.Second),
+		})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Category < records[j].Category
+	})
+	return records, nil
+}
+
+func (src recordSource) GetActivities(
+	ctx context.Context,
+	date time.Time,
+) ([]productivity.Activity, error) {
+	type activityKey struct {
+		app string
+		cat productivity.Category
+	}
+	start, end := dayRange(date)
+	durations := make(map[activityKey]time.Duration)
+	if err := src.walk(
+		ctx, start, end,
+		func(app, _ string, cat productivity.Category, iv interval) {
+			durations[activityKey{app: app, cat: cat}] += iv.duration()
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	acts := make([]productivity.Activity, 0, len(durations))
+	for key, dur := range durations {
+		acts = append(acts, productivity.Activity{
+			Name:     key.app,
+			Category: key.cat,
+			Duration: dur.Round(time.Second),
+		})
+	}
+	sort.Slice(acts, func(i, j int) bool {
+		if acts[i].Duration != acts[j].Duration {
+			return acts[i].Duration > acts[j].Duration
+		}
+		return acts[i].Name < acts[j].Name
+	})
+	return acts, nil
+}
+
+func (src recordSource) GetIntervalRecords(
+	ctx context.Context,
+	from, to time.Time,
+	opts ...productivity.IntervalOption,
+) ([]productivity.IntervalRecord, error) {
+	type intervalKey struct {
+		start time.Time
+		cat   productivity.Category
+	}
+	var (
+		opt       = productivity.BuildIntervalOptions(opts...)
+		loc       = from.Location()
+		durations = make(map[intervalKey]time.Duration)
+	)
+
+	// bounds returns the bounds of the interval that contains t.
+	var bounds func(t time.Time) (start, end time.Time)
+	switch res := opt.Resolution; res {
+	case productivity.ResolutionHour:
+		bounds = func(t time.Time) (start, end time.Time) {
+			start = time.Date(
+				t.Year(), t.Month(), t.Day(),
+				t.Hour(), 0, 0, 0,
+				loc,
+			)
+			return start, start.Add(time.Hour)
+		}
+	case productivity.ResolutionDay:
+		bounds = dayRange
+	default:
+		return nil, errors.WithDetailf(
+			productivity.ErrInvalidResolution,
+			"Interval records are not available at resolution '%s'.", res,
+		)
+	}
+
+	if err := src.walk(
+		ctx, from, to,
+		func(_, _ string, cat productivity.Category, iv interval) {
+			// Split the interval at interval boundaries.
+			for iv.start.Before(iv.end) {
+				start, end := bounds(iv.start.In(loc))
+				if end.After(iv.end) {
+					end = iv.end
+				}
+				durations[intervalKey{start: start, cat: cat}] += end.Sub(iv.start)
+				iv.start = end
+			}
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	recs := make([]productivity.IntervalRecord, 0, len(durations))
+	for key, dur := range durations {
+		recs = append(recs, productivity.IntervalRecord{
+			Start: key.start,
+			Record: productivity.Record{
+				Category: key.cat,
+				Duration: dur,
+			},
+		})
+	}
+	sort.Slice(recs, func(i, j int) bool {
+		if !recs[i].Start.Equal(recs[j].Start) {
+			return recs[i].Start.Before(recs[j].Start)
+		}
+		return recs[i].Category < recs[j].Category
+	})
+	return recs, nil
+}
+
+// dayRange returns the start and end of the day that contains t.
+func dayRange(t time.Time) (start, end time.Time) {
+	start = timeutil.DayStart(t)
+	return start, start.AddDate(0, 0, 1)
+}
+
+// walk calls fn with the classified app and title of each window event between
+// start and end, once for every interval during the event in which I was
+// active.
+func (src recordSource) walk(
+	ctx context.Context,
+	start, end time.Time,
+	fn func(app, title string, cat productivity.Category, iv interval),
+) error {
+	buckets, err := src.client.Buckets(ctx)
+	if err != nil {
+		return errors.Wrap(err, "activitywatch: list buckets")
+	}
+	var (
+		windows []Bucket
+		afks    = make(map[string]Bucket) // by hostname
+	)
+	for _, b := range buckets {
+		if (src.hostname != "") && (b.Hostname != src.hostname) {
+			continue
+		}
+		switch b.Type {
+		case BucketTypeWindow:
+			windows = append(windows, b)
+		case BucketTypeAFK:
+			afks[b.Hostname] = b
+		}
+	}
+
+	for _, wb := range windows {
+		// Determine when I was active on the bucket's host.
+		active := []interval{{start: start, end: end}}
+		if ab, ok := afks[wb.Hostname]; ok {
+			events, err := src.client.Events(ctx, ab.ID, start, end)
+			if err != nil {
+				return errors.Wrapf(
+					err,
+					"activitywatch: list events in bucket '%s'", ab.ID,
+				)
+			}
+			active = active[:0]
+			for i := range events {
+				e := &events[i]
+				if e.DataString("status") != "not-afk" {
+					continue
+				}
+				if iv, ok := clip(e.Timestamp, e.End(), start, end); ok {
+					active = append(active, iv)
+				}
+			}
+			sort.Slice(active, func(i, j int) bool {
+				return active[i].start.Before(active[j].start)
+			})
+		}
+
+		// Classify window events during active periods.
+		events, err := src.client.Events(ctx, wb.ID, start, end)
+		if err != nil {
+			return errors.Wrapf(
+				err,
+				"activitywatch: list events in bucket '%s'", wb.ID,
+			)
+		}
+		for i := range events {
+			e := &events[i]
+			var (
+				app   = e.DataString("app")
+				title = e.DataString("title")
+				cat   = src.classifier.Classify(app, title)
+			)
+			for _, iv := range active {
+				if !iv.start.Before(e.End()) {
+					break
+				}
+				if o, ok := clip(e.Timestamp, e.End(), iv.start, iv.end); ok {
+					fn(app, title, cat, o)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// clip clips the interval between start and end to the interval between min
+// and max, returning false if they don't overlap.
+func clip(start, end, min, max time.Time) (interval, bool) {
+	if start.Before(min) {
+		start = min
+	}
+	if end.After(max) {
+		end = max
+	}
+	return interval{start: start, end: end}, start.Before(end)
+}
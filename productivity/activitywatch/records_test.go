@@ -0,0 +1,302 @@
+package activitywatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+var _day = time.Date(2019, time.November, 4, 0, 0, 0, 0, time.UTC)
+
+// newTestServer creates an aw-server that serves two hosts, whose events
+// start at the beginning of _day.
+func newTestServer(t *testing.T) *httptest.Server {
+	at := func(h, m int) time.Time {
+		return _day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+	}
+	var (
+		buckets = map[string]Bucket{
+			"aw-watcher-window_laptop": {Type: BucketTypeWindow, Hostname: "laptop"},
+			"aw-watcher-afk_laptop":    {Type: BucketTypeAFK, Hostname: "laptop"},
+			"aw-watcher-window_desk":   {Type: BucketTypeWindow, Hostname: "desk"},
+			"aw-watcher-web-firefox":   {Type: "web.tab.current", Hostname: "laptop"},
+		}
+		events = map[string][]Event{
+			"aw-watcher-window_laptop": {
+				{
+					Timestamp: at(9, 0), Duration: 3600,
+					Data: map[string]interface{}{"app": "Code", "title": "main.go"},
+				},
+				{
+					Timestamp: at(10, 0), Duration: 1800,
+					Data: map[string]interface{}{"app": "Firefox", "title": "YouTube"},
+				},
+				{
+					Timestamp: at(10, 30), Duration: 900,
+					Data: map[string]interface{}{"app": "Finder", "title": "Downloads"},
+				},
+			},
+			"aw-watcher-afk_laptop": {
+				{
+					Timestamp: at(9, 0), Duration: 1800,
+					Data: map[string]interface{}{"status": "not-afk"},
+				},
+				{
+					Timestamp: at(9, 30), Duration: 1800,
+					Data: map[string]interface{}{"status": "afk"},
+				},
+				{
+					Timestamp: at(10, 0), Duration: 3600,
+					Data: map[string]interface{}{"status": "not-afk"},
+				},
+			},
+			"aw-watcher-window_desk": {
+				{
+					Timestamp: at(11, 0), Duration: 600,
+					Data: map[string]interface{}{"app": "Code", "title": "main.go"},
+				},
+			},
+		}
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/0/buckets/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/0/buckets/" {
+			_ = json.NewEncoder(w).Encode(buckets)
+			return
+		}
+
+		id := r.URL.Path[len("/api/0/buckets/"):]
+		id = id[:len(id)-len("/events")]
+		bucketEvents, ok := events[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		params := r.URL.Query()
+		if limit := params.Get("limit"); limit != "-1" {
+			t.Errorf("bucket '%s': limit = %q, want \"-1\"", id, limit)
+		}
+		start, err := time.Parse(time.RFC3339, params.Get("start"))
+		if err != nil {
+			t.Errorf("bucket '%s': parse start: %v", id, err)
+		}
+		if end, err := time.Parse(time.RFC3339, params.Get("end")); err != nil {
+			t.Errorf("bucket '%s': parse end: %v", id, err)
+		} else if end.Sub(start) != 24*time.Hour {
+			t.Errorf("bucket '%s': queried %s, want a day", id, end.Sub(start))
+		}
+		_ = json.NewEncoder(w).Encode(bucketEvents)
+	})
+	return httptest.NewServer(mux)
+}
+
+func newTestClassifier(t *testing.T) *Classifier {
+	cl, err := NewClassifier(
+		[]Rule{
+			{Category: "Very Productive", App: "^Code$"},
+			{Category: "Very Distracting", Title: "YouTube"},
+		},
+		productivity.CatNeutral,
+	)
+	if err != nil {
+		t.Fatalf("create classifier: %v", err)
+	}
+	return cl
+}
+
+func TestClientBuckets(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	buckets, err := NewClient(srv.URL + "/").Buckets(context.Background())
+	if err != nil {
+		t.Fatalf("list buckets: %v", err)
+	}
+	if len(buckets) != 4 {
+		t.Fatalf("got %d buckets, want 4", len(buckets))
+	}
+	for _, b := range buckets {
+		if b.ID == "" {
+			t.Errorf("bucket %+v has no ID", b)
+		}
+	}
+}
+
+func TestRecordSource(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	tests := []struct {
+		name       string
+		opts       []RecordSourceOption
+		records    []productivity.Record
+		activities []productivity.Activity
+	}{
+		{
+			name: "laptop",
+			opts: []RecordSourceOption{RecordSourceWithHostname("laptop")},
+			records: []productivity.Record{
+				{Category: productivity.CatVeryDistracting, Duration: 30 * time.Minute},
+				{Category: productivity.CatNeutral, Duration: 15 * time.Minute},
+				{Category: productivity.CatVeryProductive, Duration: 30 * time.Minute},
+			},
+			activities: []productivity.Activity{
+				{Name: "Code", Category: productivity.CatVeryProductive, Duration: 30 * time.Minute},
+				{Name: "Firefox", Category: productivity.CatVeryDistracting, Duration: 30 * time.Minute},
+				{Name: "Finder", Category: productivity.CatNeutral, Duration: 15 * time.Minute},
+			},
+		},
+		{
+			// The desk has no AFK watcher, so all of its window events count.
+			name: "all hosts",
+			records: []productivity.Record{
+				{Category: productivity.CatVeryDistracting, Duration: 30 * time.Minute},
+				{Category: productivity.CatNeutral, Duration: 15 * time.Minute},
+				{Category: productivity.CatVeryProductive, Duration: 40 * time.Minute},
+			},
+			activities: []productivity.Activity{
+				{Name: "Code", Category: productivity.CatVeryProductive, Duration: 40 * time.Minute},
+				{Name: "Firefox", Category: productivity.CatVeryDistracting, Duration: 30 * time.Minute},
+				{Name: "Finder", Category: productivity.CatNeutral, Duration: 15 * time.Minute},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				c   = NewClient(srv.URL)
+				cl  = newTestClassifier(t)
+			)
+
+			records, err := NewRecordSource(c, cl, tt.opts...).
+				GetRecords(ctx, _day.Add(12*time.Hour))
+			if err != nil {
+				t.Fatalf("get records: %v", err)
+			}
+			if !reflect.DeepEqual(records, tt.records) {
+				t.Errorf("records = %+v, want %+v", records, tt.records)
+			}
+
+			acts, err := NewActivitySource(c, cl, tt.opts...).
+				GetActivities(ctx, _day)
+			if err != nil {
+				t.Fatalf("get activities: %v", err)
+			}
+			if !reflect.DeepEqual(acts, tt.activities) {
+				t.Errorf("activities = %+v, want %+v", acts, tt.activities)
+			}
+		})
+	}
+}
+
+func TestIntervalSource(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	src := NewIntervalSource(
+		NewClient(srv.URL),
+		newTestClassifier(t),
+		RecordSourceWithHostname("laptop"),
+	)
+	recs, err := src.GetIntervalRecords(
+		context.Background(),
+		_day, _day.AddDate(0, 0, 1),
+	)
+	if err != nil {
+		t.Fatalf("get interval records: %v", err)
+	}
+
+	rec := func(
+		h int,
+		cat productivity.Category, dur time.Duration,
+	) productivity.IntervalRecord {
+		return productivity.IntervalRecord{
+			Start:  _day.Add(time.Duration(h) * time.Hour),
+			Record: productivity.Record{Category: cat, Duration: dur},
+		}
+	}
+	want := []productivity.IntervalRecord{
+		rec(9, productivity.CatVeryProductive, 30*time.Minute),
+		rec(10, productivity.CatVeryDistracting, 30*time.Minute),
+		rec(10, productivity.CatNeutral, 15*time.Minute),
+	}
+	if !reflect.DeepEqual(recs, want) {
+		t.Errorf("interval records = %+v, want %+v", recs, want)
+	}
+}
+
+func TestClientBadStatus(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL).Events(
+		context.Background(),
+		"missing", _day, _day.AddDate(0, 0, 1),
+	)
+	if err == nil {
+		t.Fatal("expected an error for a missing bucket")
+	}
+}
+
+func TestIntervalSourceDaily(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	src := NewIntervalSource(
+		NewClient(srv.URL),
+		newTestClassifier(t),
+		RecordSourceWithHostname("laptop"),
+	)
+	recs, err := src.GetIntervalRecords(
+		context.Background(),
+		_day, _day.AddDate(0, 0, 1),
+		productivity.IntervalWithResolution(productivity.ResolutionDay),
+	)
+	if err != nil {
+		t.Fatalf("get interval records: %v", err)
+	}
+
+	want := []productivity.IntervalRecord{
+		{
+			Start: _day,
+			Record: productivity.Record{
+				Category: productivity.CatVeryDistracting,
+				Duration: 30 * time.Minute,
+			},
+		},
+		{
+			Start: _day,
+			Record: productivity.Record{
+				Category: productivity.CatNeutral,
+				Duration: 15 * time.Minute,
+			},
+		},
+		{
+			Start: _day,
+			Record: productivity.Record{
+				Category: productivity.CatVeryProductive,
+				Duration: 30 * time.Minute,
+			},
+		},
+	}
+	if !reflect.DeepEqual(recs, want) {
+		t.Errorf("interval records = %+v, want %+v", recs, want)
+	}
+
+	if _, err = src.GetIntervalRecords(
+		context.Background(),
+		_day, _day.AddDate(0, 0, 7),
+		productivity.IntervalWithResolution(productivity.ResolutionWeek),
+	); err == nil {
+		t.Error("expected an error at resolution 'WEEK'")
+	}
+}
@@ -0,0 +1,97 @@
+package activitywatch
+
+import (
+	"regexp"
+
+	"github.com/cockroachdb/errors"
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+// A Rule assigns a productivity.Category to window events whose app and title
+// match a pair of regular expressions.
+type Rule struct {
+	// Category is the name of a productivity.Category, like "Very Productive".
+	Category string `yaml:"category"`
+
+	// App and Title are regular expressions that an event's app and window
+	// title must match; an empty expression matches anything.
+	App   string `yaml:"app"`
+	Title string `yaml:"title"`
+}
+
+var _ validation.Validatable = (*Rule)(nil)
+
+// Validate returns an error if the Rule is not valid.
+func (r *Rule) Validate() error {
+	_, err := compileRule(r)
+	return err
+}
+
+// A Classifier assigns productivity.Categories to window events using a list
+// of Rules.
+type Classifier struct {
+	rules []compiledRule
+	def   productivity.Category
+}
+
+type compiledRule struct {
+	cat        productivity.Category
+	app, title *regexp.Regexp
+}
+
+// NewClassifier creates a Classifier that classifies window events using the
+// first matching Rule in rules.
+//
+// Events that match no rules are classified as def.
+func NewClassifier(
+	rules []Rule,
+	def productivity.Category,
+) (*Classifier, error) {
+	cl := Classifier{
+		rules: make([]compiledRule, len(rules)),
+		def:   def,
+	}
+	for i := range rules {
+		cr, err := compileRule(&rules[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "activitywatch: rule %d", i)
+		}
+		cl.rules[i] = *cr
+	}
+	return &cl, nil
+}
+
+func compileRule(r *Rule) (*compiledRule, error) {
+	cat, err := productivity.ParseCategory(r.Category)
+	if err != nil {
+		return nil, err
+	}
+	cr := compiledRule{cat: cat}
+	if r.App != "" {
+		if cr.app, err = regexp.Compile(r.App); err != nil {
+			return nil, errors.Wrap(err, "compile app expression")
+		}
+	}
+	if r.Title != "" {
+		if cr.title, err = regexp.Compile(r.Title); err != nil {
+			return nil, errors.Wrap(err, "compile title expression")
+		}
+	}
+	return &cr, nil
+}
+
+// Classify classifies a window event with the specified app and title.
+func (cl *Classifier) Classify(app, title string) productivity.Category {
+	for _, r := range cl.rules {
+		if (r.app != nil) && !r.app.MatchString(app) {
+			continue
+		}
+		if (r.title != nil) && !r.title.MatchString(title) {
+			continue
+		}
+		return r.cat
+	}
+	return cl.def
+}
@@ -74,3 +74,15 @@ func (c *Category) UnmarshalJSON(b []byte) error {
 	*c = Category(data.ID)
 	return nil
 }
+
+// ParseCategory parses a Category from its name (i.e. "Very Productive") or
+// its string representation (i.e. "VeryProductive"), ignoring case.
+func ParseCategory(s string) (Category, error) {
+	s = strings.ReplaceAll(s, " ", "")
+	for c := range categoryNames {
+		if strings.EqualFold(s, c.String()) {
+			return c, nil
+		}
+	}
+	return 0, fmt.Errorf("productivity: unknown category '%s'", s)
+}
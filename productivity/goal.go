@@ -0,0 +1,217 @@
+package productivity
+
+import (
+	"context"
+	stderrs "errors"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// A Goal is a daily limit or target for the time spent doing activities of a
+// particular Category.
+type Goal struct {
+	ID string `json:"id"`
+	GoalSpec
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// A GoalSpec specifies the parameters of a Goal.
+type GoalSpec struct {
+	Category Category      `json:"category"`
+	Kind     GoalKind      `json:"kind"`
+	Duration time.Duration `json:"duration"`
+
+	// Weekdays are the days on which the Goal applies; if empty, it applies
+	// every day.
+	Weekdays []time.Weekday `json:"weekdays"`
+}
+
+// Validate returns an error if the GoalSpec is not valid.
+func (spec *GoalSpec) Validate() error {
+	if _, ok := categoryNames[spec.Category]; !ok {
+		return errors.WithDetailf(
+			ErrInvalidGoal,
+			"Unknown category '%d'.", spec.Category,
+		)
+	}
+	if err := spec.Kind.Validate(); err != nil {
+		return err
+	}
+	if spec.Duration <= 0 {
+		return errors.WithDetail(ErrInvalidGoal, "Duration must be positive.")
+	}
+	for _, wd := range spec.Weekdays {
+		if (wd < time.Sunday) || (wd > time.Saturday) {
+			return errors.WithDetailf(ErrInvalidGoal, "Unknown weekday '%d'.", wd)
+		}
+	}
+	return nil
+}
+
+// AppliesOn reports whether the Goal applies on the day of t.
+func (spec *GoalSpec) AppliesOn(t time.Time) bool {
+	if len(spec.Weekdays) == 0 {
+		return true
+	}
+	wd := t.Weekday()
+	for _, d := range spec.Weekdays {
+		if d == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// A GoalKind determines whether a Goal is a limit or a target.
+type GoalKind string
+
+// The set of valid GoalKinds.
+const (
+	GoalAtMost  GoalKind = "AT_MOST"
+	GoalAtLeast GoalKind = "AT_LEAST"
+)
+
+// Validate returns an error if k is not a valid GoalKind.
+func (k GoalKind) Validate() error {
+	switch k {
+	case GoalAtMost, GoalAtLeast:
+		return nil
+	default:
+		return errors.WithDetailf(ErrInvalidGoal, "Unknown goal kind '%s'.", k)
+	}
+}
+
+// GoalProgress describes the progress made towards a Goal on a particular day.
+type GoalProgress struct {
+	Goal Goal      `json:"goal"`
+	Date time.Time `json:"date"`
+
+	// Active is true if the Goal applies on Date.
+	Active bool `json:"active"`
+
+	// Duration is the time spent on activities of the Goal's Category, and
+	// Percent is Duration as a percentage of the Goal's Duration.
+	Duration time.Duration `json:"duration"`
+	Percent  float64       `json:"percent"`
+
+	// Met is true if the Goal has been met (or, for a limit, not yet
+	// exceeded), and Breached is true if a limit has been exceeded.
+	Met      bool `json:"met"`
+	Breached bool `json:"breached"`
+}
+
+// ProgressOf computes the progress made towards g, according to p.
+func ProgressOf(g *Goal, p *Productivity) GoalProgress {
+	prog := GoalProgress{
+		Goal:   *g,
+		Date:   p.Date,
+		Active: g.AppliesOn(p.Date),
+	}
+	for _, r := range p.Records {
+		if r.Category == g.Category {
+			prog.Duration += r.Duration
+		}
+	}
+	prog.Percent = float64(prog.Duration) / float64(g.Duration) * 100
+	switch g.Kind {
+	case GoalAtMost:
+		prog.Breached = prog.Active && (prog.Duration > g.Duration)
+		prog.Met = !prog.Breached
+	case GoalAtLeast:
+		prog.Met = !prog.Active || (prog.Duration >= g.Duration)
+	}
+	return prog
+}
+
+// A FocusSession is an explicit period of focused work.
+type FocusSession struct {
+	ID    string     `json:"id"`
+	Label *string    `json:"label"`
+	Start time.Time  `json:"start"`
+	End   *time.Time `json:"end"` // nil if the session is ongoing
+
+	// Records and Score describe my productivity during the session, computed
+	// like Productivity.Records and Productivity.Score.
+	Records []Record `json:"records"`
+	Score   *uint    `json:"score,omitempty"`
+}
+
+// Redacted returns a copy of the FocusSession without its label, for viewers
+// who cannot manage my Goals and FocusSessions.
+func (s *FocusSession) Redacted() FocusSession {
+	redacted := *s
+	redacted.Label = nil
+	return redacted
+}
+
+// StartWithLabel configures GoalService.StartFocusSession to label the
+// FocusSession.
+func StartWithLabel(label string) StartFocusSessionOption {
+	return func(opt *StartFocusSessionOptions) { opt.Label = &label }
+}
+
+type (
+	// A GoalService manages my Goals and FocusSessions.
+	GoalService interface {
+		// Goals lists my Goals, in order of creation.
+		Goals(ctx context.Context) ([]Goal, error)
+		CreateGoal(ctx context.Context, spec GoalSpec) (*Goal, error)
+		UpdateGoal(ctx context.Context, id string, spec GoalSpec) (*Goal, error)
+		DeleteGoal(ctx context.Context, id string) (*Goal, error)
+
+		// FocusSessions lists the FocusSessions that started on a given date,
+		// from oldest to newest.
+		FocusSessions(ctx context.Context, date time.Time) ([]FocusSession, error)
+
+		// StartFocusSession starts a new FocusSession; only one FocusSession
+		// may be ongoing at a time.
+		StartFocusSession(
+			ctx context.Context,
+			opts ...StartFocusSessionOption,
+		) (*FocusSession, error)
+
+		// StopFocusSession stops the ongoing FocusSession.
+		StopFocusSession(ctx context.Context) (*FocusSession, error)
+	}
+
+	// StartFocusSessionOptions are option parameters for
+	// GoalService.StartFocusSession.
+	StartFocusSessionOptions struct {
+		Label *string
+	}
+
+	// A StartFocusSessionOption modifies a StartFocusSessionOptions.
+	StartFocusSessionOption func(*StartFocusSessionOptions)
+
+	// A GoalStreamer can stream the GoalProgress of Goals as they are
+	// breached.
+	GoalStreamer interface {
+		StreamGoalBreaches(ctx context.Context, ch chan<- GoalProgress) error
+	}
+)
+
+// Errors returned by a GoalService.
+var (
+	ErrInvalidGoal = exthttp.WrapWithHTTPCode(
+		stderrs.New("productivity: invalid goal"),
+		http.StatusBadRequest,
+	)
+	ErrGoalNotFound = exthttp.WrapWithHTTPCode(
+		stderrs.New("productivity: no such goal"),
+		http.StatusNotFound,
+	)
+	ErrFocusSessionActive = exthttp.WrapWithHTTPCode(
+		errors.WithHint(
+			stderrs.New("productivity: a focus session is already ongoing"),
+			"Stop the ongoing focus session first.",
+		),
+		http.StatusConflict,
+	)
+	ErrNoFocusSession = exthttp.WrapWithHTTPCode(
+		stderrs.New("productivity: no ongoing focus session"),
+		http.StatusConflict,
+	)
+)
@@ -0,0 +1,9 @@
+package productivity
+
+import "go.stevenxie.me/api/v2/auth"
+
+// Valid permissions corresponding to this package.
+const (
+	PermActivities auth.Permission = "productivity.activities"
+	PermGoals      auth.Permission = "productivity.goals"
+)
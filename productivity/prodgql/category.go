@@ -1,8 +1,18 @@
 package prodgql
 
+import "go.stevenxie.me/api/v2/productivity"
+
 // A Category is a GraphQL representation of a productivity.Category.
 type Category struct {
 	ID     int    `json:"id"`
 	Name   string `json:"name"`
 	Weight int    `json:"weight"`
 }
+
+func categoryFrom(cat productivity.Category) *Category {
+	return &Category{
+		ID:     int(cat),
+		Name:   cat.Name(),
+		Weight: int(cat.Weight()),
+	}
+}
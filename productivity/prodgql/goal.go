@@ -0,0 +1,223 @@
+package prodgql
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/openlyinc/pointy"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+// NewMutation creates a new Mutation.
+func NewMutation(svc productivity.GoalService) Mutation {
+	return Mutation{svc: svc}
+}
+
+// A Mutation resolves productivity-related mutations.
+type Mutation struct {
+	svc productivity.GoalService
+}
+
+// CreateGoal creates a new productivity.Goal.
+func (mut Mutation) CreateGoal(
+	ctx context.Context,
+	goal GoalInput,
+) (*productivity.Goal, error) {
+	spec, err := goal.spec()
+	if err != nil {
+		return nil, err
+	}
+	return mut.svc.CreateGoal(ctx, *spec)
+}
+
+// UpdateGoal replaces the parameters of an existing productivity.Goal.
+func (mut Mutation) UpdateGoal(
+	ctx context.Context,
+	id string,
+	goal GoalInput,
+) (*productivity.Goal, error) {
+	spec, err := goal.spec()
+	if err != nil {
+		return nil, err
+	}
+	return mut.svc.UpdateGoal(ctx, id, *spec)
+}
+
+// DeleteGoal deletes a productivity.Goal.
+func (mut Mutation) DeleteGoal(
+	ctx context.Context,
+	id string,
+) (*productivity.Goal, error) {
+	return mut.svc.DeleteGoal(ctx, id)
+}
+
+// StartFocusSession starts a new productivity.FocusSession.
+func (mut Mutation) StartFocusSession(
+	ctx context.Context,
+	label *string,
+) (*productivity.FocusSession, error) {
+	var opts []productivity.StartFocusSessionOption
+	if label != nil {
+		opts = append(opts, productivity.StartWithLabel(*label))
+	}
+	return mut.svc.StartFocusSession(ctx, opts...)
+}
+
+// StopFocusSession stops the ongoing productivity.FocusSession.
+func (mut Mutation) StopFocusSession(
+	ctx context.Context,
+) (*productivity.FocusSession, error) {
+	return mut.svc.StopFocusSession(ctx)
+}
+
+// A GoalInput is a GraphQL representation of a productivity.GoalSpec.
+type GoalInput struct {
+	Category string   `json:"category"`
+	Kind     string   `json:"kind"`
+	Duration int      `json:"duration"` // in seconds
+	Weekdays []string `json:"weekdays"`
+}
+
+func (in *GoalInput) spec() (*productivity.GoalSpec, error) {
+	cat, err := productivity.ParseCategory(in.Category)
+	if err != nil {
+		return nil, errors.WithDetail(productivity.ErrInvalidGoal, err.Error())
+	}
+	spec := productivity.GoalSpec{
+		Category: cat,
+		Kind:     productivity.GoalKind(in.Kind),
+		Duration: time.Duration(in.Duration) * time.Second,
+	}
+	for _, name := range in.Weekdays {
+		wd, ok := timeutil.ParseWeekday(name)
+		if !ok {
+			return nil, errors.WithDetailf(
+				productivity.ErrInvalidGoal,
+				"Unknown weekday '%s'.", name,
+			)
+		}
+		spec.Weekdays = append(spec.Weekdays, wd)
+	}
+	if err = spec.Validate(); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// Goals resolves the productivity.GoalProgress of my Goals on the date of p.
+func (res Resolver) Goals(
+	ctx context.Context,
+	p *productivity.Productivity,
+	code string,
+) ([]productivity.GoalProgress, error) {
+	if err := requirePermission(
+		ctx, res.auth,
+		code, productivity.PermGoals,
+	); err != nil {
+		return nil, err
+	}
+	goals, err := res.goals.Goals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	progs := make([]productivity.GoalProgress, len(goals))
+	for i := range goals {
+		progs[i] = productivity.ProgressOf(&goals[i], p)
+	}
+	return progs, nil
+}
+
+// FocusSessions resolves the productivity.FocusSessions that started on the
+// date of p.
+//
+// Their labels are redacted unless code has the productivity.PermGoals
+// permission.
+func (res Resolver) FocusSessions(
+	ctx context.Context,
+	p *productivity.Productivity,
+	code *string,
+) ([]productivity.FocusSession, error) {
+	var visible bool
+	if code != nil {
+		var err error
+		if visible, err = res.auth.HasPermission(
+			ctx,
+			strings.TrimSpace(*code), productivity.PermGoals,
+		); err != nil {
+			return nil, errors.Wrap(err, "prodgql: checking permissions")
+		}
+	}
+	sessions, err := res.goals.FocusSessions(ctx, p.Date)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		for i := range sessions {
+			sessions[i] = sessions[i].Redacted()
+		}
+	}
+	return sessions, nil
+}
+
+// A GoalResolver resolves fields for a productivity.Goal.
+type GoalResolver zero.Struct
+
+//revive:disable-line:exported
+func (GoalResolver) Category(
+	_ context.Context,
+	g *productivity.Goal,
+) (*Category, error) {
+	return categoryFrom(g.Category), nil
+}
+
+//revive:disable-line:exported
+func (GoalResolver) Kind(_ context.Context, g *productivity.Goal) (string, error) {
+	return string(g.Kind), nil
+}
+
+//revive:disable-line:exported
+func (GoalResolver) Duration(_ context.Context, g *productivity.Goal) (int, error) {
+	return int(g.Duration.Seconds()), nil
+}
+
+//revive:disable-line:exported
+func (GoalResolver) Weekdays(
+	_ context.Context,
+	g *productivity.Goal,
+) ([]string, error) {
+	names := make([]string, len(g.Weekdays))
+	for i, wd := range g.Weekdays {
+		names[i] = wd.String()
+	}
+	return names, nil
+}
+
+// A GoalProgressResolver resolves fields for a productivity.GoalProgress.
+type GoalProgressResolver zero.Struct
+
+//revive:disable-line:exported
+func (GoalProgressResolver) Duration(
+	_ context.Context,
+	p *productivity.GoalProgress,
+) (int, error) {
+	return int(p.Duration.Seconds()), nil
+}
+
+// A FocusSessionResolver resolves fields for a productivity.FocusSession.
+type FocusSessionResolver zero.Struct
+
+//revive:disable-line:exported
+func (FocusSessionResolver) Score(
+	_ context.Context,
+	s *productivity.FocusSession,
+) (*int, error) {
+	if s.Score == nil {
+		return nil, nil
+	}
+	return pointy.Int(int(*s.Score)), nil
+}
@@ -2,6 +2,7 @@ package prodgql
 
 import (
 	"context"
+	"time"
 
 	"go.stevenxie.me/api/v2/productivity"
 )
@@ -20,3 +21,24 @@ type Query struct {
 func (q Query) Productivity(ctx context.Context) (*productivity.Productivity, error) {
 	return q.svc.CurrentProductivity(ctx)
 }
+
+// ProductivityTrend resolves my productivity.Trend between from and to.
+func (q Query) ProductivityTrend(
+	ctx context.Context,
+	from, to time.Time,
+	resolution *string,
+	window *int,
+) (*productivity.Trend, error) {
+	return q.svc.ProductivityRange(
+		ctx,
+		from, to,
+		func(opt *productivity.RangeOptions) {
+			if resolution != nil {
+				opt.Resolution = productivity.Resolution(*resolution)
+			}
+			if window != nil {
+				opt.Window = *window
+			}
+		},
+	)
+}
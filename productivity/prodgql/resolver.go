@@ -2,14 +2,42 @@ package prodgql
 
 import (
 	"context"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/openlyinc/pointy"
-	"go.stevenxie.me/api/v2/productivity"
 	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/auth"
+	"go.stevenxie.me/api/v2/auth/authutil"
+	"go.stevenxie.me/api/v2/productivity"
 )
 
+// NewResolver creates a new Resolver.
+func NewResolver(
+	svc productivity.Service,
+	goals productivity.GoalService,
+	auth auth.Service,
+) Resolver {
+	return Resolver{
+		svc:   svc,
+		goals: goals,
+		auth:  auth,
+		acts: &activityLoads{
+			loads: make(map[*productivity.Productivity]*activityLoad),
+		},
+	}
+}
+
 // A Resolver resolves fields for a productivity.Productivity.
-type Resolver zero.Struct
+type Resolver struct {
+	svc   productivity.Service
+	goals productivity.GoalService
+	auth  auth.Service
+	acts  *activityLoads
+}
 
 //revive:disable-line:exported
 func (Resolver) Score(
@@ -22,6 +50,130 @@ func (Resolver) Score(
 	return pointy.Int(int(*p.Score)), nil
 }
 
+// Activities resolves the productivity.Activities on the date of p.
+func (res Resolver) Activities(
+	ctx context.Context,
+	p *productivity.Productivity,
+	code string,
+	limit *int,
+) ([]productivity.Activity, error) {
+	acts, err := res.activities(ctx, p, code)
+	if err != nil {
+		return nil, err
+	}
+	return truncateActivities(acts, limit, 10), nil
+}
+
+// TopDistractions resolves the most distracting productivity.Activities on the
+// date of p.
+func (res Resolver) TopDistractions(
+	ctx context.Context,
+	p *productivity.Productivity,
+	code string,
+	limit *int,
+) ([]productivity.Activity, error) {
+	acts, err := res.activities(ctx, p, code)
+	if err != nil {
+		return nil, err
+	}
+	distractions := make([]productivity.Activity, 0, len(acts))
+	for _, a := range acts {
+		if a.Category < productivity.CatNeutral {
+			distractions = append(distractions, a)
+		}
+	}
+	return truncateActivities(distractions, limit, 5), nil
+}
+
+func (res Resolver) activities(
+	ctx context.Context,
+	p *productivity.Productivity,
+	code string,
+) ([]productivity.Activity, error) {
+	if err := requirePermission(
+		ctx, res.auth,
+		code, productivity.PermActivities,
+	); err != nil {
+		return nil, err
+	}
+	return res.acts.get(ctx, res.svc, p)
+}
+
+// _activityLoadTTL is the duration for which the activities loaded for a
+// productivity.Productivity are reused; it only needs to span a single
+// request.
+const _activityLoadTTL = time.Minute
+
+// activityLoads memoises the activities loaded for each
+// productivity.Productivity, so that resolving both Activities and
+// TopDistractions only loads them once.
+type activityLoads struct {
+	mux   sync.Mutex
+	loads map[*productivity.Productivity]*activityLoad
+}
+
+type activityLoad struct {
+	once sync.Once
+	acts []productivity.Activity
+	err  error
+}
+
+func (l *activityLoads) get(
+	ctx context.Context,
+	svc productivity.Service,
+	p *productivity.Productivity,
+) ([]productivity.Activity, error) {
+	l.mux.Lock()
+	load, ok := l.loads[p]
+	if !ok {
+		load = new(activityLoad)
+		l.loads[p] = load
+		time.AfterFunc(_activityLoadTTL, func() {
+			l.mux.Lock()
+			delete(l.loads, p)
+			l.mux.Unlock()
+		})
+	}
+	l.mux.Unlock()
+
+	load.once.Do(func() {
+		load.acts, load.err = svc.GetActivities(ctx, p.Date)
+	})
+	return load.acts, load.err
+}
+
+// requirePermission returns authutil.ErrAccessDenied if code does not have
+// the permission perm.
+func requirePermission(
+	ctx context.Context,
+	svc auth.Service,
+	code string, perm auth.Permission,
+) error {
+	ok, err := svc.HasPermission(ctx, strings.TrimSpace(code), perm)
+	if err != nil {
+		return errors.Wrap(err, "prodgql: checking permissions")
+	}
+	if !ok {
+		return authutil.ErrAccessDenied
+	}
+	return nil
+}
+
+func truncateActivities(
+	acts []productivity.Activity,
+	limit *int,
+	def int,
+) []productivity.Activity {
+	n := def
+	if limit != nil {
+		n = *limit
+	}
+	if (n >= 0) && (len(acts) > n) {
+		acts = acts[:n]
+	}
+	return acts
+}
+
 // A RecordResolver resolves fields for a productivity.Record.
 type RecordResolver zero.Struct
 
@@ -30,15 +182,56 @@ func (RecordResolver) Category(
 	_ context.Context,
 	r *productivity.Record,
 ) (*Category, error) {
-	cat := r.Category
-	return &Category{
-		ID:     int(cat),
-		Name:   cat.Name(),
-		Weight: int(cat.Weight()),
-	}, nil
+	return categoryFrom(r.Category), nil
 }
 
 //revive:disable
 func (RecordResolver) Duration(_ context.Context, r *productivity.Record) (int, error) {
 	return int(r.Duration.Seconds()), nil
 }
+
+//revive:enable
+
+// An ActivityResolver resolves fields for a productivity.Activity.
+type ActivityResolver zero.Struct
+
+//revive:disable-line:exported
+func (ActivityResolver) Category(
+	_ context.Context,
+	a *productivity.Activity,
+) (*Category, error) {
+	return categoryFrom(a.Category), nil
+}
+
+//revive:disable-line:exported
+func (ActivityResolver) Duration(
+	_ context.Context,
+	a *productivity.Activity,
+) (int, error) {
+	return int(a.Duration.Seconds()), nil
+}
+
+// A TrendResolver resolves fields for a productivity.Trend.
+type TrendResolver zero.Struct
+
+//revive:disable-line:exported
+func (TrendResolver) Resolution(
+	_ context.Context,
+	t *productivity.Trend,
+) (string, error) {
+	return string(t.Resolution), nil
+}
+
+// A TrendPointResolver resolves fields for a productivity.TrendPoint.
+type TrendPointResolver zero.Struct
+
+//revive:disable-line:exported
+func (TrendPointResolver) Score(
+	_ context.Context,
+	p *productivity.TrendPoint,
+) (*int, error) {
+	if p.Score == nil {
+		return nil, nil
+	}
+	return pointy.Int(int(*p.Score)), nil
+}
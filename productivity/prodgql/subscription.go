@@ -0,0 +1,62 @@
+package prodgql
+
+import (
+	"context"
+
+	"go.stevenxie.me/api/v2/auth"
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+// NewSubscriptionResolver creates a new SubscriptionResolver.
+func NewSubscriptionResolver(
+	stream productivity.GoalStreamer,
+	auth auth.Service,
+) SubscriptionResolver {
+	return SubscriptionResolver{
+		stream: stream,
+		auth:   auth,
+	}
+}
+
+// A SubscriptionResolver resolves productivity-related GraphQL subscriptions.
+type SubscriptionResolver struct {
+	stream productivity.GoalStreamer
+	auth   auth.Service
+}
+
+// GoalBreaches opens a stream of the productivity.GoalProgress of Goals as
+// they are breached, for the holder of a code with the
+// productivity.PermGoals permission.
+func (res SubscriptionResolver) GoalBreaches(
+	ctx context.Context,
+	code string,
+) (<-chan *productivity.GoalProgress, error) {
+	if err := requirePermission(
+		ctx, res.auth,
+		code, productivity.PermGoals,
+	); err != nil {
+		return nil, err
+	}
+
+	var (
+		src = make(chan productivity.GoalProgress, 1)
+		dst = make(chan *productivity.GoalProgress, 1)
+	)
+
+	go func(src <-chan productivity.GoalProgress,
+		dst chan<- *productivity.GoalProgress) {
+		for p := range src {
+			p := p
+			select {
+			case dst <- &p:
+			case <-ctx.Done():
+			}
+		}
+		close(dst)
+	}(src, dst)
+
+	if err := res.stream.StreamGoalBreaches(ctx, src); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
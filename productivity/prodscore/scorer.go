@@ -0,0 +1,202 @@
+package prodscore
+
+import (
+	"math"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+// Names of the scoring models reported by a productivity.Scorer created by
+// NewScorer.
+const (
+	ModelPulse    = "pulse"
+	ModelWeighted = "weighted"
+
+	// Modifiers are appended to the name of a scoring model, i.e.
+	// "pulse+time-of-day".
+	ModifierExcludeNeutral = "exclude-neutral"
+	ModifierTimeOfDay      = "time-of-day"
+)
+
+// Pulse returns a productivity.Scorer that computes RescueTime's productivity
+// pulse:
+// https://help.rescuetime.com/article/73-how-is-my-productivity-pulse-calculated
+func Pulse() productivity.Scorer {
+	s, _ := NewScorer()
+	return s
+}
+
+// NewScorer creates a new productivity.Scorer, which computes the weighted
+// average of the weights of each record's productivity.Category.
+//
+// By default, it computes RescueTime's productivity pulse; opts may change the
+// weights of each productivity.Category, exclude neutral time, or weight
+// time by the hour of day at which it was recorded (in which case the
+// productivity.Scorer is also a productivity.IntervalScorer).
+func NewScorer(opts ...ScorerOption) (productivity.Scorer, error) {
+	var opt ScorerOptions
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	weights := make(map[productivity.Category]float64, len(_pulseWeights))
+	for cat, w := range _pulseWeights {
+		weights[cat] = w
+	}
+	for cat, w := range opt.Weights {
+		if _, ok := weights[cat]; !ok {
+			return nil, errors.Newf("prodscore: unknown category '%d'", cat)
+		}
+		if (w < 0) || (w > 1) {
+			return nil, errors.Newf(
+				"prodscore: weight of '%s' must be between 0 and 1",
+				cat.Name(),
+			)
+		}
+		weights[cat] = w
+	}
+	for hour, w := range opt.HourWeights {
+		if (hour < 0) || (hour > 23) {
+			return nil, errors.Newf("prodscore: invalid hour '%d'", hour)
+		}
+		if w < 0 {
+			return nil, errors.Newf(
+				"prodscore: weight of hour '%d' must not be negative",
+				hour,
+			)
+		}
+	}
+
+	model := []string{ModelPulse}
+	if len(opt.Weights) > 0 {
+		model[0] = ModelWeighted
+	}
+	if opt.ExcludeNeutral {
+		model = append(model, ModifierExcludeNeutral)
+	}
+	if len(opt.HourWeights) > 0 {
+		model = append(model, ModifierTimeOfDay)
+	}
+
+	s := &scorer{
+		weights:        weights,
+		excludeNeutral: opt.ExcludeNeutral,
+		model:          strings.Join(model, "+"),
+	}
+	if len(opt.HourWeights) > 0 {
+		return &hourScorer{scorer: s, weights: opt.HourWeights}, nil
+	}
+	return s, nil
+}
+
+// ScorerWithWeights configures a productivity.Scorer to weight each
+// productivity.Category by a value between 0 and 1, instead of by its
+// productivity pulse weight.
+//
+// Categories that are missing from weights keep their pulse weights.
+func ScorerWithWeights(
+	weights map[productivity.Category]float64,
+) ScorerOption {
+	return func(opt *ScorerOptions) { opt.Weights = weights }
+}
+
+// ScorerWithExcludeNeutral configures a productivity.Scorer to ignore time
+// spent on neutral activities.
+func ScorerWithExcludeNeutral(exclude bool) ScorerOption {
+	return func(opt *ScorerOptions) { opt.ExcludeNeutral = exclude }
+}
+
+// ScorerWithHourWeights configures a productivity.Scorer to multiply the time
+// recorded during each hour of the day (0 to 23) by a weight. Hours that are
+// missing from weights have a weight of 1.
+//
+// Hour weights only apply to productivity.IntervalRecords.
+func ScorerWithHourWeights(weights map[int]float64) ScorerOption {
+	return func(opt *ScorerOptions) { opt.HourWeights = weights }
+}
+
+type (
+	// ScorerOptions configures a productivity.Scorer created by NewScorer.
+	ScorerOptions struct {
+		Weights        map[productivity.Category]float64
+		ExcludeNeutral bool
+		HourWeights    map[int]float64
+	}
+
+	// A ScorerOption modifies a ScorerOptions.
+	ScorerOption func(*ScorerOptions)
+)
+
+// The weights of each productivity.Category in RescueTime's productivity
+// pulse.
+var _pulseWeights = map[productivity.Category]float64{
+	productivity.CatVeryDistracting: 0,
+	productivity.CatDistracting:     0.25,
+	productivity.CatNeutral:         0.5,
+	productivity.CatProductive:      0.75,
+	productivity.CatVeryProductive:  1,
+}
+
+type scorer struct {
+	weights        map[productivity.Category]float64
+	excludeNeutral bool
+	model          string
+}
+
+var _ productivity.Scorer = (*scorer)(nil)
+
+func (s *scorer) Model() string { return s.model }
+
+func (s *scorer) Score(recs []productivity.Record) *uint {
+	var sum scoreSum
+	for _, r := range recs {
+		s.add(&sum, r, 1)
+	}
+	return sum.result()
+}
+
+func (s *scorer) add(sum *scoreSum, r productivity.Record, mult float64) {
+	if s.excludeNeutral && (r.Category == productivity.CatNeutral) {
+		return
+	}
+	secs := math.Round(r.Duration.Seconds()) * mult
+	sum.weighted += s.weights[r.Category] * secs
+	sum.total += secs
+}
+
+// An hourScorer is a scorer that also weights IntervalRecords by the hour of
+// day at which they were recorded.
+type hourScorer struct {
+	*scorer
+	weights map[int]float64
+}
+
+var _ productivity.IntervalScorer = (*hourScorer)(nil)
+
+func (s *hourScorer) ScoreIntervals(recs []productivity.IntervalRecord) *uint {
+	var sum scoreSum
+	for _, ir := range recs {
+		mult := 1.0
+		if w, ok := s.weights[ir.Start.Hour()]; ok {
+			mult = w
+		}
+		s.add(&sum, ir.Record, mult)
+	}
+	return sum.result()
+}
+
+type scoreSum struct {
+	weighted, total float64
+}
+
+// result returns the score of the sum, or nil if no time was recorded.
+func (sum *scoreSum) result() *uint {
+	if sum.total <= 0 {
+		return nil
+	}
+	score := uint(math.Round(sum.weighted / sum.total * 100))
+	return &score
+}
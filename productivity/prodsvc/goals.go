@@ -0,0 +1,573 @@
+package prodsvc
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/pkg/fanout"
+	"go.stevenxie.me/api/v2/pkg/poll"
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/productivity/prodscore"
+)
+
+// NewGoalService creates a new GoalService, which checks my Goals against the
+// productivity reported by svc, and scores FocusSessions using intervals.
+//
+// By default, FocusSessions are scored using the RescueTime productivity
+// pulse.
+//
+// If a path is configured, Goals and FocusSessions are loaded from and saved
+// to a file at that path; otherwise, they are only kept in memory.
+func NewGoalService(
+	svc productivity.Service,
+	intervals productivity.IntervalSource,
+	opts ...GoalServiceOption,
+) (*GoalService, error) {
+	opt := GoalServiceOptions{
+		Logger:        logutil.NoopEntry(),
+		Tracer:        new(opentracing.NoopTracer),
+		CheckInterval: 5 * time.Minute,
+		MaxSessions:   500,
+		Scorer:        prodscore.Pulse(),
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	log := logutil.WithComponent(opt.Logger, (*GoalService)(nil))
+	gs := &GoalService{
+		svc:       svc,
+		intervals: intervals,
+		log:       log,
+		tracer:    opt.Tracer,
+		opt:       opt,
+		hub: fanout.NewHub(
+			fanout.HubWithLogger(log),
+			fanout.HubWithBufferSize(8),
+		),
+		breached: make(map[string]zero.Struct),
+	}
+	if err := gs.load(); err != nil {
+		return nil, err
+	}
+	gs.poller = poll.NewPoller(
+		goalActor{gs: gs},
+		opt.CheckInterval,
+		poll.PollerWithLogger(log),
+	)
+	return gs, nil
+}
+
+// GoalServiceWithLogger configures a GoalService to write logs with log.
+func GoalServiceWithLogger(log *logrus.Entry) GoalServiceOption {
+	return func(opt *GoalServiceOptions) { opt.Logger = log }
+}
+
+// GoalServiceWithTracer configures a GoalService to trace calls with t.
+func GoalServiceWithTracer(t opentracing.Tracer) GoalServiceOption {
+	return func(opt *GoalServiceOptions) { opt.Tracer = t }
+}
+
+// GoalServiceWithPath configures a GoalService to save its Goals and
+// FocusSessions to a file at path.
+func GoalServiceWithPath(path string) GoalServiceOption {
+	return func(opt *GoalServiceOptions) { opt.Path = path }
+}
+
+// GoalServiceWithScorer configures a GoalService to score FocusSessions using
+// s.
+func GoalServiceWithScorer(s productivity.Scorer) GoalServiceOption {
+	return func(opt *GoalServiceOptions) { opt.Scorer = s }
+}
+
+// GoalServiceWithCheckInterval configures how often a GoalService checks
+// whether my Goals have been breached.
+func GoalServiceWithCheckInterval(n time.Duration) GoalServiceOption {
+	return func(opt *GoalServiceOptions) { opt.CheckInterval = n }
+}
+
+type (
+	// A GoalService implements a productivity.GoalService and a
+	// productivity.GoalStreamer.
+	GoalService struct {
+		svc       productivity.Service
+		intervals productivity.IntervalSource
+		log       *logrus.Entry
+		tracer    opentracing.Tracer
+		opt       GoalServiceOptions
+		hub       *fanout.Hub
+		poller    *poll.Poller
+
+		mux      sync.Mutex
+		state    goalState
+		breached map[string]zero.Struct // goals breached, by breachKey
+	}
+
+	// GoalServiceOptions configures a GoalService.
+	GoalServiceOptions struct {
+		Logger *logrus.Entry
+		Tracer opentracing.Tracer
+
+		Path          string
+		CheckInterval time.Duration
+		Scorer        productivity.Scorer
+
+		// MaxSessions is the number of FocusSessions to retain.
+		MaxSessions int
+	}
+
+	// A GoalServiceOption modifies a GoalServiceOptions.
+	GoalServiceOption func(*GoalServiceOptions)
+
+	// goalState is the persistent state of a GoalService.
+	goalState struct {
+		Seq      int                         `json:"seq"`
+		Goals    []productivity.Goal         `json:"goals"`
+		Sessions []productivity.FocusSession `json:"sessions"`
+	}
+)
+
+var (
+	_ productivity.GoalService  = (*GoalService)(nil)
+	_ productivity.GoalStreamer = (*GoalService)(nil)
+)
+
+// Goals implements productivity.GoalService.
+func (gs *GoalService) Goals(context.Context) ([]productivity.Goal, error) {
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+	goals := make([]productivity.Goal, len(gs.state.Goals))
+	copy(goals, gs.state.Goals)
+	return goals, nil
+}
+
+// CreateGoal implements productivity.GoalService.
+func (gs *GoalService) CreateGoal(
+	ctx context.Context,
+	spec productivity.GoalSpec,
+) (*productivity.Goal, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, gs.tracer,
+		name.OfFunc((*GoalService).CreateGoal),
+	)
+	defer span.Finish()
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+	g := productivity.Goal{
+		ID:        gs.nextID(),
+		GoalSpec:  spec,
+		CreatedAt: time.Now(),
+	}
+	gs.state.Goals = append(gs.state.Goals, g)
+	if err := gs.save(); err != nil {
+		return nil, err
+	}
+
+	gs.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod((*GoalService).CreateGoal),
+		"id":              g.ID,
+	}).WithContext(ctx).Info("Created goal.")
+	return &g, nil
+}
+
+// UpdateGoal implements productivity.GoalService.
+func (gs *GoalService) UpdateGoal(
+	ctx context.Context,
+	id string,
+	spec productivity.GoalSpec,
+) (*productivity.Goal, error) {
+	span, _ := opentracing.StartSpanFromContextWithTracer(
+		ctx, gs.tracer,
+		name.OfFunc((*GoalService).UpdateGoal),
+	)
+	defer span.Finish()
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+	i := gs.goalIndex(id)
+	if i < 0 {
+		return nil, productivity.ErrGoalNotFound
+	}
+	g := &gs.state.Goals[i]
+	g.GoalSpec = spec
+	if err := gs.save(); err != nil {
+		return nil, err
+	}
+	updated := *g
+	return &updated, nil
+}
+
+// DeleteGoal implements productivity.GoalService.
+func (gs *GoalService) DeleteGoal(
+	ctx context.Context,
+	id string,
+) (*productivity.Goal, error) {
+	span, _ := opentracing.StartSpanFromContextWithTracer(
+		ctx, gs.tracer,
+		name.OfFunc((*GoalService).DeleteGoal),
+	)
+	defer span.Finish()
+
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+	i := gs.goalIndex(id)
+	if i < 0 {
+		return nil, productivity.ErrGoalNotFound
+	}
+	g := gs.state.Goals[i]
+	gs.state.Goals = append(gs.state.Goals[:i], gs.state.Goals[i+1:]...)
+	if err := gs.save(); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// FocusSessions implements productivity.GoalService.
+func (gs *GoalService) FocusSessions(
+	ctx context.Context,
+	date time.Time,
+) ([]productivity.FocusSession, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, gs.tracer,
+		name.OfFunc((*GoalService).FocusSessions),
+	)
+	defer span.Finish()
+
+	var (
+		start    = timeutil.DayStart(date)
+		end      = start.AddDate(0, 0, 1)
+		sessions []productivity.FocusSession
+	)
+	gs.mux.Lock()
+	for _, s := range gs.state.Sessions {
+		if !s.Start.Before(start) && s.Start.Before(end) {
+			sessions = append(sessions, s)
+		}
+	}
+	gs.mux.Unlock()
+
+	for i := range sessions {
+		if err := gs.scoreSession(ctx, &sessions[i]); err != nil {
+			return nil, err
+		}
+	}
+	if sessions == nil {
+		sessions = []productivity.FocusSession{}
+	}
+	return sessions, nil
+}
+
+// StartFocusSession implements productivity.GoalService.
+func (gs *GoalService) StartFocusSession(
+	ctx context.Context,
+	opts ...productivity.StartFocusSessionOption,
+) (*productivity.FocusSession, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, gs.tracer,
+		name.OfFunc((*GoalService).StartFocusSession),
+	)
+	defer span.Finish()
+
+	var opt productivity.StartFocusSessionOptions
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+	if n := len(gs.state.Sessions); (n > 0) &&
+		(gs.state.Sessions[n-1].End == nil) {
+		return nil, productivity.ErrFocusSessionActive
+	}
+	s := productivity.FocusSession{
+		ID:      gs.nextID(),
+		Label:   opt.Label,
+		Start:   time.Now(),
+		Records: []productivity.Record{},
+	}
+	gs.state.Sessions = append(gs.state.Sessions, s)
+	if n := len(gs.state.Sessions) - gs.opt.MaxSessions; n > 0 {
+		gs.state.Sessions = gs.state.Sessions[n:]
+	}
+	if err := gs.save(); err != nil {
+		return nil, err
+	}
+
+	gs.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod((*GoalService).StartFocusSession),
+		"id":              s.ID,
+	}).WithContext(ctx).Info("Started focus session.")
+	return &s, nil
+}
+
+// StopFocusSession implements productivity.GoalService.
+func (gs *GoalService) StopFocusSession(
+	ctx context.Context,
+) (*productivity.FocusSession, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, gs.tracer,
+		name.OfFunc((*GoalService).StopFocusSession),
+	)
+	defer span.Finish()
+
+	gs.mux.Lock()
+	n := len(gs.state.Sessions)
+	if (n == 0) || (gs.state.Sessions[n-1].End != nil) {
+		gs.mux.Unlock()
+		return nil, productivity.ErrNoFocusSession
+	}
+	var (
+		s   = &gs.state.Sessions[n-1]
+		end = time.Now()
+	)
+	s.End = &end
+	stopped := *s
+	err := gs.save()
+	gs.mux.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	gs.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod((*GoalService).StopFocusSession),
+		"id":              stopped.ID,
+	}).WithContext(ctx).Info("Stopped focus session.")
+	if err = gs.scoreSession(ctx, &stopped); err != nil {
+		return nil, err
+	}
+	return &stopped, nil
+}
+
+// StreamGoalBreaches implements productivity.GoalStreamer.
+//
+// Each Goal is reported at most once per day.
+func (gs *GoalService) StreamGoalBreaches(
+	ctx context.Context,
+	ch chan<- productivity.GoalProgress,
+) error {
+	if ch == nil {
+		panic(errors.New("prodsvc: nil channel"))
+	}
+	send := func(ctx context.Context, v zero.Interface) bool {
+		select {
+		case ch <- v.(productivity.GoalProgress):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	if err := gs.hub.Subscribe(
+		ctx, send,
+		func() { close(ch) },
+	); err != nil {
+		return errors.Wrap(err, "prodsvc: subscribe to goal breaches")
+	}
+	return nil
+}
+
+// Stop stops the GoalService from checking my Goals, and closes all open
+// streams.
+func (gs *GoalService) Stop() {
+	gs.poller.Stop()
+	gs.hub.Close()
+}
+
+// scoreSession computes the Records and Score of s, by prorating the hourly
+// interval records that overlap with it.
+func (gs *GoalService) scoreSession(
+	ctx context.Context,
+	s *productivity.FocusSession,
+) error {
+	end := time.Now()
+	if s.End != nil {
+		end = *s.End
+	}
+	irecs, err := gs.intervals.GetIntervalRecords(
+		ctx,
+		intervalStart(s.Start, productivity.ResolutionHour), end,
+	)
+	if err != nil {
+		return errors.WithMessage(err, "prodsvc: get interval records")
+	}
+
+	var (
+		durations = make(map[productivity.Category]time.Duration)
+		prorated  = make([]productivity.IntervalRecord, 0, len(irecs))
+	)
+	for _, ir := range irecs {
+		var (
+			from = ir.Start
+			to   = ir.Start.Add(time.Hour)
+		)
+		if from.Before(s.Start) {
+			from = s.Start
+		}
+		if to.After(end) {
+			to = end
+		}
+		if !from.Before(to) {
+			continue
+		}
+		frac := float64(to.Sub(from)) / float64(time.Hour)
+		dur := time.Duration(float64(ir.Duration) * frac).Round(time.Second)
+		durations[ir.Category] += dur
+		ir.Duration = dur
+		prorated = append(prorated, ir)
+	}
+
+	s.Records = make([]productivity.Record, 0, len(durations))
+	for cat, dur := range durations {
+		s.Records = append(s.Records, productivity.Record{
+			Category: cat,
+			Duration: dur,
+		})
+	}
+	sort.Slice(s.Records, func(i, j int) bool {
+		return s.Records[i].Category.Weight() < s.Records[j].Category.Weight()
+	})
+	s.Score = scoreRecords(gs.opt.Scorer, s.Records, prorated)
+	return nil
+}
+
+// nextID returns a new ID for a Goal or FocusSession.
+//
+// gs.mux must be held.
+func (gs *GoalService) nextID() string {
+	gs.state.Seq++
+	return strconv.Itoa(gs.state.Seq)
+}
+
+// goalIndex returns the index of the Goal with the specified ID, or -1 if
+// there is no such Goal.
+//
+// gs.mux must be held.
+func (gs *GoalService) goalIndex(id string) int {
+	for i := range gs.state.Goals {
+		if gs.state.Goals[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// load loads the state of gs from its file, if configured.
+func (gs *GoalService) load() error {
+	if gs.opt.Path == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(gs.opt.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "prodsvc: read goals file")
+	}
+	if err = json.Unmarshal(data, &gs.state); err != nil {
+		return errors.Wrap(err, "prodsvc: decode goals file")
+	}
+	return nil
+}
+
+// save saves the state of gs to its file, if configured.
+//
+// gs.mux must be held.
+func (gs *GoalService) save() error {
+	if gs.opt.Path == "" {
+		return nil
+	}
+	data, err := json.Marshal(&gs.state)
+	if err != nil {
+		return errors.Wrap(err, "prodsvc: encode goals")
+	}
+	if err = os.MkdirAll(filepath.Dir(gs.opt.Path), 0755); err != nil {
+		return errors.Wrap(err, "prodsvc: create goals directory")
+	}
+
+	// Write to a temporary file first, so that the goals file is never left
+	// partially written.
+	tmp := gs.opt.Path + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return errors.Wrap(err, "prodsvc: write goals file")
+	}
+	if err = os.Rename(tmp, gs.opt.Path); err != nil {
+		return errors.Wrap(err, "prodsvc: replace goals file")
+	}
+	return nil
+}
+
+// A goalActor periodically checks the progress of my Goals, and publishes
+// newly breached ones.
+type goalActor struct {
+	gs *GoalService
+}
+
+var _ poll.Actor = (*goalActor)(nil)
+
+func (ga goalActor) Prod() (zero.Interface, error) {
+	goals, err := ga.gs.Goals(context.Background())
+	if (err != nil) || (len(goals) == 0) {
+		return nil, err
+	}
+	p, err := ga.gs.svc.CurrentProductivity(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	progs := make([]productivity.GoalProgress, len(goals))
+	for i := range goals {
+		progs[i] = productivity.ProgressOf(&goals[i], p)
+	}
+	return progs, nil
+}
+
+func (ga goalActor) Recv(v zero.Interface, err error) {
+	gs := ga.gs
+	if err != nil {
+		gs.log.WithError(err).Error("Failed to check goal progress.")
+		return
+	}
+	progs, _ := v.([]productivity.GoalProgress)
+
+	gs.mux.Lock()
+	var breaches []productivity.GoalProgress
+	for _, prog := range progs {
+		if !prog.Breached {
+			continue
+		}
+		key := prog.Goal.ID + "@" + prog.Date.Format("2006-01-02")
+		if _, ok := gs.breached[key]; ok {
+			continue
+		}
+		gs.breached[key] = zero.Empty()
+		breaches = append(breaches, prog)
+	}
+	gs.mux.Unlock()
+
+	for _, prog := range breaches {
+		gs.log.WithField("goal", prog.Goal.ID).Info("Goal breached.")
+		gs.hub.Publish(prog)
+	}
+}
@@ -2,7 +2,6 @@ package prodsvc
 
 import (
 	"context"
-	"math"
 	"sort"
 	"time"
 
@@ -14,27 +13,47 @@ import (
 
 	"go.stevenxie.me/api/v2/location"
 	"go.stevenxie.me/api/v2/pkg/basic"
+	"go.stevenxie.me/api/v2/pkg/timeutil"
 	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/productivity/prodscore"
 )
 
 // NewService creates a new Services from a RecordService.
+//
+// acts may be nil, in which case the service will not report any
+// productivity.Activities.
+//
+// scorer may be nil, in which case scores are computed using the RescueTime
+// productivity pulse.
 func NewService(
 	records productivity.RecordSource,
+	acts productivity.ActivitySource,
+	intervals productivity.IntervalSource,
 	zones location.TimeZoneService,
+	scorer productivity.Scorer,
 	opts ...basic.Option,
 ) productivity.Service {
+	if scorer == nil {
+		scorer = prodscore.Pulse()
+	}
 	cfg := basic.BuildOptions(opts...)
 	return service{
-		records: records,
-		zones:   zones,
-		log:     logutil.WithComponent(cfg.Logger, (*service)(nil)),
-		tracer:  cfg.Tracer,
+		records:   records,
+		acts:      acts,
+		intervals: intervals,
+		zones:     zones,
+		scorer:    scorer,
+		log:       logutil.WithComponent(cfg.Logger, (*service)(nil)),
+		tracer:    cfg.Tracer,
 	}
 }
 
 type service struct {
-	records productivity.RecordSource
-	zones   location.TimeZoneService
+	records   productivity.RecordSource
+	acts      productivity.ActivitySource
+	intervals productivity.IntervalSource
+	zones     location.TimeZoneService
+	scorer    productivity.Scorer
 
 	log    *logrus.Entry
 	tracer opentracing.Tracer
@@ -69,7 +88,9 @@ func (svc service) GetProductivity(
 	// Return early if no records.
 	if len(recs) == 0 {
 		return &productivity.Productivity{
-			Records: []productivity.Record{},
+			Date:       date,
+			Records:    []productivity.Record{},
+			ScoreModel: svc.scorer.Model(),
 		}, nil
 	}
 
@@ -81,27 +102,55 @@ func (svc service) GetProductivity(
 		WithField("records", recs).
 		Trace("Sorted records by weight.")
 
-	// Compute score.
-	var score uint
-	{
-		var totalSecs uint
-		for _, r := range recs {
-			secs := uint(math.Round(r.Duration.Seconds()))
-			score += r.Category.Weight() * secs
-			totalSecs += secs
+	// Compute score, using interval records if the scorer weights records by
+	// time.
+	var score *uint
+	if is, ok := svc.scorer.(productivity.IntervalScorer); ok {
+		var (
+			start = timeutil.DayStart(date)
+			end   = start.AddDate(0, 0, 1)
+		)
+		if now := time.Now(); now.Before(end) {
+			end = now
+		}
+		log.Trace("Getting interval records...")
+		irecs, err := svc.intervals.GetIntervalRecords(ctx, start, end)
+		if err != nil {
+			log.WithError(err).Error("Failed to get interval records.")
+			return nil, err
 		}
-		score = uint(math.Round(float64(score) / float64(totalSecs*4) * 100))
+		score = is.ScoreIntervals(irecs)
+	} else {
+		score = svc.scorer.Score(recs)
 	}
 	log.
-		WithField("score", score).
+		WithFields(logrus.Fields{
+			"score": score,
+			"model": svc.scorer.Model(),
+		}).
 		Trace("Computed productivity score.")
 
 	return &productivity.Productivity{
-		Records: recs,
-		Score:   &score,
+		Date:       date,
+		Records:    recs,
+		Score:      score,
+		ScoreModel: svc.scorer.Model(),
 	}, nil
 }
 
+// scoreRecords scores recs using scorer, or irecs if scorer can weight records
+// by time.
+func scoreRecords(
+	scorer productivity.Scorer,
+	recs []productivity.Record,
+	irecs []productivity.IntervalRecord,
+) *uint {
+	if is, ok := scorer.(productivity.IntervalScorer); ok {
+		return is.ScoreIntervals(irecs)
+	}
+	return scorer.Score(recs)
+}
+
 func (svc service) CurrentProductivity(ctx context.Context) (
 	*productivity.Productivity, error) {
 	span, ctx := opentracing.StartSpanFromContextWithTracer(
@@ -122,3 +171,35 @@ func (svc service) CurrentProductivity(ctx context.Context) (
 	}
 	return svc.GetProductivity(ctx, time.Now().In(zone))
 }
+
+func (svc service) GetActivities(
+	ctx context.Context,
+	date time.Time,
+) ([]productivity.Activity, error) {
+	if svc.acts == nil {
+		return []productivity.Activity{}, nil
+	}
+
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.GetActivities),
+	)
+	defer span.Finish()
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.GetActivities),
+		"date":            date,
+	}).WithContext(ctx)
+
+	log.Trace("Getting activities...")
+	acts, err := svc.acts.GetActivities(ctx, date)
+	if err != nil {
+		log.WithError(err).Error("Failed to get activities.")
+		return nil, err
+	}
+
+	sort.SliceStable(acts, func(i, j int) bool {
+		return acts[i].Duration > acts[j].Duration
+	})
+	return acts, nil
+}
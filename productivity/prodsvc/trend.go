@@ -0,0 +1,252 @@
+package prodsvc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+// Per-resolution settings for Service.ProductivityRange.
+var (
+	// _maxRanges are the maximum ranges that can be requested, including the
+	// intervals before the range that are needed to compute moving averages
+	// and week-over-week deltas.
+	_maxRanges = map[productivity.Resolution]time.Duration{
+		productivity.ResolutionHour: 31 * 24 * time.Hour,
+		productivity.ResolutionDay:  366 * 24 * time.Hour,
+		productivity.ResolutionWeek: 2 * 366 * 24 * time.Hour,
+	}
+
+	// _defaultWindows are the default moving average windows.
+	_defaultWindows = map[productivity.Resolution]int{
+		productivity.ResolutionHour: 24,
+		productivity.ResolutionDay:  7,
+		productivity.ResolutionWeek: 4,
+	}
+
+	// _maxWindow is the largest moving average window that can be requested.
+	_maxWindow = 52
+
+	// _weekIntervals are the number of intervals in a week.
+	_weekIntervals = map[productivity.Resolution]int{
+		productivity.ResolutionHour: 7 * 24,
+		productivity.ResolutionDay:  7,
+		productivity.ResolutionWeek: 1,
+	}
+)
+
+func (svc service) ProductivityRange(
+	ctx context.Context,
+	from, to time.Time,
+	opts ...productivity.RangeOption,
+) (*productivity.Trend, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.ProductivityRange),
+	)
+	defer span.Finish()
+
+	opt := productivity.RangeOptions{
+		Resolution: productivity.ResolutionDay,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	res := opt.Resolution
+	if err := res.Validate(); err != nil {
+		return nil, err
+	}
+	if opt.Window == 0 {
+		opt.Window = _defaultWindows[res]
+	}
+	if (opt.Window < 0) || (opt.Window > _maxWindow) {
+		return nil, errors.WithDetailf(
+			productivity.ErrInvalidWindow,
+			"The window must be between 1 and %d intervals.", _maxWindow,
+		)
+	}
+	if !from.Before(to) {
+		return nil, errors.WithDetail(
+			productivity.ErrInvalidRange,
+			"The start of the range must be before its end.",
+		)
+	}
+	to = to.In(from.Location())
+
+	// Include enough intervals before the range to compute moving averages and
+	// week-over-week deltas.
+	lookback := _weekIntervals[res]
+	if opt.Window-1 > lookback {
+		lookback = opt.Window - 1
+	}
+	start := intervalStart(from, res)
+	for i := 0; i < lookback; i++ {
+		start = prevInterval(start, res)
+	}
+	if max := _maxRanges[res]; to.Sub(start) > max {
+		return nil, errors.WithDetailf(
+			productivity.ErrInvalidRange,
+			"Ranges at resolution '%s' may not exceed %d days, including the "+
+				"%d intervals before the range used to compute trends.",
+			res, max/(24*time.Hour), lookback,
+		)
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.ProductivityRange),
+		"from":            from,
+		"to":              to,
+		"resolution":      res,
+	}).WithContext(ctx)
+
+	// Compute interval boundaries.
+	var bounds []time.Time
+	for t := start; t.Before(to); t = nextInterval(t, res) {
+		bounds = append(bounds, t)
+	}
+	bounds = append(bounds, nextInterval(bounds[len(bounds)-1], res))
+
+	// Daily records suffice for daily and weekly intervals, unless the scorer
+	// weights records by the hour at which they occurred.
+	fetchRes := productivity.ResolutionHour
+	if _, ok := svc.scorer.(productivity.IntervalScorer); !ok &&
+		(res != productivity.ResolutionHour) {
+		fetchRes = productivity.ResolutionDay
+	}
+
+	log.WithField("fetch_resolution", fetchRes).
+		Trace("Getting interval records...")
+	irecs, err := svc.intervals.GetIntervalRecords(
+		ctx,
+		start, to,
+		productivity.IntervalWithResolution(fetchRes),
+	)
+	if err != nil {
+		log.WithError(err).Error("Failed to get interval records.")
+		return nil, err
+	}
+
+	// Aggregate records by interval, and category.
+	n := len(bounds) - 1
+	var (
+		durations = make([]map[productivity.Category]time.Duration, n)
+		intervals = make([][]productivity.IntervalRecord, n)
+	)
+	for _, ir := range irecs {
+		i := sort.Search(len(bounds), func(i int) bool {
+			return bounds[i].After(ir.Start)
+		}) - 1
+		if (i < 0) || (i >= n) {
+			continue
+		}
+		if durations[i] == nil {
+			durations[i] = make(map[productivity.Category]time.Duration)
+		}
+		durations[i][ir.Category] += ir.Duration
+		intervals[i] = append(intervals[i], ir)
+	}
+
+	points := make([]productivity.TrendPoint, n)
+	for i := range points {
+		p := productivity.TrendPoint{
+			Start:   bounds[i],
+			End:     bounds[i+1],
+			Records: make([]productivity.Record, 0, len(durations[i])),
+		}
+		for cat, dur := range durations[i] {
+			p.Records = append(p.Records, productivity.Record{
+				Category: cat,
+				Duration: dur,
+			})
+		}
+		sort.Slice(p.Records, func(i, j int) bool {
+			return p.Records[i].Category.Weight() < p.Records[j].Category.Weight()
+		})
+		p.Score = scoreRecords(svc.scorer, p.Records, intervals[i])
+		points[i] = p
+	}
+
+	// Compute moving averages and week-over-week deltas.
+	for i := lookback; i < n; i++ {
+		p := &points[i]
+		var (
+			sum   uint
+			count int
+		)
+		for j := i - opt.Window + 1; j <= i; j++ {
+			if s := points[j].Score; s != nil {
+				sum += *s
+				count++
+			}
+		}
+		if count > 0 {
+			avg := float64(sum) / float64(count)
+			p.MovingAverage = &avg
+		}
+		prev := points[i-_weekIntervals[res]].Score
+		if (p.Score != nil) && (prev != nil) {
+			delta := int(*p.Score) - int(*prev)
+			p.WeekOverWeek = &delta
+		}
+	}
+
+	return &productivity.Trend{
+		From:       from,
+		To:         to,
+		Resolution: res,
+		Points:     points[lookback:],
+	}, nil
+}
+
+// intervalStart returns the start of the interval at resolution res that
+// contains t. Weeks start on Monday.
+func intervalStart(t time.Time, res productivity.Resolution) time.Time {
+	switch res {
+	case productivity.ResolutionHour:
+		return time.Date(
+			t.Year(), t.Month(), t.Day(),
+			t.Hour(), 0, 0, 0,
+			t.Location(),
+		)
+	case productivity.ResolutionWeek:
+		day := timeutil.DayStart(t)
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	default:
+		return timeutil.DayStart(t)
+	}
+}
+
+// nextInterval returns the start of the interval after the one that starts at
+// t.
+func nextInterval(t time.Time, res productivity.Resolution) time.Time {
+	switch res {
+	case productivity.ResolutionHour:
+		return t.Add(time.Hour)
+	case productivity.ResolutionWeek:
+		return t.AddDate(0, 0, 7)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// prevInterval returns the start of the interval before the one that starts at
+// t.
+func prevInterval(t time.Time, res productivity.Resolution) time.Time {
+	switch res {
+	case productivity.ResolutionHour:
+		return t.Add(-time.Hour)
+	case productivity.ResolutionWeek:
+		return t.AddDate(0, 0, -7)
+	default:
+		return t.AddDate(0, 0, -1)
+	}
+}
@@ -7,11 +7,14 @@ import (
 
 // Productivity is a measure of productivity for a given day.
 type Productivity struct {
-	Records []Record `json:"records"`
+	Date    time.Time `json:"date"`
+	Records []Record  `json:"records"`
 
-	// Score is a number between 0 and 100, computed as follows:
+	// Score is a number between 0 and 100, computed by the Scorer whose model
+	// is ScoreModel. By default, this is RescueTime's productivity pulse:
 	// https://help.rescuetime.com/article/73-how-is-my-productivity-pulse-calculated
-	Score *uint `json:"score,omitempty"`
+	Score      *uint  `json:"score,omitempty"`
+	ScoreModel string `json:"scoreModel"`
 }
 
 // Record is a record of the time spent doing activities of a particular
@@ -0,0 +1,67 @@
+package rescuetime
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+// NewActivitySource creates a new productivity.ActivitySource.
+func NewActivitySource(c Client) productivity.ActivitySource {
+	return activitySource{
+		client: c,
+	}
+}
+
+type activitySource struct {
+	client Client
+}
+
+var _ productivity.ActivitySource = (*activitySource)(nil)
+
+func (src activitySource) GetActivities(
+	ctx context.Context,
+	date time.Time,
+) ([]productivity.Activity, error) {
+	// Each row is of the form: [Rank, Time Spent (seconds), Number of People,
+	// Activity, Category, Productivity].
+	var rows [][]json.RawMessage
+	if err := getRows(
+		ctx, src.client,
+		rowParams(date, date, "activity"),
+		&rows,
+	); err != nil {
+		return nil, err
+	}
+
+	acts := make([]productivity.Activity, len(rows))
+	for i, row := range rows {
+		if len(row) < 6 {
+			return nil, errors.Newf("rescuetime: malformed activity row %d", i)
+		}
+		var (
+			secs, level int
+			act         productivity.Activity
+		)
+		for j, v := range map[int]interface{}{
+			1: &secs,
+			3: &act.Name,
+			4: &act.Group,
+			5: &level,
+		} {
+			if err := json.Unmarshal(row[j], v); err != nil {
+				return nil, errors.Wrapf(
+					err,
+					"rescuetime: decode column %d of activity row %d", j, i,
+				)
+			}
+		}
+		act.Category = productivity.Category(level + 3)
+		act.Duration = time.Duration(secs) * time.Second
+		acts[i] = act
+	}
+	return acts, nil
+}
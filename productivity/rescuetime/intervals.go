@@ -0,0 +1,103 @@
+package rescuetime
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/api/v2/productivity"
+)
+
+// NewIntervalSource creates a new productivity.IntervalSource.
+//
+// RescueTime reports times in the time zone configured for my account, so
+// the time zone of the range passed to GetIntervalRecords should match it.
+func NewIntervalSource(c Client) productivity.IntervalSource {
+	return intervalSource{
+		client: c,
+	}
+}
+
+type intervalSource struct {
+	client Client
+}
+
+var _ productivity.IntervalSource = (*intervalSource)(nil)
+
+func (src intervalSource) GetIntervalRecords(
+	ctx context.Context,
+	from, to time.Time,
+	opts ...productivity.IntervalOption,
+) ([]productivity.IntervalRecord, error) {
+	var (
+		opt      = productivity.BuildIntervalOptions(opts...)
+		interval time.Duration
+		params   = rowParams(from, to.Add(-time.Nanosecond), "productivity")
+	)
+	params.Set("perspective", "interval")
+	switch res := opt.Resolution; res {
+	case productivity.ResolutionHour:
+		params.Set("resolution_time", "hour")
+		interval = time.Hour
+	case productivity.ResolutionDay:
+		params.Set("resolution_time", "day")
+		interval = 24 * time.Hour
+	default:
+		return nil, errors.WithDetailf(
+			productivity.ErrInvalidResolution,
+			"Interval records are not available at resolution '%s'.", res,
+		)
+	}
+
+	// Each row is of the form: [Date, Time Spent (seconds), Number of People,
+	// Productivity].
+	var rows [][]json.RawMessage
+	if err := getRows(ctx, src.client, params, &rows); err != nil {
+		return nil, err
+	}
+
+	var (
+		loc  = from.Location()
+		recs = make([]productivity.IntervalRecord, 0, len(rows))
+	)
+	for i, row := range rows {
+		if len(row) < 4 {
+			return nil, errors.Newf("rescuetime: malformed interval row %d", i)
+		}
+		var (
+			date        string
+			secs, level int
+		)
+		for j, v := range map[int]interface{}{
+			0: &date,
+			1: &secs,
+			3: &level,
+		} {
+			if err := json.Unmarshal(row[j], v); err != nil {
+				return nil, errors.Wrapf(
+					err,
+					"rescuetime: decode column %d of interval row %d", j, i,
+				)
+			}
+		}
+		start, err := time.ParseInLocation("2006-01-02T15:04:05", date, loc)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"rescuetime: parse date of interval row %d", i,
+			)
+		}
+		if start.Before(from.Add(-interval)) || !start.Before(to) {
+			continue
+		}
+		recs = append(recs, productivity.IntervalRecord{
+			Start: start,
+			Record: productivity.Record{
+				Category: productivity.Category(level + 3),
+				Duration: time.Duration(secs) * time.Second,
+			},
+		})
+	}
+	return recs, nil
+}
@@ -36,16 +36,46 @@ func (svc recordSource) GetRecords(
 	ctx context.Context,
 	date time.Time,
 ) ([]productivity.Record, error) {
-	ds := date.Format(_iso8601)
+	var rows [][]int
+	if err := getRows(
+		ctx, svc.client,
+		rowParams(date, date, "productivity"),
+		&rows,
+	); err != nil {
+		return nil, err
+	}
+
+	// Parse productivity data.
+	records := make([]productivity.Record, len(rows))
+	for i, row := range rows {
+		records[i] = productivity.Record{
+			Category: productivity.Category(row[3] + 3),
+			Duration: time.Duration(row[1]) * time.Second,
+		}
+	}
+	return records, nil
+}
 
-	// Build query params.
+// rowParams builds query parameters for requesting rows of RescueTime data of
+// the specified kind, between two dates (inclusive).
+func rowParams(begin, end time.Time, kind string) url.Values {
 	params := make(url.Values)
 	params.Set("version", "0")
 	params.Set("format", "json")
-	params.Set("restrict_begin", ds)
-	params.Set("restrict_end", ds)
-	params.Set("restrict_kind", "productivity")
+	params.Set("restrict_begin", begin.Format(_iso8601))
+	params.Set("restrict_end", end.Format(_iso8601))
+	params.Set("restrict_kind", kind)
+	return params
+}
 
+// getRows gets the rows of RescueTime data selected by params, and decodes
+// them into v.
+func getRows(
+	ctx context.Context,
+	c Client,
+	params url.Values,
+	v interface{},
+) error {
 	// Send request.
 	u, err := url.Parse(_baseURL)
 	if err != nil {
@@ -56,32 +86,23 @@ func (svc recordSource) GetRecords(
 	// Perform request.
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
-		return nil, errors.Wrap(err, "rescuetime: create request")
+		return errors.Wrap(err, "rescuetime: create request")
 	}
-	res, err := svc.client.Do(req)
+	res, err := c.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer res.Body.Close()
 
 	// Decode response as JSON.
-	var data struct {
-		Rows [][]int `json:"rows"`
-	}
+	data := struct {
+		Rows interface{} `json:"rows"`
+	}{Rows: v}
 	if err = json.NewDecoder(res.Body).Decode(&data); err != nil {
-		return nil, errors.Wrap(err, "rescuetime: decode response as JSON")
+		return errors.Wrap(err, "rescuetime: decode response as JSON")
 	}
 	if err = res.Body.Close(); err != nil {
-		return nil, errors.Wrap(err, "rescuetime: close response body")
+		return errors.Wrap(err, "rescuetime: close response body")
 	}
-
-	// Parse productivity data.
-	records := make([]productivity.Record, len(data.Rows))
-	for i, row := range data.Rows {
-		records[i] = productivity.Record{
-			Category: productivity.Category(row[3] + 3),
-			Duration: time.Duration(row[1]) * time.Second,
-		}
-	}
-	return records, nil
+	return nil
 }
@@ -0,0 +1,22 @@
+package productivity
+
+type (
+	// A Scorer computes productivity scores from Records.
+	Scorer interface {
+		// Model names the scoring model used by the Scorer, like "pulse".
+		Model() string
+
+		// Score computes a score between 0 and 100 from recs, or returns nil if
+		// there is no recorded time to score.
+		Score(recs []Record) *uint
+	}
+
+	// An IntervalScorer is a Scorer that can also weight records by the time
+	// at which they occurred.
+	IntervalScorer interface {
+		Scorer
+
+		// ScoreIntervals is like Score, but for IntervalRecords.
+		ScoreIntervals(recs []IntervalRecord) *uint
+	}
+)
@@ -9,4 +9,16 @@ import (
 type Service interface {
 	GetProductivity(ctx context.Context, date time.Time) (*Productivity, error)
 	CurrentProductivity(ctx context.Context) (*Productivity, error)
+
+	// GetActivities gets the Activities for a given date, ordered from longest
+	// to shortest.
+	GetActivities(ctx context.Context, date time.Time) ([]Activity, error)
+
+	// ProductivityRange describes my productivity between from and to, as a
+	// Trend.
+	ProductivityRange(
+		ctx context.Context,
+		from, to time.Time,
+		opts ...RangeOption,
+	) (*Trend, error)
 }
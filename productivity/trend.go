@@ -0,0 +1,140 @@
+package productivity
+
+import (
+	"context"
+	stderrs "errors"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// An IntervalRecord is a Record of the time spent during a particular
+// interval (an hour, by default).
+type IntervalRecord struct {
+	// Start is the start of the interval.
+	Start time.Time `json:"start"`
+	Record
+}
+
+type (
+	// An IntervalSource can load IntervalRecords over a range of time.
+	IntervalSource interface {
+		// GetIntervalRecords loads the IntervalRecords for the intervals
+		// between from and to.
+		GetIntervalRecords(
+			ctx context.Context,
+			from, to time.Time,
+			opts ...IntervalOption,
+		) ([]IntervalRecord, error)
+	}
+
+	// IntervalOptions are option parameters for
+	// IntervalSource.GetIntervalRecords.
+	IntervalOptions struct {
+		// Resolution is the duration of each interval; it is either
+		// ResolutionHour (the default) or ResolutionDay.
+		Resolution Resolution
+	}
+
+	// An IntervalOption modifies an IntervalOptions.
+	IntervalOption func(*IntervalOptions)
+)
+
+// IntervalWithResolution configures IntervalSource.GetIntervalRecords to load
+// IntervalRecords at resolution res, which must be ResolutionHour or
+// ResolutionDay.
+func IntervalWithResolution(res Resolution) IntervalOption {
+	return func(opt *IntervalOptions) { opt.Resolution = res }
+}
+
+// BuildIntervalOptions builds an IntervalOptions from opts.
+func BuildIntervalOptions(opts ...IntervalOption) IntervalOptions {
+	opt := IntervalOptions{Resolution: ResolutionHour}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return opt
+}
+
+// A Resolution is the duration of each TrendPoint in a Trend.
+type Resolution string
+
+// The set of valid Resolutions.
+const (
+	ResolutionHour Resolution = "HOUR"
+	ResolutionDay  Resolution = "DAY"
+	ResolutionWeek Resolution = "WEEK"
+)
+
+// Resolutions are the set of valid Resolutions.
+var Resolutions = []Resolution{ResolutionHour, ResolutionDay, ResolutionWeek}
+
+// Validate returns an error if r is not a valid Resolution.
+func (r Resolution) Validate() error {
+	for _, valid := range Resolutions {
+		if r == valid {
+			return nil
+		}
+	}
+	return errors.WithDetailf(ErrInvalidResolution, "Unknown resolution '%s'.", r)
+}
+
+// A Trend describes my productivity over a range of time.
+type Trend struct {
+	From       time.Time    `json:"from"`
+	To         time.Time    `json:"to"`
+	Resolution Resolution   `json:"resolution"`
+	Points     []TrendPoint `json:"points"`
+}
+
+// A TrendPoint describes my productivity during a single interval within a
+// Trend.
+type TrendPoint struct {
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Records []Record  `json:"records"`
+
+	// Score is computed like Productivity.Score; it is nil if no time was
+	// recorded during the interval.
+	Score *uint `json:"score,omitempty"`
+
+	// MovingAverage is the average Score of the trailing intervals (including
+	// this one), ignoring intervals without a Score.
+	MovingAverage *float64 `json:"movingAverage,omitempty"`
+
+	// WeekOverWeek is the change in Score since the same interval a week
+	// earlier.
+	WeekOverWeek *int `json:"weekOverWeek,omitempty"`
+}
+
+type (
+	// RangeOptions are option parameters for Service.ProductivityRange.
+	RangeOptions struct {
+		Resolution Resolution
+
+		// Window is the number of intervals over which to compute each
+		// TrendPoint.MovingAverage.
+		Window int
+	}
+
+	// A RangeOption modifies a RangeOptions.
+	RangeOption func(*RangeOptions)
+)
+
+// Errors returned by Service.ProductivityRange.
+var (
+	ErrInvalidResolution = exthttp.WrapWithHTTPCode(
+		stderrs.New("productivity: invalid resolution"),
+		http.StatusBadRequest,
+	)
+	ErrInvalidRange = exthttp.WrapWithHTTPCode(
+		stderrs.New("productivity: invalid range"),
+		http.StatusBadRequest,
+	)
+	ErrInvalidWindow = exthttp.WrapWithHTTPCode(
+		stderrs.New("productivity: invalid moving average window"),
+		http.StatusBadRequest,
+	)
+)
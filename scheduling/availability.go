@@ -0,0 +1,140 @@
+package scheduling
+
+import (
+	stderrs "errors"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+)
+
+// WorkingHours are the hours of the day during which I can be scheduled.
+type WorkingHours struct {
+	// Start and End are offsets from the beginning of each day, like 9h and
+	// 17h.
+	Start time.Duration `json:"start"`
+	End   time.Duration `json:"end"`
+
+	// Weekdays are the days on which I work; if empty, I work every day.
+	Weekdays []time.Weekday `json:"weekdays"`
+}
+
+// DefaultWorkingHours are 9 AM to 5 PM, Monday through Friday.
+func DefaultWorkingHours() WorkingHours {
+	return WorkingHours{
+		Start: 9 * time.Hour,
+		End:   17 * time.Hour,
+		Weekdays: []time.Weekday{
+			time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+		},
+	}
+}
+
+// Validate returns an error if the WorkingHours are not valid.
+func (wh *WorkingHours) Validate() error {
+	if (wh.Start < 0) || (wh.End > 24*time.Hour) || (wh.Start >= wh.End) {
+		return errors.WithDetail(
+			ErrInvalidAvailability,
+			"Working hours must start before they end, within a single day.",
+		)
+	}
+	return nil
+}
+
+// ParseWorkingHours parses WorkingHours that start and end at times of day
+// like "09:30" (or "24:00", for the end of the day), on the named weekdays.
+func ParseWorkingHours(
+	start, end string,
+	weekdays []string,
+) (*WorkingHours, error) {
+	var (
+		wh  WorkingHours
+		err error
+	)
+	if wh.Start, err = parseClock(start); err != nil {
+		return nil, err
+	}
+	if wh.End, err = parseClock(end); err != nil {
+		return nil, err
+	}
+	for _, name := range weekdays {
+		wd, ok := timeutil.ParseWeekday(name)
+		if !ok {
+			return nil, errors.WithDetailf(
+				ErrInvalidAvailability,
+				"Unknown weekday '%s'.", name,
+			)
+		}
+		wh.Weekdays = append(wh.Weekdays, wd)
+	}
+	if err = wh.Validate(); err != nil {
+		return nil, err
+	}
+	return &wh, nil
+}
+
+func parseClock(s string) (time.Duration, error) {
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.WithDetailf(
+			ErrInvalidAvailability,
+			"Invalid time of day '%s'; expected a time like '09:30'.", s,
+		)
+	}
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute, nil
+}
+
+// WorksOn reports whether the WorkingHours include the day of t.
+func (wh *WorkingHours) WorksOn(t time.Time) bool {
+	if len(wh.Weekdays) == 0 {
+		return true
+	}
+	wd := t.Weekday()
+	for _, d := range wh.Weekdays {
+		if d == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// A Slot is a span of free time, in which a meeting could be scheduled.
+type Slot struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Score ranks the Slot against others, between 0 and 1; higher scores
+	// indicate better slots.
+	Score float64 `json:"score"`
+}
+
+type (
+	// AvailabilityOptions are option parameters for Service.Availability.
+	AvailabilityOptions struct {
+		WorkingHours WorkingHours
+
+		// Buffer is the time to keep free before and after each busy span, i.e.
+		// for travel.
+		Buffer time.Duration
+
+		// Limit is the maximum number of Slots to return.
+		Limit int
+	}
+
+	// An AvailabilityOption modifies an AvailabilityOptions.
+	AvailabilityOption func(*AvailabilityOptions)
+)
+
+// ErrInvalidAvailability is returned by Service.Availability when it is
+// called with invalid parameters.
+var ErrInvalidAvailability = exthttp.WrapWithHTTPCode(
+	stderrs.New("scheduling: invalid availability query"),
+	http.StatusBadRequest,
+)
@@ -0,0 +1,140 @@
+package scheduling
+
+import (
+	"context"
+	stderrs "errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/api/v2/pkg/ical"
+)
+
+// A Booking is a tentative hold on my calendar, placed by a visitor.
+type Booking struct {
+	ID    string    `json:"id"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Title string    `json:"title"`
+
+	// Name, Email, and Notes describe the visitor who placed the Booking.
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+	Notes *string `json:"notes,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Span returns the TimeSpan of the Booking.
+func (b *Booking) Span() TimeSpan {
+	return TimeSpan{Start: b.Start, End: b.End}
+}
+
+// Description describes the Booking and the visitor who placed it, for use
+// in calendar events.
+func (b *Booking) Description() string {
+	var desc strings.Builder
+	fmt.Fprintf(&desc, "Booked by %s", b.Name)
+	if b.Email != nil {
+		fmt.Fprintf(&desc, " <%s>", *b.Email)
+	}
+	desc.WriteString(".")
+	if b.Notes != nil {
+		fmt.Fprintf(&desc, "\n\n%s", *b.Notes)
+	}
+	return desc.String()
+}
+
+// ICS encodes the Booking as an iCalendar object, for use as an attachment.
+func (b *Booking) ICS() ([]byte, error) {
+	return ical.Marshal(&ical.Calendar{
+		ProdID: ProdID,
+		Method: "PUBLISH",
+		Events: []ical.Event{{
+			UID:         b.ID + "@" + UIDDomain,
+			Start:       b.Start,
+			End:         b.End,
+			Summary:     b.Title,
+			Description: b.Description(),
+			Status:      ical.StatusTentative,
+			Stamp:       b.CreatedAt,
+		}},
+	})
+}
+
+// Identifiers used in the iCalendar objects created by this package.
+const (
+	ProdID    = "-//stevenxie.me//api//EN"
+	UIDDomain = "api.stevenxie.me"
+)
+
+// A BookingRequest requests a Booking for a particular slot.
+type BookingRequest struct {
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+
+	// Title is the title of the Booking; if nil, a title is derived from
+	// Name.
+	Title *string `json:"title,omitempty"`
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+	Notes *string `json:"notes,omitempty"`
+
+	// IdempotencyKey identifies the request, so that it can be safely retried:
+	// repeated requests with the same key return the original Booking.
+	IdempotencyKey string `json:"idempotencyKey"`
+}
+
+type (
+	// A BookingService allows visitors to place Bookings on my calendar.
+	BookingService interface {
+		// Book places a Booking on behalf of the holder of code, after checking
+		// that the requested slot is still free.
+		Book(ctx context.Context, code string, req BookingRequest) (*Booking, error)
+	}
+
+	// A Booker can place tentative holds on my calendar.
+	Booker interface {
+		// Hold inserts a tentative event for b into my calendar.
+		//
+		// It returns ErrBookingExists if a hold was already placed for a
+		// Booking with the same ID.
+		Hold(ctx context.Context, b *Booking) error
+	}
+)
+
+// Errors returned by a BookingService.
+var (
+	ErrInvalidBooking = exthttp.WrapWithHTTPCode(
+		stderrs.New("scheduling: invalid booking request"),
+		http.StatusBadRequest,
+	)
+	ErrSlotUnavailable = exthttp.WrapWithHTTPCode(
+		errors.WithHint(
+			stderrs.New("scheduling: slot is no longer available"),
+			"Query my availability for free slots.",
+		),
+		http.StatusConflict,
+	)
+	ErrIdempotencyKeyReused = exthttp.WrapWithHTTPCode(
+		stderrs.New(
+			"scheduling: idempotency key was used for a different booking",
+		),
+		http.StatusUnprocessableEntity,
+	)
+	ErrBookingExists = exthttp.WrapWithHTTPCode(
+		errors.WithHint(
+			stderrs.New("scheduling: booking already exists"),
+			"A booking was already placed with this idempotency key.",
+		),
+		http.StatusConflict,
+	)
+	ErrBookingUnavailable = exthttp.WrapWithHTTPCode(
+		stderrs.New("scheduling: bookings are not enabled"),
+		http.StatusServiceUnavailable,
+	)
+)
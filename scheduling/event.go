@@ -0,0 +1,95 @@
+package scheduling
+
+import (
+	"context"
+	"time"
+)
+
+// An Event is an event on my calendar.
+type Event struct {
+	ID    string    `json:"id"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// AllDay is true if the Event spans entire days, rather than specific
+	// times.
+	AllDay bool `json:"allDay"`
+
+	Title    *string `json:"title,omitempty"`
+	Location *string `json:"location,omitempty"`
+
+	// Attendees is the number of people attending the Event, including me.
+	Attendees *int `json:"attendees,omitempty"`
+
+	// ConferenceURL is a link for joining the Event remotely, like a Google
+	// Meet or Zoom URL.
+	ConferenceURL *string `json:"conferenceURL,omitempty"`
+
+	Transparency Transparency `json:"transparency"`
+}
+
+// Span returns the TimeSpan of the Event.
+func (e *Event) Span() TimeSpan {
+	return TimeSpan{Start: e.Start, End: e.End}
+}
+
+// Busy reports whether the Event blocks time on my calendar.
+func (e *Event) Busy() bool { return e.Transparency != TransparencyTransparent }
+
+// Redacted returns a copy of the Event, with the fields that should not be
+// visible at Visibility v removed.
+func (e *Event) Redacted(v Visibility) Event {
+	redacted := *e
+	if v < VisibilityFull {
+		redacted.Location = nil
+		redacted.Attendees = nil
+		redacted.ConferenceURL = nil
+	}
+	if v < VisibilityTitles {
+		redacted.ID = ""
+		redacted.Title = nil
+	}
+	return redacted
+}
+
+// Transparency determines whether an Event blocks time on my calendar.
+type Transparency string
+
+// The set of valid Transparency values.
+const (
+	TransparencyOpaque      Transparency = "OPAQUE"
+	TransparencyTransparent Transparency = "TRANSPARENT"
+)
+
+// Visibility determines how much of an Event is visible to a viewer.
+type Visibility int
+
+// The set of valid Visibility levels, from least to most visible.
+//
+// At VisibilityBusy, only the times of busy Events are visible. At
+// VisibilityTitles, all Events are visible, along with their titles. At
+// VisibilityFull, all fields are visible.
+const (
+	VisibilityBusy Visibility = iota
+	VisibilityTitles
+	VisibilityFull
+)
+
+// RedactEvents filters and redacts events for viewing at Visibility v.
+func RedactEvents(events []Event, v Visibility) []Event {
+	redacted := make([]Event, 0, len(events))
+	for i := range events {
+		e := &events[i]
+		if (v == VisibilityBusy) && !e.Busy() {
+			continue
+		}
+		redacted = append(redacted, e.Redacted(v))
+	}
+	return redacted
+}
+
+// An EventSource can list my calendar Events.
+type EventSource interface {
+	// Events lists the Events that overlap with the given date.
+	Events(ctx context.Context, date time.Time) ([]Event, error)
+}
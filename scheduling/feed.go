@@ -0,0 +1,82 @@
+package scheduling
+
+import (
+	"fmt"
+	"time"
+
+	"go.stevenxie.me/api/v2/pkg/ical"
+)
+
+// A Feed is an export of my schedule over a range of time, for overlaying
+// onto other calendars.
+//
+// At VisibilityBusy, a Feed contains anonymous busy blocks; otherwise, it
+// contains Events that have been redacted to its Visibility.
+type Feed struct {
+	From       time.Time  `json:"from"`
+	To         time.Time  `json:"to"`
+	Visibility Visibility `json:"-"`
+
+	Busy   []TimeSpan `json:"busy,omitempty"`
+	Events []Event    `json:"events,omitempty"`
+}
+
+// ICS encodes the Feed as an iCalendar object, which can be subscribed to by
+// calendar apps.
+func (f *Feed) ICS() ([]byte, error) {
+	cal := ical.Calendar{
+		ProdID: ProdID,
+		Method: "PUBLISH",
+		Name:   "Busy",
+	}
+	if f.Visibility > VisibilityBusy {
+		cal.Name = "Schedule"
+	}
+
+	for _, b := range f.Busy {
+		cal.Events = append(cal.Events, ical.Event{
+			UID:     busyUID(&b),
+			Start:   b.Start,
+			End:     b.End,
+			Summary: "Busy",
+			Status:  ical.StatusConfirmed,
+		})
+	}
+	for i := range f.Events {
+		var (
+			e    = &f.Events[i]
+			span = e.Span()
+		)
+		ie := ical.Event{
+			UID:         busyUID(&span),
+			Start:       e.Start,
+			End:         e.End,
+			AllDay:      e.AllDay,
+			Summary:     "Busy",
+			Status:      ical.StatusConfirmed,
+			Transparent: !e.Busy(),
+		}
+		if e.ID != "" {
+			ie.UID = e.ID + "@" + UIDDomain
+		}
+		if e.Title != nil {
+			ie.Summary = *e.Title
+		}
+		if e.Location != nil {
+			ie.Location = *e.Location
+		}
+		if e.ConferenceURL != nil {
+			ie.URL = *e.ConferenceURL
+		}
+		cal.Events = append(cal.Events, ie)
+	}
+	return ical.Marshal(&cal)
+}
+
+// busyUID derives an iCalendar UID for an anonymous span of busy time.
+func busyUID(span *TimeSpan) string {
+	return fmt.Sprintf(
+		"busy-%d-%d@%s",
+		span.Start.Unix(), span.End.Unix(), UIDDomain,
+	)
+}
@@ -0,0 +1,57 @@
+package gcal
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	gcal "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// NewBooker creates a new scheduling.Booker that inserts tentative events into
+// the Google calendar specified by id.
+//
+// Events are inserted with IDs derived from their Bookings, so that Google
+// Calendar rejects duplicate holds.
+func NewBooker(svc *gcal.Service, id CalendarID) scheduling.Booker {
+	return booker{
+		svc: svc,
+		id:  id,
+	}
+}
+
+type booker struct {
+	svc *gcal.Service
+	id  CalendarID
+}
+
+var _ scheduling.Booker = (*booker)(nil)
+
+func (bk booker) Hold(ctx context.Context, b *scheduling.Booking) error {
+	event := gcal.Event{
+		Id:          b.ID,
+		ICalUID:     b.ID + "@" + scheduling.UIDDomain,
+		Summary:     b.Title,
+		Description: b.Description(),
+		Status:      "tentative",
+		Start:       &gcal.EventDateTime{DateTime: b.Start.Format(_timeLayout)},
+		End:         &gcal.EventDateTime{DateTime: b.End.Format(_timeLayout)},
+		ExtendedProperties: &gcal.EventExtendedProperties{
+			Private: map[string]string{"bookingID": b.ID},
+		},
+	}
+	if _, err := bk.svc.Events.
+		Insert(bk.id, &event).
+		Context(ctx).
+		Do(); err != nil {
+		var gerr *googleapi.Error
+		if errors.As(err, &gerr) && (gerr.Code == http.StatusConflict) {
+			return scheduling.ErrBookingExists
+		}
+		return errors.Wrap(err, "gcal: insert event")
+	}
+	return nil
+}
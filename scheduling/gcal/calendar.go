@@ -34,14 +34,23 @@ type calendar struct {
 	ids []CalendarID
 }
 
-var _ scheduling.Calendar = (*calendar)(nil)
+var _ scheduling.RangeCalendar = (*calendar)(nil)
 
 func (cal calendar) BusyTimes(
 	ctx context.Context,
 	date time.Time,
+) ([]scheduling.TimeSpan, error) {
+	// Days are not always 24 hours long (i.e. on DST transition days).
+	start := timeutil.DayStart(date)
+	return cal.BusyTimesBetween(ctx, start, start.AddDate(0, 0, 1))
+}
+
+func (cal calendar) BusyTimesBetween(
+	ctx context.Context,
+	start, end time.Time,
 ) ([]scheduling.TimeSpan, error) {
 	// Determine request time zone.
-	tz := date.Location()
+	tz := start.Location()
 
 	// Build Busy request.
 	var req gcal.FreeBusyRequest
@@ -50,13 +59,8 @@ func (cal calendar) BusyTimes(
 		for i, id := range cal.ids {
 			req.Items[i] = &gcal.FreeBusyRequestItem{Id: string(id)}
 		}
-
-		var (
-			min = timeutil.DayStart(date)
-			max = min.Add(time.Hour * 24)
-		)
-		req.TimeMin = min.Format(_timeLayout)
-		req.TimeMax = max.Format(_timeLayout)
+		req.TimeMin = start.Format(_timeLayout)
+		req.TimeMax = end.Format(_timeLayout)
 		req.TimeZone = tz.String()
 	}
 
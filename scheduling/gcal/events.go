@@ -0,0 +1,139 @@
+package gcal
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	gcal "google.golang.org/api/calendar/v3"
+
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// NewEventSource creates a new scheduling.EventSource that lists events from
+// the Google calendars specified by ids.
+func NewEventSource(
+	svc *gcal.Service,
+	ids []CalendarID,
+) scheduling.EventSource {
+	return eventSource{
+		svc: svc,
+		ids: ids,
+	}
+}
+
+type eventSource struct {
+	svc *gcal.Service
+	ids []CalendarID
+}
+
+var _ scheduling.EventSource = (*eventSource)(nil)
+
+func (src eventSource) Events(
+	ctx context.Context,
+	date time.Time,
+) ([]scheduling.Event, error) {
+	var (
+		tz  = date.Location()
+		min = timeutil.DayStart(date)
+		max = min.AddDate(0, 0, 1)
+	)
+
+	var events []scheduling.Event
+	for _, id := range src.ids {
+		if err := src.svc.Events.List(id).
+			SingleEvents(true).
+			OrderBy("startTime").
+			TimeMin(min.Format(_timeLayout)).
+			TimeMax(max.Format(_timeLayout)).
+			TimeZone(tz.String()).
+			Pages(ctx, func(page *gcal.Events) error {
+				for _, item := range page.Items {
+					if (item.Status == "cancelled") || declined(item) {
+						continue
+					}
+					e, err := eventFrom(item, tz)
+					if err != nil {
+						return err
+					}
+					events = append(events, *e)
+				}
+				return nil
+			}); err != nil {
+			return nil, errors.Wrapf(err, "gcal: list events in '%s'", id)
+		}
+	}
+	return events, nil
+}
+
+// declined reports whether I've declined the invitation to item.
+func declined(item *gcal.Event) bool {
+	for _, a := range item.Attendees {
+		if a.Self {
+			return a.ResponseStatus == "declined"
+		}
+	}
+	return false
+}
+
+func eventFrom(item *gcal.Event, tz *time.Location) (*scheduling.Event, error) {
+	e := scheduling.Event{
+		ID:           item.Id,
+		Transparency: scheduling.TransparencyOpaque,
+	}
+	if item.Transparency == "transparent" {
+		e.Transparency = scheduling.TransparencyTransparent
+	}
+
+	var err error
+	if e.Start, e.AllDay, err = parseEventTime(item.Start, tz); err != nil {
+		return nil, errors.Wrap(err, "gcal: parsing start time")
+	}
+	if e.End, _, err = parseEventTime(item.End, tz); err != nil {
+		return nil, errors.Wrap(err, "gcal: parsing end time")
+	}
+
+	if item.Summary != "" {
+		e.Title = &item.Summary
+	}
+	if item.Location != "" {
+		e.Location = &item.Location
+	}
+	if n := len(item.Attendees); n > 0 {
+		e.Attendees = &n
+	}
+	if link := conferenceURL(item); link != "" {
+		e.ConferenceURL = &link
+	}
+	return &e, nil
+}
+
+// parseEventTime parses an EventDateTime, which is either a date (for all-day
+// events) or a time.
+func parseEventTime(
+	dt *gcal.EventDateTime,
+	tz *time.Location,
+) (t time.Time, allDay bool, err error) {
+	if dt == nil {
+		return time.Time{}, false, errors.New("missing time")
+	}
+	if dt.Date != "" {
+		t, err = time.ParseInLocation("2006-01-02", dt.Date, tz)
+		return t, true, err
+	}
+	t, err = time.ParseInLocation(_timeLayout, dt.DateTime, tz)
+	return t, false, err
+}
+
+// conferenceURL finds a link for joining item by video.
+func conferenceURL(item *gcal.Event) string {
+	if data := item.ConferenceData; data != nil {
+		for _, ep := range data.EntryPoints {
+			if ep.EntryPointType == "video" {
+				return ep.Uri
+			}
+		}
+	}
+	return item.HangoutLink
+}
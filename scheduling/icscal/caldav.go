@@ -0,0 +1,89 @@
+package icscal
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/pkg/ical"
+)
+
+// _calendarQuery is the body of a CalDAV calendar-query REPORT request, which
+// selects the events overlapping a UTC time range (RFC 4791, Section 7.8.1).
+const _calendarQuery = `<?xml version="1.0" encoding="utf-8"?>
+<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop><C:calendar-data/></D:prop>
+  <C:filter>
+    <C:comp-filter name="VCALENDAR">
+      <C:comp-filter name="VEVENT">
+        <C:time-range start="%s" end="%s"/>
+      </C:comp-filter>
+    </C:comp-filter>
+  </C:filter>
+</C:calendar-query>`
+
+// multistatus is a WebDAV multistatus response (RFC 4918, Section 14.16),
+// containing calendar data.
+type multistatus struct {
+	Responses []struct {
+		Propstats []struct {
+			Status string `xml:"DAV: status"`
+			Prop   struct {
+				CalendarData string `xml:"urn:ietf:params:xml:ns:caldav calendar-data"`
+			} `xml:"DAV: prop"`
+		} `xml:"DAV: propstat"`
+	} `xml:"DAV: response"`
+}
+
+// queryCalDAV fetches the iCalendar objects in a CalDAV collection that have
+// events which overlap the range [from, to).
+//
+// Recurring events are returned unexpanded.
+func queryCalDAV(
+	ctx context.Context,
+	httpc *http.Client,
+	src *Source,
+	from, to time.Time,
+) ([][]byte, error) {
+	body := fmt.Sprintf(
+		_calendarQuery,
+		from.UTC().Format(ical.DateTimeLayout),
+		to.UTC().Format(ical.DateTimeLayout),
+	)
+	req, err := http.NewRequest("REPORT", src.URL, strings.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "icscal: create request")
+	}
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+	req.Header.Set("Depth", "1")
+
+	data, err := doRequest(
+		httpc, req.WithContext(ctx), src,
+		http.StatusMultiStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms multistatus
+	if err = xml.Unmarshal(data, &ms); err != nil {
+		return nil, errors.Wrap(err, "icscal: decode multistatus response")
+	}
+	var objects [][]byte
+	for _, res := range ms.Responses {
+		for _, ps := range res.Propstats {
+			if !strings.Contains(ps.Status, " 200 ") {
+				continue
+			}
+			if data := ps.Prop.CalendarData; data != "" {
+				objects = append(objects, []byte(data))
+			}
+		}
+	}
+	return objects, nil
+}
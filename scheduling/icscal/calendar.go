@@ -0,0 +1,348 @@
+package icscal
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+
+	"go.stevenxie.me/api/v2/pkg/ical"
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// DefaultCacheTTL is the default duration for which fetched calendar data is
+// cached.
+const DefaultCacheTTL = 5 * time.Minute
+
+// NewCalendar creates a new Calendar that reads events from sources.
+func NewCalendar(sources []Source, opts ...CalendarOption) (*Calendar, error) {
+	opt := CalendarOptions{
+		HTTPClient: new(http.Client),
+		CacheTTL:   DefaultCacheTTL,
+		Logger:     logutil.NoopEntry(),
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	for i := range sources {
+		if err := sources[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &Calendar{
+		sources: sources,
+		httpc:   opt.HTTPClient,
+		ttl:     opt.CacheTTL,
+		log:     logutil.WithComponent(opt.Logger, (*Calendar)(nil)),
+		cache:   make(map[string]cacheEntry),
+	}, nil
+}
+
+type (
+	// CalendarOptions configures a Calendar.
+	CalendarOptions struct {
+		HTTPClient *http.Client
+
+		// CacheTTL is the duration for which fetched calendar data is cached.
+		// If zero, data is fetched on every request.
+		CacheTTL time.Duration
+
+		Logger *logrus.Entry
+	}
+
+	// A CalendarOption modifies a CalendarOptions.
+	CalendarOption func(*CalendarOptions)
+)
+
+// CalendarWithHTTPClient configures a Calendar to make requests with c.
+func CalendarWithHTTPClient(c *http.Client) CalendarOption {
+	return func(opt *CalendarOptions) { opt.HTTPClient = c }
+}
+
+// CalendarWithLogger configures a Calendar to write logs with log.
+func CalendarWithLogger(log *logrus.Entry) CalendarOption {
+	return func(opt *CalendarOptions) { opt.Logger = log }
+}
+
+// CalendarWithCacheTTL configures the duration for which a Calendar caches
+// fetched calendar data.
+func CalendarWithCacheTTL(ttl time.Duration) CalendarOption {
+	return func(opt *CalendarOptions) { opt.CacheTTL = ttl }
+}
+
+// A Calendar reads events from iCalendar feeds, local .ics files, and CalDAV
+// collections. It implements a scheduling.Calendar and a
+// scheduling.EventSource.
+//
+// Recurring events are expanded using their RRULE and EXDATE properties, and
+// occurrences that were modified or cancelled individually are respected.
+// Events with recurrence rules that aren't supported are treated as if they
+// do not recur.
+type Calendar struct {
+	sources []Source
+	httpc   *http.Client
+	ttl     time.Duration
+	log     *logrus.Entry
+
+	mux   sync.Mutex
+	cache map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	objects [][]byte
+	expires time.Time
+}
+
+var (
+	_ scheduling.Calendar    = (*Calendar)(nil)
+	_ scheduling.EventSource = (*Calendar)(nil)
+)
+
+// BusyTimes implements scheduling.Calendar.
+//
+// Events that are transparent are not reported as busy times.
+func (cal *Calendar) BusyTimes(
+	ctx context.Context,
+	date time.Time,
+) ([]scheduling.TimeSpan, error) {
+	events, err := cal.Events(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	var spans []scheduling.TimeSpan
+	for i := range events {
+		if e := &events[i]; e.Busy() {
+			spans = append(spans, e.Span())
+		}
+	}
+	return spans, nil
+}
+
+// Events implements scheduling.EventSource.
+//
+// Cancelled events are omitted.
+func (cal *Calendar) Events(
+	ctx context.Context,
+	date time.Time,
+) ([]scheduling.Event, error) {
+	var (
+		min = timeutil.DayStart(date)
+		max = min.AddDate(0, 0, 1)
+	)
+
+	var events []scheduling.Event
+	for i := range cal.sources {
+		objects, err := cal.fetch(ctx, i, min, max)
+		if err != nil {
+			return nil, errors.WithDetailf(err, "Source: %s", cal.sources[i].URL)
+		}
+
+		var ievents []ical.Event
+		for _, obj := range objects {
+			c, err := ical.Unmarshal(obj, date.Location())
+			if err != nil {
+				return nil, errors.WithDetailf(
+					errors.Wrap(err, "icscal: decode calendar"),
+					"Source: %s", cal.sources[i].URL,
+				)
+			}
+			for j := range c.Events {
+				if rule := c.Events[j].UnsupportedRRule; rule != "" {
+					cal.log.
+						WithFields(logrus.Fields{
+							"source": cal.sources[i].URL,
+							"uid":    c.Events[j].UID,
+							"rrule":  rule,
+						}).
+						Warn("Unsupported recurrence rule; using first occurrence only.")
+				}
+			}
+			ievents = append(ievents, c.Events...)
+		}
+		events = append(events, expand(ievents, min, max)...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+	return events, nil
+}
+
+// fetch returns the iCalendar objects of the i-th source, which contain the
+// events that overlap [min, max).
+func (cal *Calendar) fetch(
+	ctx context.Context,
+	i int,
+	min, max time.Time,
+) ([][]byte, error) {
+	src := &cal.sources[i]
+	key := fmt.Sprintf("%d", i)
+	if src.Type == SourceTypeCalDAV {
+		key = fmt.Sprintf("%d:%d:%d", i, min.Unix(), max.Unix())
+	}
+
+	now := time.Now()
+	cal.mux.Lock()
+	entry, ok := cal.cache[key]
+	cal.mux.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.objects, nil
+	}
+
+	var objects [][]byte
+	switch src.Type {
+	case SourceTypeCalDAV:
+		var err error
+		if objects, err = queryCalDAV(ctx, cal.httpc, src, min, max); err != nil {
+			return nil, err
+		}
+	default:
+		data, err := fetchICS(ctx, cal.httpc, src)
+		if err != nil {
+			return nil, err
+		}
+		objects = [][]byte{data}
+	}
+
+	if cal.ttl > 0 {
+		cal.mux.Lock()
+		defer cal.mux.Unlock()
+		for k, e := range cal.cache {
+			if !now.Before(e.expires) {
+				delete(cal.cache, k)
+			}
+		}
+		cal.cache[key] = cacheEntry{
+			objects: objects,
+			expires: now.Add(cal.ttl),
+		}
+	}
+	return objects, nil
+}
+
+// expand converts ievents into scheduling.Events, expanding recurring events
+// into the occurrences that overlap [min, max).
+func expand(ievents []ical.Event, min, max time.Time) []scheduling.Event {
+	// Index the occurrences that were overridden, by UID and original start.
+	overrides := make(map[string]map[int64]bool)
+	for i := range ievents {
+		e := &ievents[i]
+		if e.RecurrenceID == nil {
+			continue
+		}
+		if overrides[e.UID] == nil {
+			overrides[e.UID] = make(map[int64]bool)
+		}
+		overrides[e.UID][e.RecurrenceID.Unix()] = true
+	}
+
+	var (
+		loc    = min.Location()
+		events []scheduling.Event
+	)
+	for i := range ievents {
+		e := &ievents[i]
+		if (e.RRule == nil) || (e.RecurrenceID != nil) {
+			if (e.Status == ical.StatusCancelled) ||
+				!overlaps(e.Start, e.End, min, max) {
+				continue
+			}
+			id := e.UID
+			if e.RecurrenceID != nil {
+				id = occurrenceID(e, *e.RecurrenceID)
+			}
+			events = append(events, eventFrom(e, id, e.Start, e.End, loc))
+			continue
+		}
+		if e.Status == ical.StatusCancelled {
+			continue
+		}
+
+		// Look back far enough to include occurrences that start before min,
+		// but are still ongoing (allowing for DST transitions).
+		from := min.Add(-e.Duration() - time.Hour)
+		for _, start := range e.RRule.Between(e.Start, from, max) {
+			if overrides[e.UID][start.Unix()] || excluded(e, start) {
+				continue
+			}
+			end := occurrenceEnd(e, start)
+			if !overlaps(start, end, min, max) {
+				continue
+			}
+			id := occurrenceID(e, start)
+			events = append(events, eventFrom(e, id, start, end, loc))
+		}
+	}
+	return events
+}
+
+// occurrenceID returns the ID of the occurrence of e that originally started
+// at start.
+func occurrenceID(e *ical.Event, start time.Time) string {
+	return e.UID + "_" + start.UTC().Format(ical.DateTimeLayout)
+}
+
+// occurrenceEnd returns the end of the occurrence of e that starts at start.
+//
+// All-day events keep their length in days, rather than in hours.
+func occurrenceEnd(e *ical.Event, start time.Time) time.Time {
+	if e.AllDay {
+		days := int(math.Round(e.Duration().Hours() / 24))
+		return start.AddDate(0, 0, days)
+	}
+	return start.Add(e.Duration())
+}
+
+// excluded reports whether the occurrence of e that starts at start was
+// excluded by an EXDATE.
+func excluded(e *ical.Event, start time.Time) bool {
+	for _, t := range e.ExDates {
+		if t.Equal(start) {
+			return true
+		}
+	}
+	return false
+}
+
+func overlaps(start, end, min, max time.Time) bool {
+	if !end.After(start) {
+		// Zero-duration events overlap the range if they start within it.
+		return !start.Before(min) && start.Before(max)
+	}
+	return start.Before(max) && end.After(min)
+}
+
+// eventFrom converts an occurrence of e into a scheduling.Event, with times
+// in loc.
+func eventFrom(
+	e *ical.Event,
+	id string,
+	start, end time.Time,
+	loc *time.Location,
+) scheduling.Event {
+	event := scheduling.Event{
+		ID:           id,
+		Start:        start.In(loc),
+		End:          end.In(loc),
+		AllDay:       e.AllDay,
+		Transparency: scheduling.TransparencyOpaque,
+	}
+	if e.Transparent {
+		event.Transparency = scheduling.TransparencyTransparent
+	}
+	if title := e.Summary; title != "" {
+		event.Title = &title
+	}
+	if location := e.Location; location != "" {
+		event.Location = &location
+	}
+	return event
+}
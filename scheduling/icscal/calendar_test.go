@@ -0,0 +1,264 @@
+package icscal
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// _standupICS is a calendar in America/Toronto with a recurring standup that
+// crosses the end of DST on November 3, 2019. One occurrence is excluded, and
+// another is moved.
+const _standupICS = `BEGIN:VCALENDAR
+PRODID:-//Test//Test//EN
+BEGIN:VEVENT
+UID:standup
+DTSTART;TZID=America/Toronto:20191028T093000
+DURATION:PT15M
+RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=5
+EXDATE;TZID=America/Toronto:20191030T093000
+SUMMARY:Standup
+END:VEVENT
+BEGIN:VEVENT
+UID:standup
+RECURRENCE-ID;TZID=America/Toronto:20191101T093000
+DTSTART;TZID=America/Toronto:20191101T110000
+DTEND;TZID=America/Toronto:20191101T113000
+SUMMARY:Standup (moved)
+END:VEVENT
+BEGIN:VEVENT
+UID:lunch
+DTSTART;TZID=America/Toronto:20191101T120000
+DTEND;TZID=America/Toronto:20191101T130000
+SUMMARY:Lunch
+TRANSP:TRANSPARENT
+END:VEVENT
+BEGIN:VEVENT
+UID:cancelled
+DTSTART;TZID=America/Toronto:20191104T150000
+DTEND;TZID=America/Toronto:20191104T160000
+STATUS:CANCELLED
+END:VEVENT
+END:VCALENDAR
+`
+
+func loadToronto(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("America/Toronto")
+	if err != nil {
+		t.Skipf("load time zone: %v", err)
+	}
+	return loc
+}
+
+func TestCalendarEvents(t *testing.T) {
+	loc := loadToronto(t)
+	at := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2019, month, day, hour, min, 0, 0, loc)
+	}
+
+	f, err := ioutil.TempFile("", "icscal-*.ics")
+	if err != nil {
+		t.Fatalf("create temp file: %v", err)
+	}
+	defer f.Close()
+	if _, err = f.WriteString(_standupICS); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	cal, err := NewCalendar(
+		[]Source{{URL: f.Name(), Type: SourceTypeICS}},
+		CalendarWithCacheTTL(0),
+	)
+	if err != nil {
+		t.Fatalf("create calendar: %v", err)
+	}
+
+	type occurrence struct {
+		id         string
+		title      string
+		start, end time.Time
+	}
+	tests := []struct {
+		name string
+		date time.Time
+		want []occurrence
+	}{
+		{
+			name: "first occurrence",
+			date: at(time.October, 28, 12, 0),
+			want: []occurrence{{
+				id:    "standup_20191028T133000Z",
+				title: "Standup",
+				start: at(time.October, 28, 9, 30),
+				end:   at(time.October, 28, 9, 45),
+			}},
+		},
+		{
+			name: "excluded",
+			date: at(time.October, 30, 12, 0),
+		},
+		{
+			name: "overridden",
+			date: at(time.November, 1, 0, 0),
+			want: []occurrence{
+				{
+					id:    "standup_20191101T133000Z",
+					title: "Standup (moved)",
+					start: at(time.November, 1, 11, 0),
+					end:   at(time.November, 1, 11, 30),
+				},
+				{
+					id:    "lunch",
+					title: "Lunch",
+					start: at(time.November, 1, 12, 0),
+					end:   at(time.November, 1, 13, 0),
+				},
+			},
+		},
+		{
+			// After DST ends, the occurrence keeps its wall clock time, and so
+			// starts an hour later in UTC.
+			name: "after DST",
+			date: at(time.November, 4, 12, 0),
+			want: []occurrence{{
+				id:    "standup_20191104T143000Z",
+				title: "Standup",
+				start: at(time.November, 4, 9, 30),
+				end:   at(time.November, 4, 9, 45),
+			}},
+		},
+		{
+			name: "after count",
+			date: at(time.November, 8, 12, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := cal.Events(context.Background(), tt.date)
+			if err != nil {
+				t.Fatalf("get events: %v", err)
+			}
+			if len(events) != len(tt.want) {
+				t.Fatalf("got %d events %+v, want %d", len(events), events, len(tt.want))
+			}
+			for i, want := range tt.want {
+				e := &events[i]
+				if e.ID != want.id {
+					t.Errorf("event %d: ID = %q, want %q", i, e.ID, want.id)
+				}
+				if (e.Title == nil) || (*e.Title != want.title) {
+					t.Errorf("event %d: Title = %v, want %q", i, e.Title, want.title)
+				}
+				if !e.Start.Equal(want.start) || !e.End.Equal(want.end) {
+					t.Errorf(
+						"event %d: span = [%s, %s), want [%s, %s)",
+						i, e.Start, e.End, want.start, want.end,
+					)
+				}
+			}
+		})
+	}
+
+	// Transparent events aren't busy.
+	busy, err := cal.BusyTimes(context.Background(), at(time.November, 1, 0, 0))
+	if err != nil {
+		t.Fatalf("get busy times: %v", err)
+	}
+	want := []scheduling.TimeSpan{{
+		Start: at(time.November, 1, 11, 0),
+		End:   at(time.November, 1, 11, 30),
+	}}
+	if (len(busy) != 1) || !busy[0].Start.Equal(want[0].Start) ||
+		!busy[0].End.Equal(want[0].End) {
+		t.Errorf("busy times = %+v, want %+v", busy, want)
+	}
+}
+
+func TestCalendarCalDAV(t *testing.T) {
+	loc := loadToronto(t)
+	const multistatus = `<?xml version="1.0" encoding="utf-8"?>
+<d:multistatus xmlns:d="DAV:" xmlns:cal="urn:ietf:params:xml:ns:caldav">
+  <d:response>
+    <d:href>/cal/standup.ics</d:href>
+    <d:propstat>
+      <d:prop><cal:calendar-data>` + _standupICS + `</cal:calendar-data></d:prop>
+      <d:status>HTTP/1.1 200 OK</d:status>
+    </d:propstat>
+  </d:response>
+  <d:response>
+    <d:href>/cal/missing.ics</d:href>
+    <d:propstat>
+      <d:prop><cal:calendar-data>BEGIN:VEVENT</cal:calendar-data></d:prop>
+      <d:status>HTTP/1.1 404 Not Found</d:status>
+    </d:propstat>
+  </d:response>
+</d:multistatus>`
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			if r.Method != "REPORT" {
+				t.Errorf("method = %s, want REPORT", r.Method)
+			}
+			if depth := r.Header.Get("Depth"); depth != "1" {
+				t.Errorf("Depth = %q, want \"1\"", depth)
+			}
+			if user, pass, ok := r.BasicAuth(); !ok ||
+				(user != "me") || (pass != "secret") {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			body, _ := ioutil.ReadAll(r.Body)
+			const timeRange = `start="20191104T050000Z" end="20191105T050000Z"`
+			if !strings.Contains(string(body), timeRange) {
+				t.Errorf("query is missing time range %s:\n%s", timeRange, body)
+			}
+			w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+			w.WriteHeader(http.StatusMultiStatus)
+			_, _ = w.Write([]byte(multistatus))
+		},
+	))
+	defer srv.Close()
+
+	src := Source{
+		URL:      srv.URL + "/cal/",
+		Type:     SourceTypeCalDAV,
+		Username: "me",
+		Password: "secret",
+	}
+	cal, err := NewCalendar([]Source{src})
+	if err != nil {
+		t.Fatalf("create calendar: %v", err)
+	}
+
+	date := time.Date(2019, time.November, 4, 12, 0, 0, 0, loc)
+	for i := 0; i < 2; i++ {
+		events, err := cal.Events(context.Background(), date)
+		if err != nil {
+			t.Fatalf("get events: %v", err)
+		}
+		if (len(events) != 1) || (events[0].ID != "standup_20191104T143000Z") {
+			t.Fatalf("events = %+v, want the standup on November 4", events)
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("got %d requests, want 1 (cached)", n)
+	}
+
+	// Requests with the wrong credentials fail.
+	src.Password = "wrong"
+	if cal, err = NewCalendar([]Source{src}); err != nil {
+		t.Fatalf("create calendar: %v", err)
+	}
+	if _, err = cal.Events(context.Background(), date); err == nil {
+		t.Error("expected an error for bad credentials")
+	}
+}
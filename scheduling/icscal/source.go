@@ -0,0 +1,115 @@
+package icscal
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// A Source is an iCalendar feed, local .ics file, or CalDAV collection.
+type Source struct {
+	// URL is the URL of the feed or collection. URLs with the "webcal" scheme
+	// are fetched over HTTPS, and URLs with the "file" scheme (or paths without
+	// a scheme) are read from the local filesystem.
+	URL  string
+	Type SourceType
+
+	// Username and Password are used for HTTP basic authentication, if
+	// Username is non-empty.
+	Username string
+	Password string
+}
+
+// SourceType is the type of a Source.
+type SourceType string
+
+// The set of valid SourceTypes.
+const (
+	SourceTypeICS    SourceType = "ics"
+	SourceTypeCalDAV SourceType = "caldav"
+)
+
+// Validate returns an error if the Source is not valid.
+func (src *Source) Validate() error {
+	if src.URL == "" {
+		return errors.New("icscal: source is missing a URL")
+	}
+	switch src.Type {
+	case SourceTypeICS:
+	case SourceTypeCalDAV:
+		if u, err := url.Parse(src.URL); (err != nil) ||
+			((u.Scheme != "http") && (u.Scheme != "https")) {
+			return errors.Newf(
+				"icscal: CalDAV source '%s' must be an HTTP(S) URL",
+				src.URL,
+			)
+		}
+	default:
+		return errors.Newf("icscal: unknown source type '%s'", src.Type)
+	}
+	return nil
+}
+
+// fetchICS reads the iCalendar data of an ICS source.
+func fetchICS(
+	ctx context.Context,
+	httpc *http.Client,
+	src *Source,
+) ([]byte, error) {
+	u, err := url.Parse(src.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "icscal: parse source URL")
+	}
+	switch u.Scheme {
+	case "", "file":
+		path := u.Path
+		if u.Scheme == "" {
+			path = src.URL
+		}
+		data, err := ioutil.ReadFile(path)
+		return data, errors.Wrap(err, "icscal: read file")
+	case "webcal":
+		u.Scheme = "https"
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "icscal: create request")
+	}
+	req.Header.Set("Accept", "text/calendar")
+	return doRequest(httpc, req.WithContext(ctx), src, http.StatusOK)
+}
+
+// doRequest performs req on behalf of src, and reads the response body.
+func doRequest(
+	httpc *http.Client,
+	req *http.Request,
+	src *Source,
+	code int,
+) ([]byte, error) {
+	if src.Username != "" {
+		req.SetBasicAuth(src.Username, src.Password)
+	}
+	res, err := httpc.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "icscal: perform request")
+	}
+	defer res.Body.Close()
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "icscal: read response body")
+	}
+	if res.StatusCode != code {
+		err = errors.Newf("icscal: bad response status '%s'", res.Status)
+		if len(data) > 0 {
+			err = errors.WithDetail(err, strings.TrimSpace(string(data)))
+		}
+		return nil, err
+	}
+	return data, nil
+}
@@ -1,8 +1,41 @@
 package scheduling
 
-import "go.stevenxie.me/api/v2/auth"
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/auth"
+)
 
 // Valid permissions corresponding to this package.
 const (
-	PermBusyAll auth.Permission = "scheduling.busy-all"
+	PermBusyAll      auth.Permission = "scheduling.busy-all"
+	PermEventDetails auth.Permission = "scheduling.event-details"
+	PermBook         auth.Permission = "scheduling.book"
 )
+
+// VisibilityOf determines the Visibility of Events to the holder of code,
+// based on their permissions.
+func VisibilityOf(
+	ctx context.Context,
+	svc auth.Service,
+	code string,
+) (Visibility, error) {
+	for _, level := range []struct {
+		perm auth.Permission
+		vis  Visibility
+	}{
+		{perm: PermEventDetails, vis: VisibilityFull},
+		{perm: PermBusyAll, vis: VisibilityTitles},
+	} {
+		ok, err := svc.HasPermission(ctx, code, level.perm)
+		if err != nil {
+			return 0, errors.Wrap(err, "scheduling: checking permissions")
+		}
+		if ok {
+			return level.vis, nil
+		}
+	}
+	return VisibilityBusy, nil
+}
@@ -0,0 +1,57 @@
+package schedgql
+
+import (
+	"context"
+	"time"
+
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// Availability finds free slots in my schedule.
+func (q Query) Availability(
+	ctx context.Context,
+	from, to time.Time,
+	duration int,
+	workingHours *WorkingHoursInput,
+	buffer *int,
+	limit *int,
+) ([]scheduling.Slot, error) {
+	opts := make([]scheduling.AvailabilityOption, 0, 3)
+	if workingHours != nil {
+		wh, err := workingHours.workingHours()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, func(opt *scheduling.AvailabilityOptions) {
+			opt.WorkingHours = *wh
+		})
+	}
+	if buffer != nil {
+		opts = append(opts, func(opt *scheduling.AvailabilityOptions) {
+			opt.Buffer = time.Duration(*buffer) * time.Second
+		})
+	}
+	if limit != nil {
+		opts = append(opts, func(opt *scheduling.AvailabilityOptions) {
+			opt.Limit = *limit
+		})
+	}
+	return q.svc.Availability(
+		ctx,
+		from, to,
+		time.Duration(duration)*time.Second,
+		opts...,
+	)
+}
+
+// A WorkingHoursInput is a GraphQL representation of a
+// scheduling.WorkingHours.
+type WorkingHoursInput struct {
+	Start    string   `json:"start"`
+	End      string   `json:"end"`
+	Weekdays []string `json:"weekdays"`
+}
+
+func (in *WorkingHoursInput) workingHours() (*scheduling.WorkingHours, error) {
+	return scheduling.ParseWorkingHours(in.Start, in.End, in.Weekdays)
+}
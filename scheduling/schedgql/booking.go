@@ -0,0 +1,49 @@
+package schedgql
+
+import (
+	"context"
+	"time"
+
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// A BookingInput is a GraphQL representation of a scheduling.BookingRequest.
+type BookingInput struct {
+	Start          time.Time `json:"start"`
+	Duration       int       `json:"duration"` // in seconds
+	Title          *string   `json:"title"`
+	Name           string    `json:"name"`
+	Email          *string   `json:"email"`
+	Notes          *string   `json:"notes"`
+	IdempotencyKey string    `json:"idempotencyKey"`
+}
+
+// Request converts the BookingInput into a scheduling.BookingRequest.
+func (in *BookingInput) Request() scheduling.BookingRequest {
+	return scheduling.BookingRequest{
+		Start:          in.Start,
+		Duration:       time.Duration(in.Duration) * time.Second,
+		Title:          in.Title,
+		Name:           in.Name,
+		Email:          in.Email,
+		Notes:          in.Notes,
+		IdempotencyKey: in.IdempotencyKey,
+	}
+}
+
+// A BookingResolver resolves fields for a scheduling.Booking.
+type BookingResolver zero.Struct
+
+// Ics resolves the iCalendar encoding of a scheduling.Booking.
+func (BookingResolver) Ics(
+	_ context.Context,
+	b *scheduling.Booking,
+) (string, error) {
+	data, err := b.ICS()
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -5,56 +5,230 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"go.stevenxie.me/gopkg/zero"
 
 	"go.stevenxie.me/api/v2/auth"
 	"go.stevenxie.me/api/v2/auth/authutil"
+	"go.stevenxie.me/api/v2/location"
 	"go.stevenxie.me/api/v2/pkg/timeutil"
 	"go.stevenxie.me/api/v2/scheduling"
 )
 
 // NewQuery creates a new Query.
-func NewQuery(svc scheduling.Service, auth auth.Service) Query {
+func NewQuery(
+	svc scheduling.Service,
+	travel scheduling.TravelService,
+	auth auth.Service,
+) Query {
 	return Query{
-		svc:  svc,
-		auth: auth,
+		svc:    svc,
+		travel: travel,
+		auth:   auth,
 	}
 }
 
 // A Query resolves queries for my scheduling-related data.
 type Query struct {
-	svc  scheduling.Service
-	auth auth.Service
+	svc    scheduling.Service
+	travel scheduling.TravelService
+	auth   auth.Service
 }
 
-// BusyTimes looks up the times when I'm busy.
+// BusyTimes looks up the times when I'm busy, on a particular date or
+// between from and to.
 func (q Query) BusyTimes(
 	ctx context.Context,
 	code *string,
 	date *time.Time,
+	from *time.Time,
+	to *time.Time,
 ) ([]scheduling.TimeSpan, error) {
+	// Only allow access to busy times beyond ~today to users with
+	// scheduling.PermBusyAll.
+	var (
+		start = timeutil.DayStart(time.Now()).AddDate(0, 0, -1)
+		end   = start.AddDate(0, 0, 2)
+	)
+
+	if (from != nil) || (to != nil) {
+		if (from == nil) || (to == nil) || (date != nil) {
+			return nil, errors.WithDetail(
+				scheduling.ErrInvalidRange,
+				"Both 'from' and 'to' must be provided, without 'date'.",
+			)
+		}
+		if from.Before(start) || to.After(end.AddDate(0, 0, 1)) {
+			if err := q.requireBusyAll(ctx, code); err != nil {
+				return nil, err
+			}
+		}
+		return q.svc.BusyTimesBetween(ctx, *from, *to)
+	}
+
 	if date != nil {
-		// Only allow access to busy times beyond ~today to users with
-		// scheduling.PermBusyAll.
-		var (
-			start = timeutil.DayStart(time.Now()).AddDate(0, 0, -1)
-			end   = start.AddDate(0, 0, 2)
-		)
 		if date.Before(start) || date.After(end) {
-			if code == nil {
-				return nil, errors.WithDetail(
-					authutil.ErrAccessDenied,
-					"No code was provided.",
-				)
-			}
-			ok, err := q.auth.HasPermission(ctx, *code, scheduling.PermBusyAll)
-			if err != nil {
-				return nil, errors.Wrap(err, "checking permissions")
-			}
-			if !ok {
-				return nil, authutil.ErrAccessDenied
+			if err := q.requireBusyAll(ctx, code); err != nil {
+				return nil, err
 			}
 		}
 		return q.svc.BusyTimes(ctx, *date)
 	}
 	return q.svc.BusyTimesToday(ctx)
 }
+
+// requireBusyAll returns an error unless code has the
+// scheduling.PermBusyAll permission.
+func (q Query) requireBusyAll(ctx context.Context, code *string) error {
+	if code == nil {
+		return errors.WithDetail(
+			authutil.ErrAccessDenied,
+			"No code was provided.",
+		)
+	}
+	ok, err := q.auth.HasPermission(ctx, *code, scheduling.PermBusyAll)
+	if err != nil {
+		return errors.Wrap(err, "checking permissions")
+	}
+	if !ok {
+		return authutil.ErrAccessDenied
+	}
+	return nil
+}
+
+// Events looks up my calendar events, as visible to the holder of code.
+func (q Query) Events(
+	ctx context.Context,
+	code *string,
+	date *time.Time,
+) ([]scheduling.Event, error) {
+	vis, err := q.visibility(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []scheduling.Event
+	if date != nil {
+		if err = checkDateAccess(*date, code, vis); err != nil {
+			return nil, err
+		}
+		events, err = q.svc.Events(ctx, *date)
+	} else {
+		events, err = q.svc.EventsToday(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return scheduling.RedactEvents(events, vis), nil
+}
+
+// TravelBlocks looks up the time that I need to spend travelling between my
+// calendar events, as visible to the holder of code.
+func (q Query) TravelBlocks(
+	ctx context.Context,
+	code *string,
+	date *time.Time,
+) ([]scheduling.TravelBlock, error) {
+	vis, err := q.visibility(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only plan trips from my current position for users with
+	// location.PermHistory, since they reveal where I am.
+	var opts []scheduling.TravelOption
+	if code != nil {
+		ok, err := q.auth.HasPermission(ctx, *code, location.PermHistory)
+		if err != nil {
+			return nil, errors.Wrap(err, "checking permissions")
+		}
+		if ok {
+			opts = append(opts, scheduling.TravelWithCurrentPosition())
+		}
+	}
+
+	var blocks []scheduling.TravelBlock
+	if date != nil {
+		if err = checkDateAccess(*date, code, vis); err != nil {
+			return nil, err
+		}
+		blocks, err = q.travel.TravelBlocks(ctx, *date, opts...)
+	} else {
+		blocks, err = q.travel.TravelBlocksToday(ctx, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range blocks {
+		blocks[i] = blocks[i].Redacted(vis)
+	}
+	return blocks, nil
+}
+
+// checkDateAccess returns an error if the holder of code (with access to
+// events at Visibility vis) may not view my schedule on date.
+//
+// Only users with scheduling.PermBusyAll or scheduling.PermEventDetails may
+// view my schedule beyond ~today.
+func checkDateAccess(
+	date time.Time,
+	code *string,
+	vis scheduling.Visibility,
+) error {
+	var (
+		start = timeutil.DayStart(time.Now()).AddDate(0, 0, -1)
+		end   = start.AddDate(0, 0, 2)
+	)
+	if (date.Before(start) || date.After(end)) &&
+		(vis < scheduling.VisibilityTitles) {
+		if code == nil {
+			return errors.WithDetail(
+				authutil.ErrAccessDenied,
+				"No code was provided.",
+			)
+		}
+		return authutil.ErrAccessDenied
+	}
+	return nil
+}
+
+// visibility determines the scheduling.Visibility of events to the holder of
+// code.
+func (q Query) visibility(
+	ctx context.Context,
+	code *string,
+) (scheduling.Visibility, error) {
+	if code == nil {
+		return scheduling.VisibilityBusy, nil
+	}
+	return scheduling.VisibilityOf(ctx, q.auth, *code)
+}
+
+// An EventResolver resolves fields for a scheduling.Event.
+type EventResolver zero.Struct
+
+//revive:disable-line:exported
+func (EventResolver) Transparency(
+	_ context.Context,
+	e *scheduling.Event,
+) (string, error) {
+	return string(e.Transparency), nil
+}
+
+// A TravelBlockResolver resolves fields for a scheduling.TravelBlock.
+type TravelBlockResolver zero.Struct
+
+//revive:disable-line:exported
+func (TravelBlockResolver) Duration(
+	_ context.Context,
+	tb *scheduling.TravelBlock,
+) (int, error) {
+	return int(tb.Duration / time.Second), nil
+}
+
+//revive:disable-line:exported
+func (TravelBlockResolver) Slack(
+	_ context.Context,
+	tb *scheduling.TravelBlock,
+) (int, error) {
+	return int(tb.Slack() / time.Second), nil
+}
@@ -0,0 +1,87 @@
+package schedmem
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// NewCalendar creates a new Calendar.
+func NewCalendar() *Calendar { return new(Calendar) }
+
+// A Calendar is an in-memory calendar, which implements a scheduling.Calendar
+// and a scheduling.Booker.
+//
+// It is useful for developing and testing booking flows without a real
+// calendar.
+type Calendar struct {
+	mux   sync.Mutex
+	busy  []scheduling.TimeSpan
+	holds []scheduling.Booking
+}
+
+var (
+	_ scheduling.Calendar = (*Calendar)(nil)
+	_ scheduling.Booker   = (*Calendar)(nil)
+)
+
+// AddBusy marks a span of time as busy.
+func (cal *Calendar) AddBusy(span scheduling.TimeSpan) {
+	cal.mux.Lock()
+	defer cal.mux.Unlock()
+	cal.busy = append(cal.busy, span)
+}
+
+// Holds returns the Bookings held on the Calendar.
+func (cal *Calendar) Holds() []scheduling.Booking {
+	cal.mux.Lock()
+	defer cal.mux.Unlock()
+	holds := make([]scheduling.Booking, len(cal.holds))
+	copy(holds, cal.holds)
+	return holds
+}
+
+// BusyTimes implements scheduling.Calendar.
+//
+// Held Bookings are reported as busy times.
+func (cal *Calendar) BusyTimes(
+	_ context.Context,
+	date time.Time,
+) ([]scheduling.TimeSpan, error) {
+	var (
+		start = timeutil.DayStart(date)
+		end   = start.AddDate(0, 0, 1)
+	)
+	cal.mux.Lock()
+	defer cal.mux.Unlock()
+
+	var periods []scheduling.TimeSpan
+	add := func(span scheduling.TimeSpan) {
+		if span.Start.Before(end) && span.End.After(start) {
+			periods = append(periods, span)
+		}
+	}
+	for _, span := range cal.busy {
+		add(span)
+	}
+	for i := range cal.holds {
+		add(cal.holds[i].Span())
+	}
+	return periods, nil
+}
+
+// Hold implements scheduling.Booker.
+func (cal *Calendar) Hold(_ context.Context, b *scheduling.Booking) error {
+	cal.mux.Lock()
+	defer cal.mux.Unlock()
+	for i := range cal.holds {
+		if cal.holds[i].ID == b.ID {
+			return scheduling.ErrBookingExists
+		}
+	}
+	cal.holds = append(cal.holds, *b)
+	return nil
+}
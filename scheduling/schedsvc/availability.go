@@ -0,0 +1,221 @@
+package schedsvc
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// _maxAvailabilityRange is the longest range over which availability can be
+// queried.
+const _maxAvailabilityRange = 14 * 24 * time.Hour
+
+func (svc service) Availability(
+	ctx context.Context,
+	from, to time.Time,
+	duration time.Duration,
+	opts ...scheduling.AvailabilityOption,
+) ([]scheduling.Slot, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.Availability),
+	)
+	defer span.Finish()
+
+	opt := scheduling.AvailabilityOptions{
+		WorkingHours: scheduling.DefaultWorkingHours(),
+		Limit:        10,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	if err := validateAvailability(from, to, duration, &opt); err != nil {
+		return nil, err
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.Availability),
+		"from":            from,
+		"to":              to,
+		"duration":        duration,
+	}).WithContext(ctx)
+
+	// Interpret the range in my current time zone, and skip times that have
+	// already passed.
+	log.Trace("Getting current time zone...")
+	tz, err := svc.zones.CurrentTimeZone(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to get current time zone.")
+		return nil, errors.Wrap(err, "schedsvc: get current time zone")
+	}
+	from, to = from.In(tz), to.In(tz)
+	if now := time.Now().In(tz); from.Before(now) {
+		from = now
+	}
+	if !from.Before(to) {
+		return []scheduling.Slot{}, nil
+	}
+
+	// Collect busy spans, padded by the buffer. Busy times just outside the
+	// range are included, since their buffers may extend into it.
+	periods, err := svc.BusyTimesBetween(
+		ctx,
+		from.Add(-opt.Buffer), to.Add(opt.Buffer),
+	)
+	if err != nil {
+		return nil, err
+	}
+	busy := make([]scheduling.TimeSpan, len(periods))
+	for i, p := range periods {
+		busy[i] = scheduling.TimeSpan{
+			Start: p.Start.Add(-opt.Buffer),
+			End:   p.End.Add(opt.Buffer),
+		}
+	}
+	busy = scheduling.ClipSpans(
+		scheduling.MergeSpans(busy),
+		scheduling.TimeSpan{Start: from, End: to},
+	)
+	log.
+		WithField("busy", busy).
+		Trace("Collected busy spans.")
+
+	// Find free spans during working hours.
+	var (
+		wh    = &opt.WorkingHours
+		slots []scheduling.Slot
+	)
+	for day := timeutil.DayStart(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !wh.WorksOn(day) {
+			continue
+		}
+		window := scheduling.TimeSpan{
+			Start: clockTime(day, wh.Start),
+			End:   clockTime(day, wh.End),
+		}
+		if window.Start.Before(from) {
+			window.Start = from
+		}
+		if window.End.After(to) {
+			window.End = to
+		}
+		for _, free := range subtractSpans(window, busy) {
+			if free.End.Sub(free.Start) < duration {
+				continue
+			}
+			slots = append(slots, scheduling.Slot{
+				Start: free.Start,
+				End:   free.End,
+				Score: scoreSlot(&free, from, to, duration),
+			})
+		}
+	}
+
+	// Rank slots.
+	sort.SliceStable(slots, func(i, j int) bool {
+		return slots[i].Score > slots[j].Score
+	})
+	if (opt.Limit > 0) && (len(slots) > opt.Limit) {
+		slots = slots[:opt.Limit]
+	}
+	if slots == nil {
+		slots = []scheduling.Slot{}
+	}
+	return slots, nil
+}
+
+func validateAvailability(
+	from, to time.Time,
+	duration time.Duration,
+	opt *scheduling.AvailabilityOptions,
+) error {
+	if !from.Before(to) {
+		return errors.WithDetail(
+			scheduling.ErrInvalidAvailability,
+			"The start of the range must be before its end.",
+		)
+	}
+	if to.Sub(from) > _maxAvailabilityRange {
+		return errors.WithDetailf(
+			scheduling.ErrInvalidAvailability,
+			"Ranges may not exceed %d days.",
+			_maxAvailabilityRange/(24*time.Hour),
+		)
+	}
+	if duration <= 0 {
+		return errors.WithDetail(
+			scheduling.ErrInvalidAvailability,
+			"Duration must be positive.",
+		)
+	}
+	if opt.Buffer < 0 {
+		return errors.WithDetail(
+			scheduling.ErrInvalidAvailability,
+			"Buffer must not be negative.",
+		)
+	}
+	return opt.WorkingHours.Validate()
+}
+
+// clockTime returns the time that is offset into the day, according to the
+// wall clock (so that, i.e., 9h is always 9 AM, even across DST transitions).
+func clockTime(day time.Time, offset time.Duration) time.Time {
+	return time.Date(
+		day.Year(), day.Month(), day.Day(),
+		0, 0, 0, int(offset),
+		day.Location(),
+	)
+}
+
+// subtractSpans returns the parts of window that are not covered by busy,
+// which must be sorted and merged.
+func subtractSpans(
+	window scheduling.TimeSpan,
+	busy []scheduling.TimeSpan,
+) []scheduling.TimeSpan {
+	var (
+		free   []scheduling.TimeSpan
+		cursor = window.Start
+	)
+	for _, b := range busy {
+		if !b.End.After(cursor) {
+			continue
+		}
+		if !b.Start.Before(window.End) {
+			break
+		}
+		if b.Start.After(cursor) {
+			free = append(free, scheduling.TimeSpan{Start: cursor, End: b.Start})
+		}
+		cursor = b.End
+	}
+	if cursor.Before(window.End) {
+		free = append(free, scheduling.TimeSpan{Start: cursor, End: window.End})
+	}
+	return free
+}
+
+// scoreSlot ranks a free span between from and to, preferring spans that
+// begin sooner, and then spans with more room to spare.
+func scoreSlot(
+	free *scheduling.TimeSpan,
+	from, to time.Time,
+	duration time.Duration,
+) float64 {
+	var (
+		earliness = 1 - float64(free.Start.Sub(from))/float64(to.Sub(from))
+		slack     = float64(free.End.Sub(free.Start)-duration) / float64(duration)
+		roominess = math.Min(slack/2, 1)
+	)
+	return math.Round((0.7*earliness+0.3*roominess)*1000) / 1000
+}
@@ -0,0 +1,307 @@
+package schedsvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// NewBookingService creates a new BookingService, which checks my availability
+// using svc and places holds on my calendar using booker.
+//
+// booker may be nil, in which case all bookings fail with
+// scheduling.ErrBookingUnavailable.
+func NewBookingService(
+	svc scheduling.Service,
+	booker scheduling.Booker,
+	opts ...BookingServiceOption,
+) *BookingService {
+	opt := BookingServiceOptions{
+		Logger:         logutil.NoopEntry(),
+		Tracer:         new(opentracing.NoopTracer),
+		WorkingHours:   scheduling.DefaultWorkingHours(),
+		MaxDuration:    time.Hour,
+		IdempotencyTTL: 24 * time.Hour,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return &BookingService{
+		svc:    svc,
+		booker: booker,
+		log:    logutil.WithComponent(opt.Logger, (*BookingService)(nil)),
+		tracer: opt.Tracer,
+		opt:    opt,
+		keys:   make(map[string]idempotentBooking),
+	}
+}
+
+// BookingServiceWithLogger configures a BookingService to write logs with
+// log.
+func BookingServiceWithLogger(log *logrus.Entry) BookingServiceOption {
+	return func(opt *BookingServiceOptions) { opt.Logger = log }
+}
+
+// BookingServiceWithTracer configures a BookingService to trace calls with t.
+func BookingServiceWithTracer(t opentracing.Tracer) BookingServiceOption {
+	return func(opt *BookingServiceOptions) { opt.Tracer = t }
+}
+
+// BookingServiceWithWorkingHours configures a BookingService to only place
+// Bookings during wh.
+func BookingServiceWithWorkingHours(
+	wh scheduling.WorkingHours,
+) BookingServiceOption {
+	return func(opt *BookingServiceOptions) { opt.WorkingHours = wh }
+}
+
+// BookingServiceWithBuffer configures a BookingService to keep buf free
+// before and after each of my busy times.
+func BookingServiceWithBuffer(buf time.Duration) BookingServiceOption {
+	return func(opt *BookingServiceOptions) { opt.Buffer = buf }
+}
+
+// BookingServiceWithMaxDuration configures the longest Booking that a
+// BookingService will place.
+func BookingServiceWithMaxDuration(max time.Duration) BookingServiceOption {
+	return func(opt *BookingServiceOptions) { opt.MaxDuration = max }
+}
+
+type (
+	// A BookingService implements a scheduling.BookingService.
+	BookingService struct {
+		svc    scheduling.Service
+		booker scheduling.Booker
+		log    *logrus.Entry
+		tracer opentracing.Tracer
+		opt    BookingServiceOptions
+
+		// mux is held for the duration of each booking, so that concurrent
+		// bookings cannot claim the same slot.
+		mux      sync.Mutex
+		keys     map[string]idempotentBooking // by code and idempotency key
+		bookings []scheduling.Booking         // recent bookings
+	}
+
+	// BookingServiceOptions configures a BookingService.
+	BookingServiceOptions struct {
+		Logger *logrus.Entry
+		Tracer opentracing.Tracer
+
+		WorkingHours scheduling.WorkingHours
+		Buffer       time.Duration
+		MaxDuration  time.Duration
+
+		// IdempotencyTTL is how long idempotency keys are remembered.
+		IdempotencyTTL time.Duration
+	}
+
+	// A BookingServiceOption modifies a BookingServiceOptions.
+	BookingServiceOption func(*BookingServiceOptions)
+
+	idempotentBooking struct {
+		req     scheduling.BookingRequest
+		booking scheduling.Booking
+	}
+)
+
+var _ scheduling.BookingService = (*BookingService)(nil)
+
+// Book implements scheduling.BookingService.
+func (svc *BookingService) Book(
+	ctx context.Context,
+	code string,
+	req scheduling.BookingRequest,
+) (*scheduling.Booking, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc((*BookingService).Book),
+	)
+	defer span.Finish()
+
+	if svc.booker == nil {
+		return nil, scheduling.ErrBookingUnavailable
+	}
+	if err := svc.validateRequest(&req); err != nil {
+		return nil, err
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod((*BookingService).Book),
+		"start":           req.Start,
+		"duration":        req.Duration,
+	}).WithContext(ctx)
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+	svc.prune()
+
+	// Return the original booking for repeated requests.
+	key := code + ":" + req.IdempotencyKey
+	if prev, ok := svc.keys[key]; ok {
+		if !sameRequest(&prev.req, &req) {
+			return nil, scheduling.ErrIdempotencyKeyReused
+		}
+		log.Info("Returning booking for repeated request.")
+		booking := prev.booking
+		return &booking, nil
+	}
+
+	// Re-check my availability, including bookings that may not yet be
+	// reflected by my calendar.
+	end := req.Start.Add(req.Duration)
+	slots, err := svc.svc.Availability(
+		ctx,
+		req.Start, end,
+		req.Duration,
+		func(opt *scheduling.AvailabilityOptions) {
+			opt.WorkingHours = svc.opt.WorkingHours
+			opt.Buffer = svc.opt.Buffer
+			opt.Limit = 1
+		},
+	)
+	if err != nil {
+		return nil, errors.WithMessage(err, "schedsvc: check availability")
+	}
+	if len(slots) == 0 {
+		return nil, scheduling.ErrSlotUnavailable
+	}
+	for i := range svc.bookings {
+		b := &svc.bookings[i]
+		if b.Start.Add(-svc.opt.Buffer).Before(end) &&
+			req.Start.Before(b.End.Add(svc.opt.Buffer)) {
+			return nil, scheduling.ErrSlotUnavailable
+		}
+	}
+
+	// Place the hold. Its ID is derived from the idempotency key, so that the
+	// booker can reject duplicate holds that were placed before this service
+	// last restarted.
+	booking := scheduling.Booking{
+		ID:        bookingID(code, req.IdempotencyKey),
+		Start:     req.Start,
+		End:       end,
+		Name:      req.Name,
+		Email:     req.Email,
+		Notes:     req.Notes,
+		CreatedAt: time.Now(),
+	}
+	if req.Title != nil {
+		booking.Title = *req.Title
+	} else {
+		booking.Title = "Meeting with " + req.Name
+	}
+	if err = svc.booker.Hold(ctx, &booking); err != nil {
+		if errors.Is(err, scheduling.ErrBookingExists) {
+			log.Info("Rejected duplicate booking.")
+			return nil, err
+		}
+		log.WithError(err).Error("Failed to place hold.")
+		return nil, errors.WithMessage(err, "schedsvc: place hold")
+	}
+	log.WithField("id", booking.ID).Info("Placed booking.")
+
+	svc.keys[key] = idempotentBooking{req: req, booking: booking}
+	svc.bookings = append(svc.bookings, booking)
+	return &booking, nil
+}
+
+func (svc *BookingService) validateRequest(req *scheduling.BookingRequest) error {
+	req.Name = strings.TrimSpace(req.Name)
+	switch {
+	case req.Name == "":
+		return errors.WithDetail(
+			scheduling.ErrInvalidBooking,
+			"A name is required.",
+		)
+	case req.IdempotencyKey == "":
+		return errors.WithDetail(
+			scheduling.ErrInvalidBooking,
+			"An idempotency key is required.",
+		)
+	case len(req.IdempotencyKey) > 128:
+		return errors.WithDetail(
+			scheduling.ErrInvalidBooking,
+			"Idempotency keys may not exceed 128 characters.",
+		)
+	case req.Duration <= 0:
+		return errors.WithDetail(
+			scheduling.ErrInvalidBooking,
+			"Duration must be positive.",
+		)
+	case req.Duration > svc.opt.MaxDuration:
+		return errors.WithDetailf(
+			scheduling.ErrInvalidBooking,
+			"Bookings may not exceed %s.", svc.opt.MaxDuration,
+		)
+	case !req.Start.After(time.Now()):
+		return errors.WithDetail(
+			scheduling.ErrInvalidBooking,
+			"Bookings must start in the future.",
+		)
+	}
+	return nil
+}
+
+// prune forgets idempotency keys and bookings that have expired.
+//
+// svc.mux must be held.
+func (svc *BookingService) prune() {
+	cutoff := time.Now().Add(-svc.opt.IdempotencyTTL)
+	for key, ib := range svc.keys {
+		if ib.booking.CreatedAt.Before(cutoff) {
+			delete(svc.keys, key)
+		}
+	}
+
+	// Bookings only need to be remembered until they end.
+	var (
+		now      = time.Now()
+		bookings = svc.bookings[:0]
+	)
+	for _, b := range svc.bookings {
+		if b.End.After(now) {
+			bookings = append(bookings, b)
+		}
+	}
+	svc.bookings = bookings
+}
+
+// sameRequest reports whether a and b request the same Booking.
+func sameRequest(a, b *scheduling.BookingRequest) bool {
+	return a.Start.Equal(b.Start) &&
+		(a.Duration == b.Duration) &&
+		equalStrings(a.Title, b.Title) &&
+		(a.Name == b.Name) &&
+		equalStrings(a.Email, b.Email) &&
+		equalStrings(a.Notes, b.Notes) &&
+		(a.IdempotencyKey == b.IdempotencyKey)
+}
+
+func equalStrings(a, b *string) bool {
+	if (a == nil) || (b == nil) {
+		return a == b
+	}
+	return *a == *b
+}
+
+// bookingID derives the ID of the Booking requested by the holder of code
+// with the specified idempotency key.
+//
+// IDs consist of lowercase hexadecimal digits, which are valid in both
+// iCalendar UIDs and Google Calendar event IDs.
+func bookingID(code, key string) string {
+	sum := sha256.Sum256([]byte(code + "\x00" + key))
+	return hex.EncodeToString(sum[:16])
+}
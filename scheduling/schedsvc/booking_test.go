@@ -0,0 +1,238 @@
+package schedsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/scheduling"
+	"go.stevenxie.me/api/v2/scheduling/schedmem"
+)
+
+type utcZones struct{}
+
+func (utcZones) CurrentTimeZone(context.Context) (*time.Location, error) {
+	return time.UTC, nil
+}
+
+// nextMonday returns the start of the Monday that is at least a week away.
+func nextMonday() time.Time {
+	day := time.Now().UTC().AddDate(0, 0, 7)
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	for day.Weekday() != time.Monday {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+func newTestBookingService(
+	cal *schedmem.Calendar,
+	opts ...BookingServiceOption,
+) *BookingService {
+	return NewBookingService(NewService(cal, nil, utcZones{}), cal, opts...)
+}
+
+func TestBookingService(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		monday = nextMonday()
+		cal    = schedmem.NewCalendar()
+		svc    = newTestBookingService(cal)
+	)
+	cal.AddBusy(scheduling.TimeSpan{
+		Start: monday.Add(9 * time.Hour),
+		End:   monday.Add(10 * time.Hour),
+	})
+
+	req := scheduling.BookingRequest{
+		Start:          monday.Add(11 * time.Hour),
+		Duration:       30 * time.Minute,
+		Name:           " Jane Doe ",
+		IdempotencyKey: "jane-1",
+	}
+	booking, err := svc.Book(ctx, "code", req)
+	if err != nil {
+		t.Fatalf("book: %v", err)
+	}
+	if booking.Title != "Meeting with Jane Doe" {
+		t.Errorf("Title = %q, want %q", booking.Title, "Meeting with Jane Doe")
+	}
+	if !booking.End.Equal(req.Start.Add(req.Duration)) {
+		t.Errorf("End = %s, want %s", booking.End, req.Start.Add(req.Duration))
+	}
+	if holds := cal.Holds(); (len(holds) != 1) || (holds[0].ID != booking.ID) {
+		t.Fatalf("holds = %+v, want [%s]", holds, booking.ID)
+	}
+
+	t.Run("retry", func(t *testing.T) {
+		retry := req
+		retry.Start = retry.Start.In(time.FixedZone("EST", -5*60*60))
+		b, err := svc.Book(ctx, "code", retry)
+		if err != nil {
+			t.Fatalf("book: %v", err)
+		}
+		if b.ID != booking.ID {
+			t.Errorf("ID = %s, want %s", b.ID, booking.ID)
+		}
+		if n := len(cal.Holds()); n != 1 {
+			t.Errorf("got %d holds, want 1", n)
+		}
+	})
+
+	t.Run("reused key", func(t *testing.T) {
+		reused := req
+		reused.Start = reused.Start.Add(time.Hour)
+		_, err := svc.Book(ctx, "code", reused)
+		if !errors.Is(err, scheduling.ErrIdempotencyKeyReused) {
+			t.Errorf("err = %v, want %v", err, scheduling.ErrIdempotencyKeyReused)
+		}
+	})
+
+	t.Run("same key for another code", func(t *testing.T) {
+		other := req
+		other.Start = other.Start.Add(2 * time.Hour)
+		b, err := svc.Book(ctx, "other", other)
+		if err != nil {
+			t.Fatalf("book: %v", err)
+		}
+		if b.ID == booking.ID {
+			t.Error("expected a different ID for a different code")
+		}
+	})
+
+	// A new service forgets its idempotency keys, and so must rely on the
+	// calendar.
+	restarted := newTestBookingService(
+		cal,
+		BookingServiceWithBuffer(10*time.Minute),
+	)
+	for _, tt := range []struct {
+		name string
+		req  scheduling.BookingRequest
+		want error
+	}{
+		{
+			name: "busy",
+			req: scheduling.BookingRequest{
+				Start:          monday.Add(9*time.Hour + 30*time.Minute),
+				Duration:       30 * time.Minute,
+				Name:           "John",
+				IdempotencyKey: "john-1",
+			},
+			want: scheduling.ErrSlotUnavailable,
+		},
+		{
+			// The meeting ends at 10 AM, but is followed by a buffer.
+			name: "buffer",
+			req: scheduling.BookingRequest{
+				Start:          monday.Add(10 * time.Hour),
+				Duration:       30 * time.Minute,
+				Name:           "John",
+				IdempotencyKey: "john-5",
+			},
+			want: scheduling.ErrSlotUnavailable,
+		},
+		{
+			name: "held",
+			req: scheduling.BookingRequest{
+				Start:          monday.Add(11*time.Hour + 15*time.Minute),
+				Duration:       30 * time.Minute,
+				Name:           "John",
+				IdempotencyKey: "john-2",
+			},
+			want: scheduling.ErrSlotUnavailable,
+		},
+		{
+			name: "outside working hours",
+			req: scheduling.BookingRequest{
+				Start:          monday.Add(18 * time.Hour),
+				Duration:       30 * time.Minute,
+				Name:           "John",
+				IdempotencyKey: "john-3",
+			},
+			want: scheduling.ErrSlotUnavailable,
+		},
+		{
+			name: "too long",
+			req: scheduling.BookingRequest{
+				Start:          monday.Add(13 * time.Hour),
+				Duration:       2 * time.Hour,
+				Name:           "John",
+				IdempotencyKey: "john-4",
+			},
+			want: scheduling.ErrInvalidBooking,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := restarted.Book(ctx, "code", tt.req)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("duplicate hold", func(t *testing.T) {
+		// Free the slot, so that only the booker can reject the request.
+		moved := req
+		moved.Start = monday.Add(14 * time.Hour)
+		_, err := restarted.Book(ctx, "code", moved)
+		if !errors.Is(err, scheduling.ErrBookingExists) {
+			t.Errorf("err = %v, want %v", err, scheduling.ErrBookingExists)
+		}
+	})
+}
+
+func TestBookingServiceWorkingHours(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		sunday = nextMonday().AddDate(0, 0, -1)
+		cal    = schedmem.NewCalendar()
+		svc    = newTestBookingService(
+			cal,
+			BookingServiceWithWorkingHours(scheduling.WorkingHours{
+				Start:    18 * time.Hour,
+				End:      22 * time.Hour,
+				Weekdays: []time.Weekday{time.Sunday},
+			}),
+		)
+	)
+	req := scheduling.BookingRequest{
+		Start:          sunday.Add(19 * time.Hour),
+		Duration:       time.Hour,
+		Name:           "Jane",
+		IdempotencyKey: "jane-1",
+	}
+	if _, err := svc.Book(ctx, "code", req); err != nil {
+		t.Fatalf("book: %v", err)
+	}
+
+	req.Start = sunday.Add(10 * time.Hour)
+	req.IdempotencyKey = "jane-2"
+	if _, err := svc.Book(ctx, "code", req); !errors.Is(
+		err,
+		scheduling.ErrSlotUnavailable,
+	) {
+		t.Errorf("err = %v, want %v", err, scheduling.ErrSlotUnavailable)
+	}
+}
+
+func TestBookingServiceWithoutBooker(t *testing.T) {
+	svc := NewBookingService(
+		NewService(schedmem.NewCalendar(), nil, utcZones{}),
+		nil,
+	)
+	_, err := svc.Book(
+		context.Background(),
+		"code", scheduling.BookingRequest{
+			Start:          nextMonday().Add(10 * time.Hour),
+			Duration:       time.Hour,
+			Name:           "Jane",
+			IdempotencyKey: "jane-1",
+		},
+	)
+	if !errors.Is(err, scheduling.ErrBookingUnavailable) {
+		t.Errorf("err = %v, want %v", err, scheduling.ErrBookingUnavailable)
+	}
+}
@@ -0,0 +1,39 @@
+package schedsvc
+
+import (
+	"context"
+	"time"
+
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// NewMergedCalendar creates a scheduling.Calendar that reports the busy times
+// of each of cals.
+//
+// Unlike a merged git.Source, a merged Calendar fails if any of cals fail,
+// since missing busy times would be mistaken for free time.
+func NewMergedCalendar(cals ...scheduling.Calendar) scheduling.Calendar {
+	if len(cals) == 1 {
+		return cals[0]
+	}
+	return mergedCalendar(cals)
+}
+
+type mergedCalendar []scheduling.Calendar
+
+var _ scheduling.Calendar = (*mergedCalendar)(nil)
+
+func (mc mergedCalendar) BusyTimes(
+	ctx context.Context,
+	date time.Time,
+) ([]scheduling.TimeSpan, error) {
+	var periods []scheduling.TimeSpan
+	for _, cal := range mc {
+		ps, err := cal.BusyTimes(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+		periods = append(periods, ps...)
+	}
+	return periods, nil
+}
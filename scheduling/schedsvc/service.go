@@ -14,18 +14,24 @@ import (
 
 	"go.stevenxie.me/api/v2/location"
 	"go.stevenxie.me/api/v2/pkg/basic"
+	"go.stevenxie.me/api/v2/pkg/timeutil"
 	"go.stevenxie.me/api/v2/scheduling"
 )
 
 // NewService creates a new Service.
+//
+// events may be nil, in which case Events are derived from the busy times
+// reported by cal, without any titles or details.
 func NewService(
 	cal scheduling.Calendar,
+	events scheduling.EventSource,
 	zones location.TimeZoneService,
 	opts ...basic.Option,
 ) scheduling.Service {
 	cfg := basic.BuildOptions(opts...)
 	return service{
 		cal:    cal,
+		events: events,
 		zones:  zones,
 		log:    logutil.WithComponent(cfg.Logger, (*service)(nil)),
 		tracer: cfg.Tracer,
@@ -33,8 +39,9 @@ func NewService(
 }
 
 type service struct {
-	cal   scheduling.Calendar
-	zones location.TimeZoneService
+	cal    scheduling.Calendar
+	events scheduling.EventSource
+	zones  location.TimeZoneService
 
 	log    *logrus.Entry
 	tracer opentracing.Tracer
@@ -96,3 +103,148 @@ func (svc service) BusyTimesToday(ctx context.Context) ([]scheduling.TimeSpan, e
 	}
 	return svc.BusyTimes(ctx, time.Now().In(tz))
 }
+
+// _maxBusyDays is the maximum number of days over which busy times can be
+// queried.
+const _maxBusyDays = 31
+
+func (svc service) BusyTimesBetween(
+	ctx context.Context,
+	start, end time.Time,
+) ([]scheduling.TimeSpan, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.BusyTimesBetween),
+	)
+	defer span.Finish()
+
+	if !start.Before(end) {
+		return nil, errors.WithDetail(
+			scheduling.ErrInvalidRange,
+			"The start of the range must be before its end.",
+		)
+	}
+	end = end.In(start.Location())
+	if end.After(start.AddDate(0, 0, _maxBusyDays)) {
+		return nil, errors.WithDetailf(
+			scheduling.ErrInvalidRange,
+			"Ranges may not exceed %d days.",
+			_maxBusyDays,
+		)
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.BusyTimesBetween),
+		"start":           start,
+		"end":             end,
+	}).WithContext(ctx)
+
+	var periods []scheduling.TimeSpan
+	if cal, ok := svc.cal.(scheduling.RangeCalendar); ok {
+		log.Trace("Getting busy times from calendar...")
+		var err error
+		if periods, err = cal.BusyTimesBetween(ctx, start, end); err != nil {
+			log.WithError(err).Error("Failed to load busy times from calendar.")
+			return nil, err
+		}
+	} else {
+		// Query each day separately; days are advanced by date (rather than by
+		// 24 hours), so that their boundaries are correct across DST
+		// transitions.
+		for day := timeutil.DayStart(start); day.Before(end); day = day.AddDate(0, 0, 1) {
+			log.
+				WithField("day", day).
+				Trace("Getting busy times from calendar...")
+			ps, err := svc.cal.BusyTimes(ctx, day)
+			if err != nil {
+				log.WithError(err).Error("Failed to load busy times from calendar.")
+				return nil, err
+			}
+			periods = append(periods, ps...)
+		}
+	}
+	log.
+		WithField("periods", periods).
+		Trace("Loaded busy times from calendar.")
+
+	// Merge overlapping periods (i.e. from different calendars, or that span
+	// multiple days), and clip them to the range.
+	periods = scheduling.ClipSpans(
+		scheduling.MergeSpans(periods),
+		scheduling.TimeSpan{Start: start, End: end},
+	)
+	if periods == nil {
+		periods = []scheduling.TimeSpan{}
+	}
+	return periods, nil
+}
+
+func (svc service) Events(
+	ctx context.Context,
+	date time.Time,
+) ([]scheduling.Event, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.Events),
+	)
+	defer span.Finish()
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod(service.Events),
+		"date":            date,
+	}).WithContext(ctx)
+
+	// Derive events from busy times, if there is no event source.
+	if svc.events == nil {
+		periods, err := svc.BusyTimes(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+		events := make([]scheduling.Event, len(periods))
+		for i, p := range periods {
+			events[i] = scheduling.Event{
+				Start:        p.Start,
+				End:          p.End,
+				Transparency: scheduling.TransparencyOpaque,
+			}
+		}
+		return events, nil
+	}
+
+	log.Trace("Getting events from event source...")
+	events, err := svc.events.Events(ctx, date)
+	if err != nil {
+		log.WithError(err).Error("Failed to load events.")
+		return nil, err
+	}
+	log.
+		WithField("events", events).
+		Trace("Loaded events from event source.")
+
+	// Sort events.
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].Span(), events[j].Span()
+		return a.Before(&b)
+	})
+	return events, nil
+}
+
+func (svc service) EventsToday(ctx context.Context) ([]scheduling.Event, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc(service.EventsToday),
+	)
+	defer span.Finish()
+
+	log := logutil.
+		WithMethod(svc.log, service.EventsToday).
+		WithContext(ctx)
+
+	log.Trace("Getting current time zone...")
+	tz, err := svc.zones.CurrentTimeZone(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to get current time zone.")
+		return nil, errors.Wrap(err, "schedsvc: get current time zone")
+	}
+	return svc.Events(ctx, time.Now().In(tz))
+}
@@ -0,0 +1,409 @@
+package schedsvc
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+
+	"go.stevenxie.me/api/v2/location"
+	"go.stevenxie.me/api/v2/location/geocode"
+	"go.stevenxie.me/api/v2/location/routing"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// NewTravelService creates a new TravelService, which finds the locations of
+// my Events using geo, and plans the trips between them using router.
+//
+// If requested with scheduling.TravelWithCurrentPosition, trips to the next
+// upcoming Event begin at my current position, as reported by pos.
+func NewTravelService(
+	svc scheduling.Service,
+	pos location.PositionService,
+	geo geocode.Geocoder,
+	router routing.Router,
+	opts ...TravelServiceOption,
+) *TravelService {
+	opt := TravelServiceOptions{
+		Logger: logutil.NoopEntry(),
+		Tracer: new(opentracing.NoopTracer),
+		Mode:   routing.ModeCar,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+	return &TravelService{
+		svc:    svc,
+		pos:    pos,
+		geo:    geo,
+		router: router,
+		log:    logutil.WithComponent(opt.Logger, (*TravelService)(nil)),
+		tracer: opt.Tracer,
+		mode:   opt.Mode,
+		places: make(map[string]placeEntry),
+		routes: make(map[routeKey]routeEntry),
+	}
+}
+
+// TravelServiceWithLogger configures a TravelService to write logs with log.
+func TravelServiceWithLogger(log *logrus.Entry) TravelServiceOption {
+	return func(opt *TravelServiceOptions) { opt.Logger = log }
+}
+
+// TravelServiceWithTracer configures a TravelService to trace calls with t.
+func TravelServiceWithTracer(t opentracing.Tracer) TravelServiceOption {
+	return func(opt *TravelServiceOptions) { opt.Tracer = t }
+}
+
+// TravelServiceWithMode configures the mode of transport that a TravelService
+// plans trips with.
+func TravelServiceWithMode(m routing.Mode) TravelServiceOption {
+	return func(opt *TravelServiceOptions) { opt.Mode = m }
+}
+
+type (
+	// A TravelService implements a scheduling.TravelService.
+	TravelService struct {
+		svc    scheduling.Service
+		pos    location.PositionService
+		geo    geocode.Geocoder
+		router routing.Router
+		log    *logrus.Entry
+		tracer opentracing.Tracer
+		mode   routing.Mode
+
+		// places caches the coordinates of event locations, and routes caches
+		// planned routes.
+		mux    sync.Mutex
+		places map[string]placeEntry
+		routes map[routeKey]routeEntry
+	}
+
+	// TravelServiceOptions configures a TravelService.
+	TravelServiceOptions struct {
+		Logger *logrus.Entry
+		Tracer opentracing.Tracer
+		Mode   routing.Mode
+	}
+
+	// A TravelServiceOption modifies a TravelServiceOptions.
+	TravelServiceOption func(*TravelServiceOptions)
+
+	placeEntry struct {
+		coord   *location.Coordinates // nil if the place could not be found
+		expires time.Time
+	}
+
+	routeKey struct {
+		from, to location.Coordinates
+		mode     routing.Mode
+
+		// departure is the start of the _departureInterval during which the
+		// trip begins, in Unix time.
+		departure int64
+	}
+
+	routeEntry struct {
+		route   routing.Route
+		expires time.Time
+	}
+)
+
+var _ scheduling.TravelService = (*TravelService)(nil)
+
+const (
+	// _minTravelDistance is the distance (in meters) between two positions,
+	// below which no travel is required.
+	_minTravelDistance = 100
+
+	// _routeTTL is the duration for which planned routes are cached; it is
+	// short, since travel times depend on traffic.
+	_routeTTL = 15 * time.Minute
+
+	// _departureInterval is the granularity with which departure times are
+	// cached; routes for trips that begin in the same interval are reused.
+	_departureInterval = 15 * time.Minute
+
+	// _placeTTL and _placeMissTTL are the durations for which the coordinates
+	// of event locations (and failures to find them) are cached. Since
+	// locations are found near my current position, they're cached for less
+	// than a day.
+	_placeTTL     = 6 * time.Hour
+	_placeMissTTL = 5 * time.Minute
+
+	// _maxCacheSize is the maximum number of places and routes that are
+	// cached.
+	_maxCacheSize = 256
+)
+
+// TravelBlocks implements scheduling.TravelService.
+func (svc *TravelService) TravelBlocks(
+	ctx context.Context,
+	date time.Time,
+	opts ...scheduling.TravelOption,
+) ([]scheduling.TravelBlock, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc((*TravelService).TravelBlocks),
+	)
+	defer span.Finish()
+
+	var opt scheduling.TravelOptions
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	log := svc.log.WithFields(logrus.Fields{
+		logutil.MethodKey: name.OfMethod((*TravelService).TravelBlocks),
+		"date":            date,
+	}).WithContext(ctx)
+
+	events, err := svc.svc.Events(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// My current position is only needed to plan the trip to the next
+	// upcoming event, so failing to find it is not fatal.
+	var (
+		now     = time.Now()
+		current *location.Coordinates
+	)
+	if opt.FromCurrentPosition {
+		log.Trace("Getting current position...")
+		if current, err = svc.pos.CurrentPosition(ctx); err != nil {
+			log.WithError(err).Warn("Failed to get current position.")
+			current = nil
+		}
+	}
+
+	type stop struct {
+		event *scheduling.Event
+		coord location.Coordinates
+	}
+	var (
+		prev   *stop
+		blocks = make([]scheduling.TravelBlock, 0)
+	)
+	for i := range events {
+		e := &events[i]
+		if e.AllDay || !e.Busy() || (e.Location == nil) ||
+			!isPhysicalLocation(*e.Location) {
+			continue
+		}
+		coord, err := svc.locate(ctx, *e.Location, current)
+		if err != nil {
+			return nil, err
+		}
+		if coord == nil {
+			log.
+				WithField("location", *e.Location).
+				Debug("Could not find event location; skipping.")
+			continue
+		}
+		next := &stop{event: e, coord: *coord}
+
+		// Determine where (and when) the trip to e begins: from my current
+		// position if e is upcoming and I'm not at another event before it, or
+		// else from the previous event.
+		var (
+			last      = prev
+			from      location.Coordinates
+			origin    *string
+			departure time.Time
+		)
+		prev = next
+		switch {
+		case e.Start.After(now) && (current != nil) &&
+			((last == nil) || !last.event.End.After(now)):
+			from, departure = *current, now
+		case last != nil:
+			from, origin, departure = last.coord, last.event.Location, last.event.End
+		default:
+			continue
+		}
+		if routing.Distance(from, next.coord) < _minTravelDistance {
+			continue
+		}
+
+		log.
+			WithFields(logrus.Fields{
+				"from":      from,
+				"to":        next.coord,
+				"departure": departure,
+			}).
+			Trace("Planning route...")
+		route, err := svc.route(ctx, from, next.coord, departure)
+		if err != nil {
+			log.WithError(err).Error("Failed to plan route.")
+			return nil, err
+		}
+		blocks = append(blocks, scheduling.TravelBlock{
+			Start:       e.Start.Add(-route.Duration),
+			End:         e.Start,
+			Departure:   departure,
+			Duration:    route.Duration,
+			Distance:    route.Distance,
+			Origin:      origin,
+			Destination: e.Location,
+			EventID:     e.ID,
+			Estimated:   route.Estimated,
+		})
+	}
+	return blocks, nil
+}
+
+// TravelBlocksToday implements scheduling.TravelService.
+func (svc *TravelService) TravelBlocksToday(
+	ctx context.Context,
+	opts ...scheduling.TravelOption,
+) ([]scheduling.TravelBlock, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc((*TravelService).TravelBlocksToday),
+	)
+	defer span.Finish()
+
+	log := logutil.
+		WithMethod(svc.log, (*TravelService).TravelBlocksToday).
+		WithContext(ctx)
+
+	log.Trace("Getting current time zone...")
+	tz, err := svc.pos.CurrentTimeZone(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to get current time zone.")
+		return nil, errors.Wrap(err, "schedsvc: get current time zone")
+	}
+	return svc.TravelBlocks(ctx, time.Now().In(tz), opts...)
+}
+
+// route plans the route between two positions, reusing recently planned
+// routes between the same positions.
+func (svc *TravelService) route(
+	ctx context.Context,
+	from, to location.Coordinates,
+	departure time.Time,
+) (*routing.Route, error) {
+	var (
+		key = routeKey{
+			from:      from,
+			to:        to,
+			mode:      svc.mode,
+			departure: departure.Truncate(_departureInterval).Unix(),
+		}
+		now = time.Now()
+	)
+	svc.mux.Lock()
+	entry, ok := svc.routes[key]
+	svc.mux.Unlock()
+	if ok && now.Before(entry.expires) {
+		return &entry.route, nil
+	}
+
+	route, err := svc.router.Route(
+		ctx, from, to,
+		routing.RouteWithMode(svc.mode),
+		routing.RouteWithDeparture(departure),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "schedsvc: plan route")
+	}
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+	var (
+		oldest  routeKey
+		expires time.Time
+	)
+	for k, e := range svc.routes {
+		if !now.Before(e.expires) {
+			delete(svc.routes, k)
+		} else if expires.IsZero() || e.expires.Before(expires) {
+			oldest, expires = k, e.expires
+		}
+	}
+	if len(svc.routes) >= _maxCacheSize {
+		delete(svc.routes, oldest)
+	}
+	svc.routes[key] = routeEntry{
+		route:   *route,
+		expires: now.Add(_routeTTL),
+	}
+	return route, nil
+}
+
+// locate finds the coordinates of an event location, preferring places near
+// my current position (if it is known).
+//
+// It returns nil if the location could not be found.
+func (svc *TravelService) locate(
+	ctx context.Context,
+	loc string,
+	near *location.Coordinates,
+) (*location.Coordinates, error) {
+	now := time.Now()
+	svc.mux.Lock()
+	entry, ok := svc.places[loc]
+	svc.mux.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.coord, nil
+	}
+
+	var opts []geocode.GeocodeOption
+	if near != nil {
+		opts = append(opts, geocode.GeocodeWithProximity(*near))
+	}
+	results, err := svc.geo.Geocode(ctx, loc, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "schedsvc: geocode event location")
+	}
+	entry = placeEntry{expires: now.Add(_placeMissTTL)}
+	if len(results) > 0 {
+		entry = placeEntry{
+			coord:   &results[0].Place.Position,
+			expires: now.Add(_placeTTL),
+		}
+	}
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+	var (
+		oldest  string
+		expires time.Time
+	)
+	for k, e := range svc.places {
+		if !now.Before(e.expires) {
+			delete(svc.places, k)
+		} else if expires.IsZero() || e.expires.Before(expires) {
+			oldest, expires = k, e.expires
+		}
+	}
+	if len(svc.places) >= _maxCacheSize {
+		delete(svc.places, oldest)
+	}
+	svc.places[loc] = entry
+	return entry.coord, nil
+}
+
+// isPhysicalLocation reports whether an event location refers to a physical
+// place, rather than a link (i.e. to a video call).
+func isPhysicalLocation(loc string) bool {
+	loc = strings.TrimSpace(loc)
+	if loc == "" {
+		return false
+	}
+	if u, err := url.Parse(loc); err == nil {
+		switch strings.ToLower(u.Scheme) {
+		case "http", "https":
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+package schedsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/location"
+	"go.stevenxie.me/api/v2/location/geocode"
+	"go.stevenxie.me/api/v2/location/routing"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+type fakeEvents struct {
+	scheduling.Service
+	events []scheduling.Event
+}
+
+func (f fakeEvents) Events(context.Context, time.Time) ([]scheduling.Event, error) {
+	return f.events, nil
+}
+
+type fakePosition struct {
+	location.PositionService
+	coord location.Coordinates
+	calls int
+}
+
+func (f *fakePosition) CurrentPosition(context.Context) (*location.Coordinates, error) {
+	f.calls++
+	return &f.coord, nil
+}
+
+type fakeGeocoder struct {
+	geocode.Geocoder
+	places map[string]location.Coordinates
+}
+
+func (f fakeGeocoder) Geocode(
+	_ context.Context,
+	query string,
+	_ ...geocode.GeocodeOption,
+) ([]geocode.GeocodeResult, error) {
+	coord, ok := f.places[query]
+	if !ok {
+		return nil, errors.Newf("unknown place '%s'", query)
+	}
+	return []geocode.GeocodeResult{{Place: location.Place{Position: coord}}}, nil
+}
+
+type countingRouter struct {
+	routing.Router
+	calls int
+}
+
+func (r *countingRouter) Route(
+	ctx context.Context,
+	from, to location.Coordinates,
+	opts ...routing.RouteOption,
+) (*routing.Route, error) {
+	r.calls++
+	return r.Router.Route(ctx, from, to, opts...)
+}
+
+func TestTravelService(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		now    = time.Now()
+		office = "Office"
+		cafe   = "Cafe"
+		events = fakeEvents{events: []scheduling.Event{
+			{
+				ID:       "office",
+				Start:    now.Add(time.Hour),
+				End:      now.Add(2 * time.Hour),
+				Location: &office,
+			},
+			{
+				ID:       "cafe",
+				Start:    now.Add(3 * time.Hour),
+				End:      now.Add(4 * time.Hour),
+				Location: &cafe,
+			},
+		}}
+		pos = &fakePosition{coord: location.Coordinates{X: 0.1}}
+		geo = fakeGeocoder{places: map[string]location.Coordinates{
+			office: {},
+			cafe:   {Y: 0.1},
+		}}
+		router = &countingRouter{Router: routing.NewHeuristicRouter()}
+		svc    = NewTravelService(events, pos, geo, router)
+	)
+
+	// Without my current position, only the trip between events is planned.
+	blocks, err := svc.TravelBlocks(ctx, now)
+	if err != nil {
+		t.Fatalf("get travel blocks: %v", err)
+	}
+	if pos.calls != 0 {
+		t.Errorf("got %d position lookups, want 0", pos.calls)
+	}
+	if (len(blocks) != 1) || (blocks[0].EventID != "cafe") ||
+		(blocks[0].Origin == nil) || (*blocks[0].Origin != office) {
+		t.Fatalf("blocks = %+v, want a trip from the office to the cafe", blocks)
+	}
+
+	blocks, err = svc.TravelBlocks(
+		ctx, now,
+		scheduling.TravelWithCurrentPosition(),
+	)
+	if err != nil {
+		t.Fatalf("get travel blocks: %v", err)
+	}
+	if pos.calls != 1 {
+		t.Errorf("got %d position lookups, want 1", pos.calls)
+	}
+	if (len(blocks) != 2) || (blocks[0].EventID != "office") ||
+		(blocks[0].Origin != nil) {
+		t.Fatalf(
+			"blocks = %+v, want a trip from my current position to the office",
+			blocks,
+		)
+	}
+
+	// The route between events is reused.
+	if router.calls != 2 {
+		t.Errorf("got %d routes planned, want 2", router.calls)
+	}
+
+	// Routes for later departures are planned again.
+	var (
+		from  = geo.places[office]
+		to    = geo.places[cafe]
+		start = time.Date(2019, time.October, 28, 9, 0, 0, 0, time.UTC)
+	)
+	for _, departure := range []time.Time{
+		start,
+		start.Add(5 * time.Minute),
+		start.Add(time.Hour),
+	} {
+		if _, err = svc.route(ctx, from, to, departure); err != nil {
+			t.Fatalf("plan route: %v", err)
+		}
+	}
+	if router.calls != 4 {
+		t.Errorf("got %d routes planned, want 4", router.calls)
+	}
+}
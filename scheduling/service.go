@@ -2,7 +2,11 @@ package scheduling // import "go.stevenxie.me/api/v2/scheduling"
 
 import (
 	"context"
+	stderrs "errors"
+	"net/http"
 	"time"
+
+	"github.com/cockroachdb/errors/exthttp"
 )
 
 // A Service provides scheduling information, derived from my calendar events
@@ -13,4 +17,32 @@ type Service interface {
 	BusyTimes(ctx context.Context, date time.Time) ([]TimeSpan, error)
 
 	BusyTimesToday(ctx context.Context) ([]TimeSpan, error)
+
+	// BusyTimesBetween gets my busy times between start and end, sorted in
+	// ascending order by start time.
+	//
+	// Overlapping busy times are merged, and busy times that extend beyond the
+	// range are clipped to it.
+	BusyTimesBetween(ctx context.Context, start, end time.Time) ([]TimeSpan, error)
+
+	// Events gets my calendar Events for the given date, sorted in ascending
+	// order by start time.
+	Events(ctx context.Context, date time.Time) ([]Event, error)
+	EventsToday(ctx context.Context) ([]Event, error)
+
+	// Availability finds Slots between from and to during which I'm free for
+	// at least duration, in order from best to worst.
+	Availability(
+		ctx context.Context,
+		from, to time.Time,
+		duration time.Duration,
+		opts ...AvailabilityOption,
+	) ([]Slot, error)
 }
+
+// ErrInvalidRange is returned by Service.BusyTimesBetween when it is called
+// with an invalid range.
+var ErrInvalidRange = exthttp.WrapWithHTTPCode(
+	stderrs.New("scheduling: invalid range"),
+	http.StatusBadRequest,
+)
@@ -3,6 +3,7 @@ package scheduling
 import (
 	"context"
 	"encoding/json"
+	"sort"
 	"time"
 )
 
@@ -55,7 +56,52 @@ func (ts *TimeSpan) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&formatted)
 }
 
+// MergeSpans sorts spans, and merges those that overlap or touch.
+func MergeSpans(spans []TimeSpan) []TimeSpan {
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].Before(&spans[j])
+	})
+	var merged []TimeSpan
+	for _, s := range spans {
+		if n := len(merged); (n > 0) && !s.Start.After(merged[n-1].End) {
+			if s.End.After(merged[n-1].End) {
+				merged[n-1].End = s.End
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+// ClipSpans returns the parts of spans that fall within window, omitting
+// spans that fall entirely outside of it.
+func ClipSpans(spans []TimeSpan, window TimeSpan) []TimeSpan {
+	var clipped []TimeSpan
+	for _, s := range spans {
+		if !s.Start.Before(window.End) || !s.End.After(window.Start) {
+			continue
+		}
+		if s.Start.Before(window.Start) {
+			s.Start = window.Start
+		}
+		if s.End.After(window.End) {
+			s.End = window.End
+		}
+		clipped = append(clipped, s)
+	}
+	return clipped
+}
+
 // A Calendar can get my busy time periods.
 type Calendar interface {
 	BusyTimes(ctx context.Context, date time.Time) ([]TimeSpan, error)
 }
+
+// A RangeCalendar is a Calendar that can also get my busy time periods
+// between arbitrary start and end times, without querying each day
+// separately.
+type RangeCalendar interface {
+	Calendar
+	BusyTimesBetween(ctx context.Context, start, end time.Time) ([]TimeSpan, error)
+}
@@ -0,0 +1,153 @@
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// A TravelBlock is a span of time that I need to spend travelling to an Event,
+// from the Event before it or from my current position.
+type TravelBlock struct {
+	// Start is the latest time at which I can leave, and End is the start of
+	// the Event.
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Departure is the earliest time at which I can leave, i.e. the end of the
+	// previous Event.
+	Departure time.Time `json:"departure"`
+
+	Duration time.Duration `json:"duration"`
+
+	// Distance is the length of the trip, in meters.
+	Distance float64 `json:"distance"`
+
+	// Origin and Destination are the locations of the Events that I'm
+	// travelling between. Origin is nil if I'm travelling from my current
+	// position (see TravelWithCurrentPosition).
+	Origin      *string `json:"origin,omitempty"`
+	Destination *string `json:"destination,omitempty"`
+
+	// EventID is the ID of the Event that I'm travelling to.
+	EventID string `json:"eventID,omitempty"`
+
+	// Estimated is true if Duration was estimated heuristically, rather than
+	// planned using a road network.
+	Estimated bool `json:"estimated"`
+}
+
+// Span returns the TimeSpan of the TravelBlock.
+func (tb *TravelBlock) Span() TimeSpan {
+	return TimeSpan{Start: tb.Start, End: tb.End}
+}
+
+// Slack is the time to spare between the TravelBlock's Departure and Start; it
+// is negative if I can't make it to the Event on time.
+func (tb *TravelBlock) Slack() time.Duration { return tb.Start.Sub(tb.Departure) }
+
+// Feasible reports whether I can make it to the Event on time.
+func (tb *TravelBlock) Feasible() bool { return tb.Slack() >= 0 }
+
+// Warning describes why the TravelBlock is not Feasible, like "Can't get from
+// A to B in 10 minutes."; it is nil if the TravelBlock is Feasible.
+func (tb *TravelBlock) Warning() *string {
+	if tb.Feasible() {
+		return nil
+	}
+	var w strings.Builder
+	w.WriteString("Can't get")
+	if tb.Origin != nil {
+		fmt.Fprintf(&w, " from %s", *tb.Origin)
+	}
+	if tb.Destination != nil {
+		fmt.Fprintf(&w, " to %s", *tb.Destination)
+	} else {
+		w.WriteString(" to the next event")
+	}
+	if avail := tb.End.Sub(tb.Departure); avail > 0 {
+		fmt.Fprintf(&w, " in %s", formatMinutes(avail))
+	} else {
+		w.WriteString(" without leaving early")
+	}
+	fmt.Fprintf(&w, " (the trip takes about %s).", formatMinutes(tb.Duration))
+	warning := w.String()
+	return &warning
+}
+
+// formatMinutes formats d in hours and minutes, rounded up to the nearest
+// minute.
+func formatMinutes(d time.Duration) string {
+	var (
+		total = int(math.Ceil(d.Minutes()))
+		hours = total / 60
+		mins  = total % 60
+	)
+	if hours == 0 {
+		return pluralize(mins, "minute")
+	}
+	if mins == 0 {
+		return pluralize(hours, "hour")
+	}
+	return pluralize(hours, "hour") + " and " + pluralize(mins, "minute")
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// Redacted returns a copy of the TravelBlock, with the fields that should not
+// be visible at Visibility v removed.
+func (tb *TravelBlock) Redacted(v Visibility) TravelBlock {
+	redacted := *tb
+	if v < VisibilityFull {
+		redacted.Origin = nil
+		redacted.Destination = nil
+	}
+	if v < VisibilityTitles {
+		redacted.EventID = ""
+	}
+	return redacted
+}
+
+// A TravelService determines the time that I need to spend travelling between
+// my calendar Events.
+type TravelService interface {
+	// TravelBlocks gets the TravelBlocks for the Events on the given date,
+	// sorted in ascending order by start time.
+	//
+	// Events without a (physical) location are ignored.
+	TravelBlocks(
+		ctx context.Context,
+		date time.Time,
+		opts ...TravelOption,
+	) ([]TravelBlock, error)
+	TravelBlocksToday(
+		ctx context.Context,
+		opts ...TravelOption,
+	) ([]TravelBlock, error)
+}
+
+type (
+	// TravelOptions are option parameters for TravelService.TravelBlocks.
+	TravelOptions struct {
+		// FromCurrentPosition allows the trip to the next upcoming Event to begin
+		// at my current position. Otherwise, every trip begins at the previous
+		// Event, so that TravelBlocks do not reveal where I am.
+		FromCurrentPosition bool
+	}
+
+	// A TravelOption modifies a TravelOptions.
+	TravelOption func(*TravelOptions)
+)
+
+// TravelWithCurrentPosition configures TravelService.TravelBlocks to plan the
+// trip to the next upcoming Event from my current position.
+func TravelWithCurrentPosition() TravelOption {
+	return func(opt *TravelOptions) { opt.FromCurrentPosition = true }
+}
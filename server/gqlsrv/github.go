@@ -0,0 +1,65 @@
+package gqlsrv
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	echo "github.com/labstack/echo/v4"
+
+	"go.stevenxie.me/api/v2/git/gitgh"
+	"go.stevenxie.me/api/v2/pkg/github"
+)
+
+// _maxWebhookPayload is the maximum size of a webhook payload, in bytes
+// (consistent with GitHub's limit).
+const _maxWebhookPayload = 25 << 20
+
+// githubWebhookHandler receives GitHub webhook events, and ingests the commits
+// that they describe.
+func (srv *Server) githubWebhookHandler() echo.HandlerFunc {
+	var (
+		hook = srv.githubWebhook
+		log  = srv.log.WithField("handler", "github-webhook")
+	)
+	return func(c echo.Context) error {
+		req := c.Request()
+		payload, err := ioutil.ReadAll(
+			io.LimitReader(req.Body, _maxWebhookPayload),
+		)
+		if err != nil {
+			return errors.Wrap(err, "gqlsrv: read webhook payload")
+		}
+		if err = github.ValidateSignature256(
+			req.Header.Get(github.SignatureHeader),
+			payload,
+			hook.Secret,
+		); err != nil {
+			log.Warn("Received webhook with an invalid signature.")
+			return err
+		}
+
+		login, err := hook.Login()
+		if err != nil {
+			log.WithError(err).Error("Failed to get GitHub login.")
+			return errors.Wrap(err, "gqlsrv: get GitHub login")
+		}
+
+		eventType := req.Header.Get("X-GitHub-Event")
+		log := log.WithField("event", eventType)
+		cms, err := gitgh.CommitsFromWebhook(eventType, payload, login)
+		if err != nil {
+			log.WithError(err).Error("Failed to parse webhook payload.")
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		if len(cms) > 0 {
+			if err = hook.Ingester.IngestCommits(req.Context(), cms); err != nil {
+				log.WithError(err).Error("Failed to ingest commits.")
+				return errors.Wrap(err, "gqlsrv: ingest commits")
+			}
+			log.WithField("count", len(cms)).Info("Ingested commits from webhook.")
+		}
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -17,16 +17,25 @@ func (srv *Server) gqlHandler() http.Handler {
 		Resolvers: svcgql.NewResolverRoot(
 			svcgql.Services{
 				Git:          srv.svcs.Git,
+				GitStats:     srv.svcs.GitStats,
 				Auth:         srv.svcs.Auth,
 				About:        srv.svcs.About,
 				Music:        srv.svcs.Music,
 				Transit:      srv.svcs.Transit,
 				Location:     srv.svcs.Location,
 				Scheduling:   srv.svcs.Scheduling,
+				Booking:      srv.svcs.Booking,
+				Travel:       srv.svcs.Travel,
 				Productivity: srv.svcs.Productivity,
+
+				ProductivityGoals: srv.svcs.ProductivityGoals,
+				Status:            srv.svcs.Status,
 			},
 			svcgql.Streamers{
-				Music: srv.strms.Music,
+				Git:          srv.strms.Git,
+				Music:        srv.strms.Music,
+				Productivity: srv.strms.Productivity,
+				Status:       srv.strms.Status,
 			},
 		),
 	})
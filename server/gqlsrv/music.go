@@ -0,0 +1,68 @@
+package gqlsrv
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+	echo "github.com/labstack/echo/v4"
+
+	"go.stevenxie.me/api/v2/music/musiccard"
+)
+
+// _cardMaxAge is the maximum amount of time that now-playing cards may be
+// cached for.
+const _cardMaxAge = time.Minute
+
+// musicCardHandler renders my currently playing music as a now-playing card.
+//
+// The card theme can be selected with the 'theme' query parameter.
+func (srv *Server) musicCardHandler(format string) echo.HandlerFunc {
+	var (
+		renderer = musiccard.NewRenderer(
+			musiccard.RendererWithLogger(srv.log),
+		)
+		log = srv.log.WithField("handler", "music-card")
+	)
+	return func(c echo.Context) error {
+		theme, err := musiccard.ThemeByName(c.QueryParam("theme"))
+		if err != nil {
+			return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+		}
+
+		ctx := c.Request().Context()
+		cp, err := srv.svcs.Music.GetCurrent(ctx)
+		if err != nil {
+			return errors.Wrap(err, "gqlsrv: get currently playing music")
+		}
+
+		var (
+			buf   bytes.Buffer
+			ctype string
+		)
+		switch format {
+		case "svg":
+			ctype = "image/svg+xml"
+			err = renderer.RenderSVG(ctx, &buf, cp, theme)
+		case "png":
+			ctype = "image/png"
+			err = renderer.RenderPNG(ctx, &buf, cp, theme)
+		default:
+			panic(errors.Newf("gqlsrv: unknown card format '%s'", format))
+		}
+		if err != nil {
+			log.WithError(err).Error("Failed to render now-playing card.")
+			return err
+		}
+
+		maxAge := musiccard.CacheDuration(cp, _cardMaxAge)
+		c.Response().Header().Set(
+			"Cache-Control",
+			fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())),
+		)
+		return c.Blob(http.StatusOK, ctype, buf.Bytes())
+	}
+}
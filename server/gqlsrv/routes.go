@@ -32,6 +32,19 @@ func (srv *Server) registerRoutes() error {
 		echo.WrapHandler(http.HandlerFunc(gqlutil.ServeGraphiQL("./graphql"))),
 	)
 
+	// Add now-playing card endpoints.
+	e.GET("/music/card.svg", srv.musicCardHandler("svg"))
+	e.GET("/music/card.png", srv.musicCardHandler("png"))
+
+	// Add busy schedule feed endpoints.
+	e.GET("/scheduling/busy.ics", srv.busyFeedHandler("ics"))
+	e.GET("/scheduling/busy.json", srv.busyFeedHandler("json"))
+
+	// Add webhook endpoints.
+	if srv.githubWebhook != nil {
+		e.POST("/webhooks/github", srv.githubWebhookHandler())
+	}
+
 	// Only enable playground in development.
 	if configutil.GetGoEnv() == configutil.GoEnvDevelopment {
 		e.GET(
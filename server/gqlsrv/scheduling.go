@@ -0,0 +1,145 @@
+package gqlsrv
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+	echo "github.com/labstack/echo/v4"
+
+	"go.stevenxie.me/api/v2/auth/authutil"
+	"go.stevenxie.me/api/v2/pkg/ical"
+	"go.stevenxie.me/api/v2/pkg/timeutil"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// Settings for busy schedule feeds.
+const (
+	_feedDefaultDays = 14
+	_feedMaxDays     = 31
+	_feedMaxAge      = 5 * time.Minute
+)
+
+// busyFeedHandler exports my schedule over a rolling window of days, starting
+// today.
+//
+// By default, my schedule is anonymized into busy blocks. If the 'code' query
+// parameter is provided, Events are included, with as much detail as the
+// code's permissions allow. The number of days can be selected with the
+// 'days' query parameter.
+func (srv *Server) busyFeedHandler(format string) echo.HandlerFunc {
+	log := srv.log.WithField("handler", "busy-feed")
+	return func(c echo.Context) error {
+		days := _feedDefaultDays
+		if s := c.QueryParam("days"); s != "" {
+			var err error
+			if days, err = strconv.Atoi(s); (err != nil) ||
+				(days < 1) || (days > _feedMaxDays) {
+				return exthttp.WrapWithHTTPCode(
+					errors.Newf("gqlsrv: days must be between 1 and %d", _feedMaxDays),
+					http.StatusBadRequest,
+				)
+			}
+		}
+
+		var (
+			ctx  = c.Request().Context()
+			code = c.QueryParam("code")
+			feed = scheduling.Feed{Visibility: scheduling.VisibilityBusy}
+		)
+		if code != "" {
+			vis, err := scheduling.VisibilityOf(ctx, srv.svcs.Auth, code)
+			if err != nil {
+				return errors.Wrap(err, "gqlsrv")
+			}
+			if vis == scheduling.VisibilityBusy {
+				return authutil.ErrAccessDenied
+			}
+			feed.Visibility = vis
+		}
+
+		tz, err := srv.svcs.Location.CurrentTimeZone(ctx)
+		if err != nil {
+			return errors.Wrap(err, "gqlsrv: get current time zone")
+		}
+		feed.From = timeutil.DayStart(time.Now().In(tz))
+		feed.To = feed.From.AddDate(0, 0, days)
+
+		if feed.Visibility == scheduling.VisibilityBusy {
+			if feed.Busy, err = srv.svcs.Scheduling.BusyTimesBetween(
+				ctx,
+				feed.From, feed.To,
+			); err != nil {
+				log.WithError(err).Error("Failed to get busy times.")
+				return errors.Wrap(err, "gqlsrv: get busy times")
+			}
+		} else if feed.Events, err = srv.feedEvents(
+			ctx,
+			feed.From, feed.To,
+			feed.Visibility,
+		); err != nil {
+			log.WithError(err).Error("Failed to get events.")
+			return err
+		}
+
+		// Feeds with details must not be stored by shared caches.
+		cacheControl := "public"
+		if code != "" {
+			cacheControl = "private"
+		}
+		c.Response().Header().Set(
+			"Cache-Control",
+			fmt.Sprintf("%s, max-age=%d", cacheControl, int(_feedMaxAge.Seconds())),
+		)
+
+		switch format {
+		case "ics":
+			data, err := feed.ICS()
+			if err != nil {
+				log.WithError(err).Error("Failed to encode feed.")
+				return errors.Wrap(err, "gqlsrv: encode feed")
+			}
+			return c.Blob(http.StatusOK, ical.ContentType, data)
+		case "json":
+			return c.JSON(http.StatusOK, &feed)
+		default:
+			panic(errors.Newf("gqlsrv: unknown feed format '%s'", format))
+		}
+	}
+}
+
+// feedEvents gets my Events between from and to (which must be the starts of
+// days), redacted to Visibility v.
+func (srv *Server) feedEvents(
+	ctx context.Context,
+	from, to time.Time,
+	v scheduling.Visibility,
+) ([]scheduling.Event, error) {
+	var (
+		events []scheduling.Event
+		seen   = make(map[string]bool)
+	)
+	for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+		es, err := srv.svcs.Scheduling.Events(ctx, date)
+		if err != nil {
+			return nil, errors.Wrap(err, "gqlsrv: get events")
+		}
+
+		// Events that span multiple days are listed on each of those days.
+		for _, e := range scheduling.RedactEvents(es, v) {
+			if e.ID != "" {
+				key := e.ID + "@" + e.Start.String()
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+			}
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
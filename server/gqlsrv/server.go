@@ -19,10 +19,12 @@ import (
 	"go.stevenxie.me/api/v2/assist/transit"
 	"go.stevenxie.me/api/v2/auth"
 	"go.stevenxie.me/api/v2/git"
+	"go.stevenxie.me/api/v2/git/stats"
 	"go.stevenxie.me/api/v2/location"
 	"go.stevenxie.me/api/v2/music"
 	"go.stevenxie.me/api/v2/productivity"
 	"go.stevenxie.me/api/v2/scheduling"
+	"go.stevenxie.me/api/v2/status"
 )
 
 // NewServer creates a new Server.
@@ -65,6 +67,7 @@ func NewServer(svcs Services, strms Streamers, opts ...ServerOption) *Server {
 		strms: strms,
 
 		complexityLimit: cfg.ComplexityLimit,
+		githubWebhook:   cfg.GitHubWebhook,
 	}
 }
 
@@ -84,6 +87,25 @@ func WithComplexityLimit(limit int) ServerOption {
 	return func(opt *ServerOptions) { opt.ComplexityLimit = limit }
 }
 
+// WithGitHubWebhook configures a Server to receive GitHub webhook events
+// signed with secret, and to pass the commits that they describe to ing.
+//
+// Only commits pushed or merged by the user whose login is returned by login
+// are ingested.
+func WithGitHubWebhook(
+	secret []byte,
+	login func() (string, error),
+	ing git.CommitIngester,
+) ServerOption {
+	return func(opt *ServerOptions) {
+		opt.GitHubWebhook = &GitHubWebhookOptions{
+			Secret:   secret,
+			Login:    login,
+			Ingester: ing,
+		}
+	}
+}
+
 type (
 	// Server serves the accounts REST API.
 	Server struct {
@@ -95,23 +117,33 @@ type (
 		strms Streamers
 
 		complexityLimit int
+		githubWebhook   *GitHubWebhookOptions
 	}
 
 	// Services are used to handle server requests.
 	Services struct {
 		Git          git.Service
+		GitStats     stats.Service
 		Auth         auth.Service
 		About        about.Service
 		Music        music.Service
 		Transit      transit.Service
 		Location     location.Service
 		Scheduling   scheduling.Service
+		Booking      scheduling.BookingService
+		Travel       scheduling.TravelService
 		Productivity productivity.Service
+
+		ProductivityGoals productivity.GoalService
+		Status            status.Service
 	}
 
 	// Streamers are used to handle server streams.
 	Streamers struct {
-		Music music.Streamer
+		Git          git.Streamer
+		Music        music.Streamer
+		Productivity productivity.GoalStreamer
+		Status       status.Streamer
 	}
 
 	// A ServerOptions configures a Server.
@@ -121,6 +153,20 @@ type (
 
 		// Complexity limit for GraphQL queries.
 		ComplexityLimit int
+
+		// If non-nil, the Server will receive GitHub webhook events.
+		GitHubWebhook *GitHubWebhookOptions
+	}
+
+	// GitHubWebhookOptions configures how a Server receives GitHub webhook
+	// events.
+	GitHubWebhookOptions struct {
+		Secret []byte
+
+		// Login gets my GitHub login; commits pushed or merged by other users
+		// are ignored.
+		Login    func() (string, error)
+		Ingester git.CommitIngester
 	}
 
 	// An ServerOption modifies a ServerOptions.
@@ -0,0 +1,182 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.stevenxie.me/api/v2/location"
+)
+
+// Confidence settings for Fuse.
+const (
+	// _idleConfidence is the confidence of an ActivityIdle Status, which is
+	// used when no other Activity is suggested.
+	_idleConfidence = 0.5
+
+	// _segmentMaxAge is the maximum age of a travel segment that is still
+	// considered to suggest that I'm commuting; location history is often
+	// reported late.
+	_segmentMaxAge = 30 * time.Minute
+
+	// _focusedScore is the minimum productivity score that suggests that I'm
+	// focused, without an ongoing focus session.
+	_focusedScore = 70
+)
+
+// _travelCategories are the categories of location history segments that
+// describe travel.
+var _travelCategories = map[string]bool{
+	"driving":      true,
+	"walking":      true,
+	"running":      true,
+	"cycling":      true,
+	"motorcycling": true,
+	"moving":       true,
+	"flying":       true,
+	"on a bus":     true,
+	"on a train":   true,
+	"on a subway":  true,
+	"on a tram":    true,
+	"on a ferry":   true,
+	"in a taxi":    true,
+}
+
+// Fuse derives my Status from sigs, as seen by a viewer with Mask m.
+//
+// Each signal may suggest an Activity, with some confidence; the most
+// confident suggestion wins, with ties broken by the precedence of the
+// Activities.
+func Fuse(sigs *Signals, m Mask) Status {
+	var (
+		best  = Status{Activity: ActivityIdle, Confidence: _idleConfidence}
+		found bool
+	)
+	suggest := func(s Status) {
+		if !found || (s.Confidence > best.Confidence) ||
+			((s.Confidence == best.Confidence) &&
+				(precedence(s.Activity) < precedence(best.Activity))) {
+			best, found = s, true
+		}
+	}
+
+	// Busy events suggest that I'm in a meeting; events with other attendees,
+	// or a conference link, even more so.
+	for i := range sigs.Events {
+		e := sigs.Events[i].Redacted(m.Events)
+		if e.AllDay || !e.Busy() {
+			continue
+		}
+		s := Status{
+			Activity:   ActivityMeeting,
+			Confidence: 0.8,
+			Detail:     e.Title,
+			Since:      timePtr(e.Start),
+			Until:      timePtr(e.End),
+		}
+		if ((e.Attendees != nil) && (*e.Attendees > 1)) ||
+			(e.ConferenceURL != nil) {
+			s.Confidence = 0.95
+		}
+		suggest(s)
+	}
+
+	// Recent travel segments suggest that I'm commuting.
+	if seg := sigs.Segment; m.Location && (seg != nil) && isTravel(seg) {
+		age := sigs.Timestamp.Sub(seg.TimeSpan.End)
+		if age < 0 {
+			age = 0
+		}
+		if age <= _segmentMaxAge {
+			suggest(Status{
+				Activity:   ActivityCommuting,
+				Confidence: 0.85 - 0.45*float64(age)/float64(_segmentMaxAge),
+				Detail:     stringPtr(seg.Category),
+				Since:      timePtr(seg.TimeSpan.Start),
+			})
+		}
+	}
+
+	// An ongoing focus session suggests that I'm focused, as does (to a lesser
+	// extent) a high productivity score.
+	if f := sigs.Focus; f != nil {
+		if !m.Focus {
+			redacted := f.Redacted()
+			f = &redacted
+		}
+		suggest(Status{
+			Activity:   ActivityFocused,
+			Confidence: 0.9,
+			Detail:     f.Label,
+			Since:      timePtr(f.Start),
+		})
+	} else if p := sigs.Productivity; (p != nil) && (p.Score != nil) &&
+		(*p.Score >= _focusedScore) {
+		suggest(Status{
+			Activity: ActivityFocused,
+			Confidence: 0.3 + 0.3*float64(*p.Score-_focusedScore)/
+				float64(100-_focusedScore),
+		})
+	}
+
+	// Playing music suggests that I'm listening to it.
+	if cp := sigs.Music; (cp != nil) && cp.Playing {
+		// Since is estimated from the playback position, so truncate it to
+		// keep it stable between observations.
+		var (
+			since = cp.Timestamp.Add(-cp.Progress).Truncate(time.Second)
+			track = &cp.Track
+		)
+		detail := track.Name
+		if len(track.Artists) > 0 {
+			names := make([]string, len(track.Artists))
+			for i, a := range track.Artists {
+				names[i] = a.Name
+			}
+			detail = fmt.Sprintf("%s by %s", detail, strings.Join(names, ", "))
+		}
+		suggest(Status{
+			Activity:   ActivityListening,
+			Confidence: 0.6,
+			Detail:     &detail,
+			Since:      &since,
+			Until:      timePtr(since.Add(track.Duration)),
+		})
+	}
+
+	// Otherwise, describe where I am (if visible).
+	if !found && m.Location {
+		if seg := sigs.Segment; (seg != nil) && !isTravel(seg) &&
+			(seg.Place != "") {
+			best.Detail = stringPtr("At " + seg.Place)
+			best.Since = timePtr(seg.TimeSpan.Start)
+		}
+	}
+
+	best.Timestamp = sigs.Timestamp
+	return best
+}
+
+// isTravel reports whether seg describes travel, rather than a visit to a
+// place.
+func isTravel(seg *location.HistorySegment) bool {
+	return _travelCategories[strings.ToLower(seg.Category)] || (seg.Distance > 0)
+}
+
+func precedence(a Activity) int {
+	switch a {
+	case ActivityMeeting:
+		return 0
+	case ActivityCommuting:
+		return 1
+	case ActivityFocused:
+		return 2
+	case ActivityListening:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+func stringPtr(s string) *string     { return &s }
@@ -0,0 +1,55 @@
+package status
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/api/v2/auth"
+	"go.stevenxie.me/api/v2/location"
+	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// A Mask determines which Signals (and details) are visible to a viewer, in
+// accordance with the permissions of the packages that they come from.
+//
+// The zero Mask shows only what is visible without a code.
+type Mask struct {
+	// Events is the Visibility of my calendar events.
+	Events scheduling.Visibility
+
+	// Location is true if my location history is visible.
+	Location bool
+
+	// Focus is true if the labels of my focus sessions are visible.
+	Focus bool
+}
+
+// MaskOf determines the Mask for the holder of code, which may be nil.
+func MaskOf(ctx context.Context, svc auth.Service, code *string) (Mask, error) {
+	var m Mask
+	if code == nil {
+		return m, nil
+	}
+	c := strings.TrimSpace(*code)
+
+	var err error
+	if m.Events, err = scheduling.VisibilityOf(ctx, svc, c); err != nil {
+		return m, err
+	}
+	if m.Location, err = svc.HasPermission(
+		ctx,
+		c, location.PermHistory,
+	); err != nil {
+		return m, errors.Wrap(err, "status: checking permissions")
+	}
+	if m.Focus, err = svc.HasPermission(
+		ctx,
+		c, productivity.PermGoals,
+	); err != nil {
+		return m, errors.Wrap(err, "status: checking permissions")
+	}
+	return m, nil
+}
@@ -0,0 +1,18 @@
+package status // import "go.stevenxie.me/api/v2/status"
+
+import "context"
+
+type (
+	// A Service can determine my current Status.
+	Service interface {
+		// CurrentStatus determines my current Status, as seen by a viewer with
+		// Mask m.
+		CurrentStatus(ctx context.Context, m Mask) (*Status, error)
+	}
+
+	// A Streamer can stream my Status as it changes.
+	Streamer interface {
+		// StreamStatus streams my Status, as seen by a viewer with Mask m.
+		StreamStatus(ctx context.Context, m Mask, ch chan<- Status) error
+	}
+)
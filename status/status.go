@@ -0,0 +1,63 @@
+package status
+
+import (
+	"time"
+
+	"go.stevenxie.me/api/v2/location"
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/scheduling"
+)
+
+// A Status describes what I'm doing right now.
+type Status struct {
+	Activity Activity `json:"activity"`
+
+	// Confidence is a number between 0 and 1, which describes how likely it is
+	// that Activity is correct.
+	Confidence float64 `json:"confidence"`
+
+	// Detail describes the Activity further (i.e. the title of a meeting, or
+	// the track that I'm listening to), if it is visible.
+	Detail *string `json:"detail,omitempty"`
+
+	// Since and Until are the start and end of the Activity, if they are
+	// known.
+	Since *time.Time `json:"since,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// An Activity is a kind of thing that I could be doing.
+type Activity string
+
+// The set of valid Activities, in order of precedence.
+const (
+	ActivityMeeting   Activity = "MEETING"
+	ActivityCommuting Activity = "COMMUTING"
+	ActivityFocused   Activity = "FOCUSED"
+	ActivityListening Activity = "LISTENING"
+	ActivityIdle      Activity = "IDLE"
+)
+
+// Signals are the observations from which a Status is derived.
+//
+// Each field is nil (or empty) if the corresponding observation is not
+// available.
+type Signals struct {
+	Timestamp time.Time
+
+	// Events are the calendar events that are ongoing at Timestamp.
+	Events []scheduling.Event
+
+	// Segment is my most recent location history segment.
+	Segment *location.HistorySegment
+
+	Music *music.CurrentlyPlaying
+
+	// Focus is my ongoing focus session, and Productivity is my productivity
+	// today.
+	Focus        *productivity.FocusSession
+	Productivity *productivity.Productivity
+}
@@ -0,0 +1,41 @@
+package statusgql
+
+import (
+	"context"
+
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/auth"
+	"go.stevenxie.me/api/v2/status"
+)
+
+// NewQuery creates a new Query.
+func NewQuery(svc status.Service, auth auth.Service) Query {
+	return Query{
+		svc:  svc,
+		auth: auth,
+	}
+}
+
+// A Query resolves queries for my current status.
+type Query struct {
+	svc  status.Service
+	auth auth.Service
+}
+
+// Status resolves my current status.Status, as seen by the holder of code.
+func (q Query) Status(ctx context.Context, code *string) (*status.Status, error) {
+	m, err := status.MaskOf(ctx, q.auth, code)
+	if err != nil {
+		return nil, err
+	}
+	return q.svc.CurrentStatus(ctx, m)
+}
+
+// A StatusResolver resolves fields for a status.Status.
+type StatusResolver zero.Struct
+
+//revive:disable-line:exported
+func (StatusResolver) Activity(_ context.Context, s *status.Status) (string, error) {
+	return string(s.Activity), nil
+}
@@ -0,0 +1,58 @@
+package statusgql
+
+import (
+	"context"
+
+	"go.stevenxie.me/api/v2/auth"
+	"go.stevenxie.me/api/v2/status"
+)
+
+// NewSubscriptionResolver creates a new SubscriptionResolver.
+func NewSubscriptionResolver(
+	stream status.Streamer,
+	auth auth.Service,
+) SubscriptionResolver {
+	return SubscriptionResolver{
+		stream: stream,
+		auth:   auth,
+	}
+}
+
+// A SubscriptionResolver resolves status-related GraphQL subscriptions.
+type SubscriptionResolver struct {
+	stream status.Streamer
+	auth   auth.Service
+}
+
+// Status opens a stream of my status.Status as it changes, as seen by the
+// holder of code.
+func (res SubscriptionResolver) Status(
+	ctx context.Context,
+	code *string,
+) (<-chan *status.Status, error) {
+	m, err := status.MaskOf(ctx, res.auth, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		src = make(chan status.Status, 1)
+		dst = make(chan *status.Status, 1)
+	)
+
+	go func(src <-chan status.Status, dst chan<- *status.Status) {
+		for s := range src {
+			s := s
+			select {
+			case dst <- &s:
+			case <-ctx.Done():
+			}
+		}
+		close(dst)
+	}(src, dst)
+
+	if err := res.stream.StreamStatus(ctx, m, src); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
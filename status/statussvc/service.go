@@ -0,0 +1,320 @@
+package statussvc
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+
+	"go.stevenxie.me/gopkg/logutil"
+	"go.stevenxie.me/gopkg/name"
+	"go.stevenxie.me/gopkg/zero"
+
+	"go.stevenxie.me/api/v2/location"
+	"go.stevenxie.me/api/v2/music"
+	"go.stevenxie.me/api/v2/pkg/fanout"
+	"go.stevenxie.me/api/v2/pkg/poll"
+	"go.stevenxie.me/api/v2/productivity"
+	"go.stevenxie.me/api/v2/scheduling"
+	"go.stevenxie.me/api/v2/status"
+)
+
+// NewService creates a new Service, which derives my Status from the
+// signals reported by srcs.
+//
+// While the Service has subscribers, it polls srcs for changes.
+func NewService(srcs Sources, opts ...ServiceOption) *Service {
+	opt := ServiceOptions{
+		Logger:       logutil.NoopEntry(),
+		Tracer:       new(opentracing.NoopTracer),
+		PollInterval: time.Minute,
+	}
+	for _, apply := range opts {
+		apply(&opt)
+	}
+
+	var (
+		log = logutil.WithComponent(opt.Logger, (*Service)(nil))
+		svc = &Service{
+			srcs:   srcs,
+			log:    log,
+			tracer: opt.Tracer,
+		}
+	)
+	svc.hub = fanout.NewHub(
+		fanout.HubWithLogger(log),
+		fanout.HubWithBufferSize(4),
+		fanout.HubWithReplay(true),
+		fanout.HubWithForgetWhenIdle(true),
+		fanout.HubWithSubscriberHook(func(n int) {
+			atomic.StoreInt32(&svc.subs, int32(n))
+		}),
+	)
+	svc.poller = poll.NewPoller(
+		statusActor{svc: svc},
+		opt.PollInterval,
+		poll.PollerWithLogger(log),
+	)
+	return svc
+}
+
+// ServiceWithLogger configures a Service to write logs with log.
+func ServiceWithLogger(log *logrus.Entry) ServiceOption {
+	return func(opt *ServiceOptions) { opt.Logger = log }
+}
+
+// ServiceWithTracer configures a Service to trace calls with t.
+func ServiceWithTracer(t opentracing.Tracer) ServiceOption {
+	return func(opt *ServiceOptions) { opt.Tracer = t }
+}
+
+// ServiceWithPollInterval configures how often a Service polls for changes
+// to my Status, while it has subscribers.
+func ServiceWithPollInterval(n time.Duration) ServiceOption {
+	return func(opt *ServiceOptions) { opt.PollInterval = n }
+}
+
+type (
+	// A Service implements a status.Service and a status.Streamer.
+	Service struct {
+		srcs   Sources
+		log    *logrus.Entry
+		tracer opentracing.Tracer
+		hub    *fanout.Hub
+		poller *poll.Poller
+		subs   int32 // number of subscribers
+
+		// priming is 1 while signals are being collected for a new subscriber.
+		priming int32
+	}
+
+	// Sources report the signals from which a Service derives my Status.
+	//
+	// Only Scheduling is required; if any other source is nil, its signals are
+	// unavailable.
+	Sources struct {
+		Scheduling   scheduling.Service
+		Location     location.HistoryService
+		Music        music.CurrentService
+		Productivity productivity.Service
+		Goals        productivity.GoalService
+	}
+
+	// ServiceOptions configures a Service.
+	ServiceOptions struct {
+		Logger       *logrus.Entry
+		Tracer       opentracing.Tracer
+		PollInterval time.Duration
+	}
+
+	// A ServiceOption modifies a ServiceOptions.
+	ServiceOption func(*ServiceOptions)
+)
+
+var (
+	_ status.Service  = (*Service)(nil)
+	_ status.Streamer = (*Service)(nil)
+)
+
+// CurrentStatus implements status.Service.
+func (svc *Service) CurrentStatus(
+	ctx context.Context,
+	m status.Mask,
+) (*status.Status, error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(
+		ctx, svc.tracer,
+		name.OfFunc((*Service).CurrentStatus),
+	)
+	defer span.Finish()
+
+	sigs := svc.signals(ctx, m.Location)
+	s := status.Fuse(sigs, m)
+	return &s, nil
+}
+
+// StreamStatus implements status.Streamer.
+//
+// Only changes to the Status are sent. ch is closed once ctx is done, or the
+// Service is stopped.
+func (svc *Service) StreamStatus(
+	ctx context.Context,
+	m status.Mask,
+	ch chan<- status.Status,
+) error {
+	if ch == nil {
+		panic(errors.New("statussvc: nil channel"))
+	}
+
+	var last *status.Status
+	send := func(ctx context.Context, v zero.Interface) bool {
+		s := status.Fuse(v.(*status.Signals), m)
+		if (last != nil) && sameStatus(last, &s) {
+			return true
+		}
+		select {
+		case ch <- s:
+			last = &s
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	if err := svc.hub.Subscribe(
+		ctx, send,
+		func() { close(ch) },
+	); err != nil {
+		return errors.Wrap(err, "statussvc: subscribe to status")
+	}
+
+	// If there are no signals to replay (i.e. because the Service was idle),
+	// collect them now, so that the new subscriber doesn't have to wait for the
+	// next poll. Signals are only collected by one subscriber at a time, so
+	// that opening many streams doesn't flood the sources with requests.
+	if !svc.hub.HasLast() && atomic.CompareAndSwapInt32(&svc.priming, 0, 1) {
+		defer atomic.StoreInt32(&svc.priming, 0)
+		if !svc.hub.HasLast() {
+			svc.hub.Publish(svc.signals(ctx, true))
+		}
+	}
+	return nil
+}
+
+// Stop stops the Service from polling for changes, and closes all open
+// streams.
+func (svc *Service) Stop() {
+	svc.poller.Stop()
+	svc.hub.Close()
+}
+
+// signals collects the current signals from each source, concurrently.
+//
+// Signals are collected on a best-effort basis: if a source fails, the
+// failure is logged, and its signals are left out.
+func (svc *Service) signals(
+	ctx context.Context,
+	includeLocation bool,
+) *status.Signals {
+	var (
+		srcs = &svc.srcs
+		sigs = status.Signals{Timestamp: time.Now()}
+		log  = logutil.
+			WithMethod(svc.log, (*Service).signals).
+			WithContext(ctx)
+		wg sync.WaitGroup
+	)
+	run := func(source string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				log.
+					WithError(err).
+					WithField("source", source).
+					Warn("Failed to collect status signals.")
+			}
+		}()
+	}
+
+	run("scheduling", func() error {
+		events, err := srcs.Scheduling.EventsToday(ctx)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if !sigs.Timestamp.Before(e.Start) && sigs.Timestamp.Before(e.End) {
+				sigs.Events = append(sigs.Events, e)
+			}
+		}
+		return nil
+	})
+	if includeLocation && (srcs.Location != nil) {
+		run("location", func() error {
+			segs, err := srcs.Location.RecentHistory(ctx)
+			if (err == nil) && (len(segs) > 0) {
+				sigs.Segment = &segs[len(segs)-1]
+			}
+			return err
+		})
+	}
+	if srcs.Music != nil {
+		run("music", func() (err error) {
+			sigs.Music, err = srcs.Music.GetCurrent(ctx)
+			return err
+		})
+	}
+	if srcs.Productivity != nil {
+		run("productivity", func() (err error) {
+			sigs.Productivity, err = srcs.Productivity.CurrentProductivity(ctx)
+			return err
+		})
+	}
+	if srcs.Goals != nil {
+		run("focus", func() error {
+			sessions, err := srcs.Goals.FocusSessions(ctx, sigs.Timestamp)
+			if err != nil {
+				return err
+			}
+			for i := range sessions {
+				if sessions[i].End == nil {
+					sigs.Focus = &sessions[i]
+				}
+			}
+			return nil
+		})
+	}
+	wg.Wait()
+	return &sigs
+}
+
+// sameStatus reports whether a and b describe the same Status, ignoring
+// their timestamps.
+func sameStatus(a, b *status.Status) bool {
+	return (a.Activity == b.Activity) &&
+		(a.Confidence == b.Confidence) &&
+		equalStrings(a.Detail, b.Detail) &&
+		equalTimes(a.Since, b.Since) &&
+		equalTimes(a.Until, b.Until)
+}
+
+func equalStrings(a, b *string) bool {
+	if (a == nil) || (b == nil) {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if (a == nil) || (b == nil) {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// A statusActor periodically collects signals while a Service has
+// subscribers, and publishes them.
+type statusActor struct {
+	svc *Service
+}
+
+var _ poll.Actor = (*statusActor)(nil)
+
+func (sa statusActor) Prod() (zero.Interface, error) {
+	if atomic.LoadInt32(&sa.svc.subs) == 0 {
+		return nil, nil
+	}
+	return sa.svc.signals(context.Background(), true), nil
+}
+
+func (sa statusActor) Recv(v zero.Interface, err error) {
+	if err != nil {
+		sa.svc.log.WithError(err).Error("Failed to collect status signals.")
+		return
+	}
+	if sigs, ok := v.(*status.Signals); ok {
+		sa.svc.hub.Publish(sigs)
+	}
+}